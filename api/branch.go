@@ -13,3 +13,23 @@ var CreateBranch = func(client *gitlab.Client, projectID interface{}, opts *gitl
 
 	return branch, nil
 }
+
+var GetBranch = func(client *gitlab.Client, projectID interface{}, branch string) (*gitlab.Branch, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	b, _, err := client.Branches.GetBranch(projectID, branch)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+var DeleteBranch = func(client *gitlab.Client, projectID interface{}, branch string) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, err := client.Branches.DeleteBranch(projectID, branch)
+	return err
+}
@@ -1,6 +1,12 @@
 package api
 
-import gitlab "gitlab.com/gitlab-org/api/client-go"
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
 
 var GetCommitStatuses = func(client *gitlab.Client, pid interface{}, sha string) ([]*gitlab.CommitStatus, error) {
 	if client == nil {
@@ -17,3 +23,81 @@ var GetCommitStatuses = func(client *gitlab.Client, pid interface{}, sha string)
 	}
 	return statuses, nil
 }
+
+// GetCommitDiff returns the diff of a commit, following pagination.
+var GetCommitDiff = func(client *gitlab.Client, pid interface{}, sha string) ([]*gitlab.Diff, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opt := &gitlab.GetCommitDiffOptions{
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	}
+
+	diffs := make([]*gitlab.Diff, 0)
+	for {
+		page, resp, err := client.Commits.GetCommitDiff(pid, sha, opt)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opt.Page = resp.NextPage
+	}
+	return diffs, nil
+}
+
+// ListMRsByCommit returns the merge requests that introduced a commit.
+var ListMRsByCommit = func(client *gitlab.Client, pid interface{}, sha string) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	mrs, _, err := client.Commits.ListMergeRequestsByCommit(pid, sha)
+	if err != nil {
+		return nil, err
+	}
+	return mrs, nil
+}
+
+// CherryPickCommit applies a commit to a branch. With DryRun set, it only checks for conflicts.
+var CherryPickCommit = func(client *gitlab.Client, pid interface{}, sha string, opts *gitlab.CherryPickCommitOptions) (*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	commit, _, err := client.Commits.CherryPickCommit(pid, sha, opts)
+	if err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
+
+// RevertCommitOptions are the options of RevertCommit. Unlike gitlab.RevertCommitOptions,
+// they support dry runs.
+type RevertCommitOptions struct {
+	Branch *string `json:"branch,omitempty"`
+	DryRun *bool   `json:"dry_run,omitempty"`
+}
+
+// RevertCommit reverts a commit on a branch. With DryRun set, it only checks for conflicts.
+var RevertCommit = func(client *gitlab.Client, projectPath string, sha string, opts *RevertCommitOptions) (*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	path := fmt.Sprintf("projects/%s/repository/commits/%s/revert", url.PathEscape(projectPath), url.PathEscape(sha))
+	req, err := client.NewRequest(http.MethodPost, path, opts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := new(gitlab.Commit)
+	_, err = client.Do(req, commit)
+	if err != nil {
+		return nil, err
+	}
+	return commit, nil
+}
@@ -0,0 +1,112 @@
+package api
+
+import gitlab "gitlab.com/gitlab-org/api/client-go"
+
+var ListGroupEpics = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupEpicsOptions) ([]*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return epics, nil
+}
+
+var GetEpic = func(client *gitlab.Client, groupID interface{}, epicIID int) (*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epic, _, err := client.Epics.GetEpic(groupID, epicIID)
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+var CreateEpic = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateEpicOptions) (*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epic, _, err := client.Epics.CreateEpic(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+var UpdateEpic = func(client *gitlab.Client, groupID interface{}, epicIID int, opts *gitlab.UpdateEpicOptions) (*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epic, _, err := client.Epics.UpdateEpic(groupID, epicIID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return epic, nil
+}
+
+// ListChildEpics returns the child epics of an epic.
+var ListChildEpics = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Epic, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	epics, _, err := client.Epics.GetEpicLinks(groupID, epicIID)
+	if err != nil {
+		return nil, err
+	}
+	return epics, nil
+}
+
+// ListEpicIssues returns all child issues of an epic, following pagination.
+var ListEpicIssues = func(client *gitlab.Client, groupID interface{}, epicIID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0)
+	for {
+		page, resp, err := client.EpicIssues.ListEpicIssues(groupID, epicIID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return issues, nil
+}
+
+// AssignEpicIssue adds an issue to an epic. issueID is the global ID of the issue, not its IID.
+var AssignEpicIssue = func(client *gitlab.Client, groupID interface{}, epicIID, issueID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, _, err := client.EpicIssues.AssignEpicIssue(groupID, epicIID, issueID)
+	return err
+}
+
+// RemoveEpicIssue removes an issue from an epic. epicIssueID is the ID of the link between the
+// epic and the issue, returned by ListEpicIssues.
+var RemoveEpicIssue = func(client *gitlab.Client, groupID interface{}, epicIID, epicIssueID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, _, err := client.EpicIssues.RemoveEpicIssue(groupID, epicIID, epicIssueID)
+	return err
+}
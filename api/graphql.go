@@ -0,0 +1,57 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// GraphQL sends a query, or a mutation, to the GraphQL API of the instance of the client,
+// and decodes the data of the response into data. Some features, like timelogs, are only
+// available in the GraphQL API.
+var GraphQL = func(client *gitlab.Client, query string, variables map[string]interface{}, data interface{}) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	req, err := client.NewRequest(http.MethodPost, "", &graphQLRequest{Query: query, Variables: variables}, nil)
+	if err != nil {
+		return err
+	}
+	// the GraphQL endpoint is next to the REST API: /api/v4/ becomes /api/graphql.
+	u := client.BaseURL()
+	u.Path = strings.TrimSuffix(u.Path, "v4/") + "graphql"
+	u.RawPath = ""
+	req.URL = u
+
+	resp := &graphQLResponse{}
+	if _, err := client.Do(req, resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, " "))
+	}
+	if data == nil || len(resp.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resp.Data, data)
+}
@@ -218,3 +218,98 @@ var AddIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issue
 
 	return timeStats, nil
 }
+
+var ResetIssueTimeEstimate = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetTimeEstimate(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetIssueTimeSpent = func(client *gitlab.Client, projectID interface{}, issueIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.Issues.ResetSpentTime(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+// ListIssueLinks returns the issues linked to an issue, with the type and the ID of each link.
+var ListIssueLinks = func(client *gitlab.Client, projectID interface{}, issueIID int) ([]*gitlab.IssueRelation, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	relations, _, err := client.IssueLinks.ListIssueRelations(projectID, issueIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return relations, nil
+}
+
+var UnlinkIssues = func(client *gitlab.Client, projectID interface{}, issueIID, issueLinkID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, _, err := client.IssueLinks.DeleteIssueLink(projectID, issueIID, issueLinkID)
+	return err
+}
+
+// ListIssueClosingMRs returns all merge requests that close an issue when merged, following pagination.
+var ListIssueClosingMRs = func(client *gitlab.Client, projectID interface{}, issueIID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListMergeRequestsClosingIssueOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0)
+	for {
+		page, resp, err := client.Issues.ListMergeRequestsClosingIssue(projectID, issueIID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return mrs, nil
+}
+
+// ListIssueRelatedMRs returns all merge requests that mention an issue, following pagination.
+var ListIssueRelatedMRs = func(client *gitlab.Client, projectID interface{}, issueIID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListMergeRequestsRelatedToIssueOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0)
+	for {
+		page, resp, err := client.Issues.ListMergeRequestsRelatedToIssue(projectID, issueIID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return mrs, nil
+}
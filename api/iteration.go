@@ -0,0 +1,53 @@
+package api
+
+import gitlab "gitlab.com/gitlab-org/api/client-go"
+
+// Iteration states, as returned by the API.
+// See docs: https://docs.gitlab.com/ee/api/iterations.html
+const (
+	IterationStateUpcoming = 1
+	IterationStateCurrent  = 2
+	IterationStateClosed   = 3
+)
+
+var ListGroupIterations = func(client *gitlab.Client, groupID interface{}, opts *gitlab.ListGroupIterationsOptions) ([]*gitlab.GroupIteration, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	iterations, _, err := client.GroupIterations.ListGroupIterations(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return iterations, nil
+}
+
+// ListIterationIssues returns all issues of an iteration of a group, following pagination.
+var ListIterationIssues = func(client *gitlab.Client, groupID interface{}, iterationID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListGroupIssuesOptions{
+		IterationID: gitlab.Ptr(iterationID),
+		ListOptions: gitlab.ListOptions{PerPage: 100},
+	}
+	issues := make([]*gitlab.Issue, 0)
+	for {
+		page, resp, err := client.Issues.ListGroupIssues(groupID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return issues, nil
+}
@@ -34,6 +34,20 @@ var GetMRApprovalState = func(client *gitlab.Client, projectID interface{}, mrID
 	return mrApprovals, nil
 }
 
+// GetMRApprovals returns the approval summary of a merge request: who approved it, and how many approvals are required.
+var GetMRApprovals = func(client *gitlab.Client, projectID interface{}, mrID int) (*gitlab.MergeRequestApprovals, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	approvals, _, err := client.MergeRequestApprovals.GetConfiguration(projectID, mrID)
+	if err != nil {
+		return nil, err
+	}
+
+	return approvals, nil
+}
+
 var GetMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.GetMergeRequestsOptions) (*gitlab.MergeRequest, error) {
 	if client == nil {
 		client = apiClient.Lab()
@@ -249,6 +263,23 @@ var listMRsWithAssigneesOrReviewers = func(client *gitlab.Client, projectID inte
 	return mrs, nil
 }
 
+// ListAllMRs lists merge requests across all projects the authenticated user has access to.
+var ListAllMRs = func(client *gitlab.Client, opts *gitlab.ListMergeRequestsOptions) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	mrs, _, err := client.MergeRequests.ListMergeRequests(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return mrs, nil
+}
+
 var UpdateMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.UpdateMergeRequestOptions) (*gitlab.MergeRequest, error) {
 	if client == nil {
 		client = apiClient.Lab()
@@ -285,6 +316,18 @@ var MergeMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts
 	return mrs, resp, nil
 }
 
+var AddMRToMergeTrain = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.AddMergeRequestToMergeTrainOptions) (*gitlab.Response, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+	_, resp, err := client.MergeTrains.AddMergeRequestToMergeTrain(projectID, mrID, opts)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
 var CreateMR = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateMergeRequestOptions) (*gitlab.MergeRequest, error) {
 	if client == nil {
 		client = apiClient.Lab()
@@ -339,6 +382,89 @@ var ListMRNotes = func(client *gitlab.Client, projectID interface{}, mrID int, o
 	return notes, nil
 }
 
+// ListMRDiscussions returns all discussions of a merge request, following pagination.
+var ListMRDiscussions = func(client *gitlab.Client, projectID interface{}, mrID int) ([]*gitlab.Discussion, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.ListMergeRequestDiscussionsOptions{PerPage: 100}
+	discussions := make([]*gitlab.Discussion, 0)
+	for {
+		page, resp, err := client.Discussions.ListMergeRequestDiscussions(projectID, mrID, opts)
+		if err != nil {
+			return nil, err
+		}
+		discussions = append(discussions, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return discussions, nil
+}
+
+// ListMRDiffVersions returns the diff versions of a merge request, most recent first.
+var ListMRDiffVersions = func(client *gitlab.Client, projectID interface{}, mrID int) ([]*gitlab.MergeRequestDiffVersion, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMergeRequestDiffVersionsOptions{PerPage: 100}
+	versions := make([]*gitlab.MergeRequestDiffVersion, 0)
+	for {
+		page, resp, err := client.MergeRequests.GetMergeRequestDiffVersions(projectID, mrID, opts)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return versions, nil
+}
+
+// GetMRDiffVersion returns a single diff version of a merge request, including its commits and diffs.
+var GetMRDiffVersion = func(client *gitlab.Client, projectID interface{}, mrID int, versionID int) (*gitlab.MergeRequestDiffVersion, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	version, _, err := client.MergeRequests.GetSingleMergeRequestDiffVersion(projectID, mrID, versionID, &gitlab.GetSingleMergeRequestDiffVersionOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+// ListMRCommits returns the commits of a merge request, most recent first, following pagination.
+var ListMRCommits = func(client *gitlab.Client, projectID interface{}, mrID int) ([]*gitlab.Commit, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMergeRequestCommitsOptions{PerPage: 100}
+	commits := make([]*gitlab.Commit, 0)
+	for {
+		page, resp, err := client.MergeRequests.GetMergeRequestCommits(projectID, mrID, opts)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return commits, nil
+}
+
 var RebaseMR = func(client *gitlab.Client, projectID interface{}, mrID int, opts *gitlab.RebaseMergeRequestOptions) error {
 	if client == nil {
 		client = apiClient.Lab()
@@ -435,3 +561,55 @@ func composeCliListMROptions(optionSetters ...CliListMROption) *cliListMROptions
 	}
 	return opts
 }
+
+var SetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrIID int, opts *gitlab.SetTimeEstimateOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.SetTimeEstimate(projectID, mrIID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var AddMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrIID int, opts *gitlab.AddSpentTimeOptions) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.AddSpentTime(projectID, mrIID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeEstimate = func(client *gitlab.Client, projectID interface{}, mrIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetTimeEstimate(projectID, mrIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
+
+var ResetMRTimeSpent = func(client *gitlab.Client, projectID interface{}, mrIID int) (*gitlab.TimeStats, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	timeStats, _, err := client.MergeRequests.ResetSpentTime(projectID, mrIID)
+	if err != nil {
+		return nil, err
+	}
+
+	return timeStats, nil
+}
@@ -162,3 +162,185 @@ var ListAllMilestones = func(client *gitlab.Client, projectID interface{}, opts
 
 	return milestones, nil
 }
+
+var GetProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.GetMilestone(projectID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var GetGroupMilestone = func(client *gitlab.Client, groupID interface{}, milestoneID int) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.GetGroupMilestone(groupID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var CreateProjectMilestone = func(client *gitlab.Client, projectID interface{}, opts *gitlab.CreateMilestoneOptions) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.CreateMilestone(projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var CreateGroupMilestone = func(client *gitlab.Client, groupID interface{}, opts *gitlab.CreateGroupMilestoneOptions) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.CreateGroupMilestone(groupID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var UpdateProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.Milestones.UpdateMilestone(projectID, milestoneID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var UpdateGroupMilestone = func(client *gitlab.Client, groupID interface{}, milestoneID int, opts *gitlab.UpdateGroupMilestoneOptions) (*gitlab.GroupMilestone, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	milestone, _, err := client.GroupMilestones.UpdateGroupMilestone(groupID, milestoneID, opts)
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+var DeleteProjectMilestone = func(client *gitlab.Client, projectID interface{}, milestoneID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, err := client.Milestones.DeleteMilestone(projectID, milestoneID)
+	return err
+}
+
+var DeleteGroupMilestone = func(client *gitlab.Client, groupID interface{}, milestoneID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, err := client.GroupMilestones.DeleteGroupMilestone(groupID, milestoneID)
+	return err
+}
+
+// ListProjectMilestoneIssues returns all issues of a project milestone, following pagination.
+var ListProjectMilestoneIssues = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMilestoneIssuesOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0)
+	for {
+		page, resp, err := client.Milestones.GetMilestoneIssues(projectID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return issues, nil
+}
+
+// ListGroupMilestoneIssues returns all issues of a group milestone, following pagination.
+var ListGroupMilestoneIssues = func(client *gitlab.Client, groupID interface{}, milestoneID int) ([]*gitlab.Issue, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetGroupMilestoneIssuesOptions{PerPage: 100}
+	issues := make([]*gitlab.Issue, 0)
+	for {
+		page, resp, err := client.GroupMilestones.GetGroupMilestoneIssues(groupID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return issues, nil
+}
+
+// ListProjectMilestoneMRs returns all merge requests of a project milestone, following pagination.
+var ListProjectMilestoneMRs = func(client *gitlab.Client, projectID interface{}, milestoneID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetMilestoneMergeRequestsOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0)
+	for {
+		page, resp, err := client.Milestones.GetMilestoneMergeRequests(projectID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return mrs, nil
+}
+
+// ListGroupMilestoneMRs returns all merge requests of a group milestone, following pagination.
+var ListGroupMilestoneMRs = func(client *gitlab.Client, groupID interface{}, milestoneID int) ([]*gitlab.MergeRequest, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts := &gitlab.GetGroupMilestoneMergeRequestsOptions{PerPage: 100}
+	mrs := make([]*gitlab.MergeRequest, 0)
+	for {
+		page, resp, err := client.GroupMilestones.GetGroupMilestoneMergeRequests(groupID, milestoneID, opts)
+		if err != nil {
+			return nil, err
+		}
+		mrs = append(mrs, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return mrs, nil
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Timelog is an amount of time spent on an issue or a merge request, by a user.
+// Timelogs are only available in the GraphQL API.
+type Timelog struct {
+	SpentAt time.Time `json:"spentAt"`
+	// TimeSpent is in seconds, and negative when time was subtracted.
+	TimeSpent int    `json:"timeSpent"`
+	Summary   string `json:"summary"`
+	User      struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Issue        *TimelogIssuable `json:"issue"`
+	MergeRequest *TimelogIssuable `json:"mergeRequest"`
+}
+
+// TimelogIssuable is the issue or merge request of a timelog.
+type TimelogIssuable struct {
+	IID    string `json:"iid"`
+	Title  string `json:"title"`
+	WebURL string `json:"webUrl"`
+	Labels struct {
+		Nodes []struct {
+			Title string `json:"title"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Milestone *struct {
+		Title string `json:"title"`
+	} `json:"milestone"`
+}
+
+// ListTimelogsOptions filters the timelogs of a project. StartDate and EndDate are in the
+// YYYY-MM-DD format, and both days are included.
+type ListTimelogsOptions struct {
+	StartDate string
+	EndDate   string
+	Username  string
+}
+
+const projectTimelogsQuery = `
+query($fullPath: ID!, $startDate: Time, $endDate: Time, $username: String, $after: String) {
+  project(fullPath: $fullPath) {
+    timelogs(startDate: $startDate, endDate: $endDate, username: $username, first: 100, after: $after) {
+      nodes {
+        spentAt
+        timeSpent
+        summary
+        user { username }
+        issue { iid title webUrl labels { nodes { title } } milestone { title } }
+        mergeRequest { iid title webUrl labels { nodes { title } } milestone { title } }
+      }
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// ListProjectTimelogs returns all timelogs of the issues and merge requests of a project, following pagination.
+var ListProjectTimelogs = func(client *gitlab.Client, projectPath string, opts *ListTimelogsOptions) ([]*Timelog, error) {
+	variables := map[string]interface{}{"fullPath": projectPath}
+	if opts.StartDate != "" {
+		variables["startDate"] = opts.StartDate
+	}
+	if opts.EndDate != "" {
+		variables["endDate"] = opts.EndDate
+	}
+	if opts.Username != "" {
+		variables["username"] = opts.Username
+	}
+
+	timelogs := make([]*Timelog, 0)
+	for {
+		var data struct {
+			Project *struct {
+				Timelogs struct {
+					Nodes    []*Timelog `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"timelogs"`
+			} `json:"project"`
+		}
+		if err := GraphQL(client, projectTimelogsQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if data.Project == nil {
+			return nil, errors.New("project not found: " + projectPath)
+		}
+
+		timelogs = append(timelogs, data.Project.Timelogs.Nodes...)
+		if !data.Project.Timelogs.PageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = data.Project.Timelogs.PageInfo.EndCursor
+	}
+
+	return timelogs, nil
+}
+
+const createTimelogMutation = `
+mutation($issuableId: IssuableID!, $timeSpent: String!, $spentAt: Time!, $summary: String!) {
+  timelogCreate(input: {issuableId: $issuableId, timeSpent: $timeSpent, spentAt: $spentAt, summary: $summary}) {
+    errors
+  }
+}`
+
+// CreateTimelog adds time spent on an issue or a merge request on a given day, which the REST
+// API doesn't support. issuableGID is the global ID of the issue or merge request, like
+// gid://gitlab/Issue/123.
+var CreateTimelog = func(client *gitlab.Client, issuableGID, duration string, spentAt time.Time, summary string) error {
+	variables := map[string]interface{}{
+		"issuableId": issuableGID,
+		"timeSpent":  duration,
+		"spentAt":    spentAt.Format(time.RFC3339),
+		"summary":    summary,
+	}
+
+	var data struct {
+		TimelogCreate struct {
+			Errors []string `json:"errors"`
+		} `json:"timelogCreate"`
+	}
+	if err := GraphQL(client, createTimelogMutation, variables, &data); err != nil {
+		return err
+	}
+	if len(data.TimelogCreate.Errors) > 0 {
+		return errors.New(strings.Join(data.TimelogCreate.Errors, " "))
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package api
+
+import gitlab "gitlab.com/gitlab-org/api/client-go"
+
+var ListTodos = func(client *gitlab.Client, opts *gitlab.ListTodosOptions) ([]*gitlab.Todo, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	if opts.PerPage == 0 {
+		opts.PerPage = DefaultListLimit
+	}
+
+	todos, _, err := client.Todos.ListTodos(opts)
+	if err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// ListAllTodos returns all to-do items that match opts, following pagination.
+var ListAllTodos = func(client *gitlab.Client, opts *gitlab.ListTodosOptions) ([]*gitlab.Todo, error) {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	opts.PerPage = 100
+	todos := make([]*gitlab.Todo, 0)
+	for {
+		page, resp, err := client.Todos.ListTodos(opts)
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, page...)
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return todos, nil
+}
+
+var MarkTodoAsDone = func(client *gitlab.Client, todoID int) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, err := client.Todos.MarkTodoAsDone(todoID)
+	return err
+}
+
+var MarkAllTodosAsDone = func(client *gitlab.Client) error {
+	if client == nil {
+		client = apiClient.Lab()
+	}
+
+	_, err := client.Todos.MarkAllTodosAsDone()
+	return err
+}
@@ -0,0 +1,355 @@
+package cmdutils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/shlex"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// BulkStdinArg is the argument that reads the IDs of the items to update in bulk from standard input.
+const BulkStdinArg = "-"
+
+// BulkOptions are the options of the commands that update several issues or merge requests at once.
+type BulkOptions struct {
+	Filter      string
+	DryRun      bool
+	Yes         bool
+	Concurrency int
+}
+
+// BulkItem is an issue or merge request updated in bulk.
+type BulkItem struct {
+	Reference string
+	Title     string
+	WebURL    string
+	// Update applies the changes to the item.
+	Update func() error
+	// Err is the error that prevented fetching the item. The item is reported as failed, and not updated.
+	Err error
+}
+
+// BulkResult is the result of the update of an item, in a BulkReport.
+type BulkResult struct {
+	Reference string `json:"reference"`
+	WebURL    string `json:"web_url"`
+	Error     string `json:"error,omitempty"`
+}
+
+// BulkReport is the report of a bulk update, printed as JSON when it ends.
+type BulkReport struct {
+	DryRun  bool         `json:"dry_run"`
+	Matched int          `json:"matched"`
+	Updated []BulkResult `json:"updated"`
+	Failed  []BulkResult `json:"failed"`
+}
+
+// AddBulkFlags adds the flags of bulk updates to cmd. kind is the plural name of the items, like "issues".
+func AddBulkFlags(cmd *cobra.Command, opts *BulkOptions, kind string) {
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", fmt.Sprintf("Update all %s that match these 'list' flags, like '--label bug --milestone 1.0'.", kind))
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, fmt.Sprintf("Show the %s that would be updated, without updating them.", kind))
+	cmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, fmt.Sprintf("Update several %s without asking for confirmation.", kind))
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 4, fmt.Sprintf("Maximum number of %s to update at once.", kind))
+}
+
+// IsBulk returns true if the command updates several items: with '--filter', or with IDs from standard input.
+func (opts *BulkOptions) IsBulk(args []string) bool {
+	return opts.Filter != "" || (len(args) == 1 && args[0] == BulkStdinArg)
+}
+
+// Validate checks the bulk options, before the items are fetched.
+func (opts *BulkOptions) Validate(io *iostreams.IOStreams, args []string) error {
+	if opts.Filter != "" && len(args) != 0 {
+		return &FlagError{Err: errors.New("--filter can't be used with an ID.")}
+	}
+	if opts.Concurrency < 1 {
+		return &FlagError{Err: errors.New("--concurrency must be at least 1.")}
+	}
+	if opts.DryRun || opts.Yes {
+		return nil
+	}
+	// standard input is used by the IDs, so it can't answer the confirmation prompt.
+	if !io.PromptEnabled() || len(args) != 0 {
+		return &FlagError{Err: errors.New("--yes or -y flag is required when not running interactively.")}
+	}
+	return nil
+}
+
+// ReadBulkArgs reads the IDs of the items to update from r. IDs are separated by spaces, commas, or new lines.
+func ReadBulkArgs(r io.Reader) ([]string, error) {
+	var ids []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.FieldsFunc(scanner.Text(), func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+		ids = append(ids, fields...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no IDs in the standard input.")
+	}
+
+	return ids, nil
+}
+
+// BulkPageSize is the number of items listed per page when fetching the items matched by --filter.
+const BulkPageSize = 100
+
+// ListMatches runs the list command created by newCmd with the flags of filter, page by page,
+// and returns every item it lists. kind is the plural name of the items, like "issues".
+func ListMatches[T any](f *Factory, newCmd func(*Factory) *cobra.Command, filter, kind string) ([]T, error) {
+	listArgs, err := shlex.Split(filter)
+	if err != nil {
+		return nil, &FlagError{Err: fmt.Errorf("--filter: %w", err)}
+	}
+
+	var items []T
+	for page := 1; ; page++ {
+		var output bytes.Buffer
+		listIO := *f.IO
+		listIO.StdOut = &output
+		listFactory := *f
+		listFactory.IO = &listIO
+
+		listCmd := newCmd(&listFactory)
+		listCmd.SetArgs(append(listArgs, "--output", "json",
+			"--page", strconv.Itoa(page), "--per-page", strconv.Itoa(BulkPageSize)))
+		listCmd.SetOut(io.Discard)
+		listCmd.SetErr(io.Discard)
+		listCmd.SilenceErrors = true
+		listCmd.SilenceUsage = true
+		if err := listCmd.Execute(); err != nil {
+			return nil, &FlagError{Err: fmt.Errorf("--filter: %w", err)}
+		}
+
+		var pageItems []T
+		if err := json.Unmarshal(output.Bytes(), &pageItems); err != nil {
+			return nil, fmt.Errorf("could not read the %s matched by --filter: %w", kind, err)
+		}
+		items = append(items, pageItems...)
+		if len(pageItems) < BulkPageSize {
+			return items, nil
+		}
+	}
+}
+
+// FetchBulkItems fetches the items of ids, at most opts.Concurrency at once, keeping their order.
+// The IDs that can't be fetched are returned as BulkItems with Err set, so RunBulk reports them as failed.
+func FetchBulkItems[T any](opts *BulkOptions, ids []string, fetch func(id string) (T, error)) ([]T, []BulkItem) {
+	fetched := make([]T, len(ids))
+	errs := make([]error, len(ids))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+	for i, id := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			fetched[i], errs[i] = fetch(id)
+		}()
+	}
+	wg.Wait()
+
+	var items []T
+	var failed []BulkItem
+	for i, id := range ids {
+		if errs[i] != nil {
+			failed = append(failed, BulkItem{Reference: id, Err: errs[i]})
+			continue
+		}
+		items = append(items, fetched[i])
+	}
+	return items, failed
+}
+
+// RunBulk previews the items and the changes of a bulk update, and asks for confirmation.
+// It then updates the items concurrently, and prints a JSON report of the results
+// to standard output. Progress is printed to standard error.
+func RunBulk(io *iostreams.IOStreams, opts *BulkOptions, kind string, items []BulkItem, changes []string) error {
+	c := io.Color()
+
+	report := BulkReport{
+		DryRun:  opts.DryRun,
+		Updated: []BulkResult{},
+		Failed:  []BulkResult{},
+	}
+
+	// items that couldn't be fetched are reported as failed before anything is updated.
+	var found []BulkItem
+	for _, item := range items {
+		if item.Err != nil {
+			fmt.Fprintf(io.StdErr, "%s %s: %s\n", c.FailedIcon(), item.Reference, item.Err)
+			report.Failed = append(report.Failed, BulkResult{Reference: item.Reference, WebURL: item.WebURL, Error: item.Err.Error()})
+			continue
+		}
+		found = append(found, item)
+	}
+	items = found
+	report.Matched = len(items)
+
+	if len(items) == 0 {
+		fmt.Fprintf(io.StdErr, "No %s match.\n", kind)
+		return finishBulk(io, report)
+	}
+
+	table := tableprinter.NewTablePrinter()
+	for _, item := range items {
+		table.AddRow(c.Green(item.Reference), item.Title)
+	}
+	fmt.Fprintf(io.StdErr, "%s\n%s\nChanges:\n", c.Bold(utils.Pluralize(len(items), strings.TrimSuffix(kind, "s"))), table.String())
+	for _, change := range changes {
+		fmt.Fprintf(io.StdErr, "- %s\n", change)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintln(io.StdErr, "\nDry run: nothing was updated.")
+		return finishBulk(io, report)
+	}
+
+	if !opts.Yes {
+		confirmed := false
+		err := prompt.Confirm(&confirmed, fmt.Sprintf("Update %s?", utils.Pluralize(len(items), strings.TrimSuffix(kind, "s"))), false)
+		if err != nil {
+			return WrapError(err, "could not prompt")
+		}
+		if !confirmed {
+			return CancelError()
+		}
+	}
+
+	results := make([]BulkResult, len(items))
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sem := make(chan struct{}, opts.Concurrency)
+	for i, item := range items {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result := BulkResult{Reference: item.Reference, WebURL: item.WebURL}
+			err := item.Update()
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Error = err.Error()
+				fmt.Fprintf(io.StdErr, "%s %s: %s\n", c.FailedIcon(), item.Reference, err)
+			} else {
+				fmt.Fprintf(io.StdErr, "%s %s\n", c.GreenCheck(), item.Reference)
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	// keep the order of the items in the report.
+	for _, result := range results {
+		if result.Error != "" {
+			report.Failed = append(report.Failed, result)
+		} else {
+			report.Updated = append(report.Updated, result)
+		}
+	}
+
+	return finishBulk(io, report)
+}
+
+// finishBulk prints the report of a bulk update, and fails if any item failed.
+func finishBulk(io *iostreams.IOStreams, report BulkReport) error {
+	if err := printBulkReport(io, report); err != nil {
+		return err
+	}
+	if len(report.Failed) > 0 {
+		return SilentError
+	}
+	return nil
+}
+
+// BulkChanges describes the changes of ua, for the preview of a bulk update.
+func (ua *UserAssignments) BulkChanges() []string {
+	add, remove := "assign", "unassign"
+	if ua.AssignmentType == ReviewerAssignment {
+		add, remove = "request review from", "remove review request for"
+	}
+
+	var changes []string
+	if len(ua.ToReplace) != 0 {
+		changes = append(changes, fmt.Sprintf("%s @%s", add, strings.Join(ua.ToReplace, " @")))
+	}
+	if len(ua.ToRemove) != 0 {
+		changes = append(changes, fmt.Sprintf("%s @%s", remove, strings.Join(ua.ToRemove, " @")))
+	}
+	if len(ua.ToAdd) != 0 {
+		changes = append(changes, fmt.Sprintf("%s @%s", add, strings.Join(ua.ToAdd, " @")))
+	}
+	return changes
+}
+
+// BulkUserIDs returns the IDs of the users of an item updated in bulk: its current users without
+// the ones of ToRemove, and the users of ToAdd, which are looked up once for all the items.
+func (ua *UserAssignments) BulkUserIDs(current []*gitlab.BasicUser, added []*gitlab.User) *[]int {
+	var ids []int
+	for _, user := range current {
+		if !utils.PresentInStringSlice(ua.ToRemove, user.Username) {
+			ids = append(ids, user.ID)
+		}
+	}
+	for _, user := range added {
+		if !utils.PresentInIntSlice(ids, user.ID) {
+			ids = append(ids, user.ID)
+		}
+	}
+
+	// 0 is the documented way to remove all users.
+	if len(ids) == 0 {
+		ids = []int{0}
+	}
+	return &ids
+}
+
+// BulkMilestoneID returns the ID of a milestone of a project, from its ID or title. Items updated
+// in bulk can belong to several projects, which don't share milestones.
+func BulkMilestoneID(apiClient *gitlab.Client, projectID int, milestone string) (int, error) {
+	if id, err := strconv.Atoi(milestone); err == nil {
+		return id, nil
+	}
+
+	m, err := api.ProjectMilestoneByTitle(apiClient, projectID, milestone)
+	if err != nil {
+		return 0, fmt.Errorf("could not find milestone %q: %w", milestone, err)
+	}
+	return m.ID, nil
+}
+
+func printBulkReport(io *iostreams.IOStreams, report BulkReport) error {
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(io.StdOut, string(reportJSON))
+	return nil
+}
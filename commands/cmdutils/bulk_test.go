@@ -0,0 +1,195 @@
+package cmdutils
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+)
+
+func Test_ReadBulkArgs(t *testing.T) {
+	ids, err := ReadBulkArgs(strings.NewReader("12\n#13, 14\thttps://gitlab.com/OWNER/REPO/-/issues/15\n\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"12", "#13", "14", "https://gitlab.com/OWNER/REPO/-/issues/15"}, ids)
+
+	_, err = ReadBulkArgs(strings.NewReader("\n"))
+	require.EqualError(t, err, "no IDs in the standard input.")
+}
+
+func TestBulkOptions_Validate(t *testing.T) {
+	ttyIO, _, _, _ := iostreams.Test()
+	ttyIO.IsaTTY = true
+	ttyIO.IsErrTTY = true
+	nonTTYIO, _, _, _ := iostreams.Test()
+
+	tests := []struct {
+		name    string
+		opts    BulkOptions
+		io      *iostreams.IOStreams
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "filter with a terminal",
+			opts: BulkOptions{Filter: "--label bug", Concurrency: 1},
+			io:   ttyIO,
+		},
+		{
+			name:    "filter with an ID",
+			opts:    BulkOptions{Filter: "--label bug", Concurrency: 1},
+			io:      ttyIO,
+			args:    []string{"12"},
+			wantErr: "--filter can't be used with an ID.",
+		},
+		{
+			name:    "no concurrency",
+			opts:    BulkOptions{Filter: "--label bug"},
+			io:      ttyIO,
+			wantErr: "--concurrency must be at least 1.",
+		},
+		{
+			name:    "filter without a terminal",
+			opts:    BulkOptions{Filter: "--label bug", Concurrency: 1},
+			io:      nonTTYIO,
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+		{
+			name:    "IDs from the standard input",
+			opts:    BulkOptions{Concurrency: 1},
+			io:      ttyIO,
+			args:    []string{BulkStdinArg},
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+		{
+			name: "IDs from the standard input with --yes",
+			opts: BulkOptions{Yes: true, Concurrency: 1},
+			io:   nonTTYIO,
+			args: []string{BulkStdinArg},
+		},
+		{
+			name: "dry run",
+			opts: BulkOptions{DryRun: true, Concurrency: 1},
+			io:   nonTTYIO,
+			args: []string{BulkStdinArg},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate(tc.io, tc.args)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func bulkItems(updated *atomic.Int32) []BulkItem {
+	return []BulkItem{
+		{
+			Reference: "OWNER/REPO#1",
+			Title:     "First",
+			WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/1",
+			Update: func() error {
+				updated.Add(1)
+				return nil
+			},
+		},
+		{
+			Reference: "OWNER/REPO#2",
+			Title:     "Second",
+			WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/2",
+			Update: func() error {
+				updated.Add(1)
+				return errors.New("403 Forbidden")
+			},
+		},
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	var updated atomic.Int32
+
+	err := RunBulk(io, &BulkOptions{Yes: true, Concurrency: 2}, "issues", bulkItems(&updated), []string{"add labels bug"})
+	require.ErrorIs(t, err, SilentError)
+	assert.Equal(t, int32(2), updated.Load())
+
+	assert.Contains(t, stderr.String(), "2 issues")
+	assert.Contains(t, stderr.String(), "OWNER/REPO#1\tFirst")
+	assert.Contains(t, stderr.String(), "Changes:\n- add labels bug")
+	assert.Contains(t, stderr.String(), "OWNER/REPO#2: 403 Forbidden")
+
+	var report BulkReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, BulkReport{
+		Matched: 2,
+		Updated: []BulkResult{{Reference: "OWNER/REPO#1", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}},
+		Failed:  []BulkResult{{Reference: "OWNER/REPO#2", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/2", Error: "403 Forbidden"}},
+	}, report)
+}
+
+func TestRunBulkDryRun(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	var updated atomic.Int32
+
+	err := RunBulk(io, &BulkOptions{DryRun: true, Concurrency: 1}, "issues", bulkItems(&updated), []string{"close"})
+	require.NoError(t, err)
+	assert.Zero(t, updated.Load())
+	assert.Contains(t, stderr.String(), "Dry run: nothing was updated.")
+
+	var report BulkReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, BulkReport{DryRun: true, Matched: 2, Updated: []BulkResult{}, Failed: []BulkResult{}}, report)
+}
+
+func TestRunBulkNotFound(t *testing.T) {
+	io, _, stdout, stderr := iostreams.Test()
+	var updated atomic.Int32
+
+	items := append(bulkItems(&updated)[:1], BulkItem{Reference: "99", Err: errors.New("404 Not found")})
+	err := RunBulk(io, &BulkOptions{Yes: true, Concurrency: 1}, "issues", items, []string{"close"})
+	require.ErrorIs(t, err, SilentError)
+	assert.Equal(t, int32(1), updated.Load())
+	assert.Contains(t, stderr.String(), "99: 404 Not found")
+
+	var report BulkReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	assert.Equal(t, BulkReport{
+		Matched: 1,
+		Updated: []BulkResult{{Reference: "OWNER/REPO#1", WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}},
+		Failed:  []BulkResult{{Reference: "99", Error: "404 Not found"}},
+	}, report)
+}
+
+func TestFetchBulkItems(t *testing.T) {
+	items, failed := FetchBulkItems(&BulkOptions{Concurrency: 2}, []string{"1", "x", "3"}, func(id string) (int, error) {
+		return strconv.Atoi(id)
+	})
+	assert.Equal(t, []int{1, 3}, items)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "x", failed[0].Reference)
+	assert.Error(t, failed[0].Err)
+}
+
+func TestRunBulkCancelled(t *testing.T) {
+	defer prompt.StubConfirm(false)()
+
+	io, _, stdout, _ := iostreams.Test()
+	var updated atomic.Int32
+
+	err := RunBulk(io, &BulkOptions{Concurrency: 1}, "issues", bulkItems(&updated), []string{"close"})
+	require.Error(t, err)
+	assert.Zero(t, updated.Load())
+	assert.Empty(t, stdout.String())
+}
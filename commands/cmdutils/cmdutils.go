@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -94,6 +95,38 @@ func ListGitLabTemplates(tmplType string) ([]string, error) {
 	return files, nil
 }
 
+// FindGitLabTemplate loads a GitLab template by name, like LoadGitLabTemplate,
+// but returns an error listing the available templates when it does not exist.
+func FindGitLabTemplate(tmplType, tmplName string) (string, error) {
+	tmpl, err := LoadGitLabTemplate(tmplType, tmplName)
+	if err != nil {
+		return "", err
+	}
+	if tmpl != "" {
+		return tmpl, nil
+	}
+
+	templates, _ := ListGitLabTemplates(tmplType)
+	if len(templates) == 0 {
+		return "", fmt.Errorf("template %q not found: no templates in .gitlab/%s.", tmplName, tmplType)
+	}
+	return "", fmt.Errorf("template %q not found. Available templates: %s.", tmplName, strings.Join(templates, ", "))
+}
+
+var templateVariableRE = regexp.MustCompile(`%\{([a-z_]+)\}`)
+
+// RenderGitLabTemplate replaces the %{variable} placeholders of a template,
+// using the syntax of GitLab description templates. Unknown placeholders are kept.
+func RenderGitLabTemplate(tmpl string, vars map[string]string) string {
+	return templateVariableRE.ReplaceAllStringFunc(tmpl, func(placeholder string) string {
+		name := templateVariableRE.FindStringSubmatch(placeholder)[1]
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
 func GetEditor(cf func() (config.Config, error)) (string, error) {
 	cfg, err := cf()
 	if err != nil {
@@ -365,6 +398,15 @@ func IDsFromUsers(users []*gitlab.User) *[]int {
 	return &ids
 }
 
+// ParseDateFlag parses the value of a date flag, in the YYYY-MM-DD format.
+func ParseDateFlag(name, value string) (*gitlab.ISOTime, error) {
+	date, err := gitlab.ParseISOTime(value)
+	if err != nil {
+		return nil, &FlagError{Err: fmt.Errorf("--%s must be a date in the YYYY-MM-DD format.", name)}
+	}
+	return &date, nil
+}
+
 func ParseMilestone(apiClient *gitlab.Client, repo glrepo.Interface, milestoneTitle string) (int, error) {
 	if milestoneID, err := strconv.Atoi(milestoneTitle); err == nil {
 		return milestoneID, nil
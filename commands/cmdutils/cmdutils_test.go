@@ -1379,3 +1379,24 @@ func TestListGitLabTemplates(t *testing.T) {
 		})
 	}
 }
+
+func TestFindGitLabTemplate(t *testing.T) {
+	oldToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return "../../test/testdata", nil }
+	t.Cleanup(func() { git.ToplevelDir = oldToplevelDir })
+
+	tmpl, err := FindGitLabTemplate(MergeRequestTemplate, "Default")
+	require.NoError(t, err)
+	assert.NotEmpty(t, tmpl)
+
+	_, err = FindGitLabTemplate(IssueTemplate, "Missing")
+	assert.EqualError(t, err, `template "Missing" not found. Available templates: Bug, Feature Request.`)
+}
+
+func TestRenderGitLabTemplate(t *testing.T) {
+	got := RenderGitLabTemplate("Branch: %{source_branch}\n%{all_commits}\nKeep %{unknown} and %{}", map[string]string{
+		"source_branch": "feat",
+		"all_commits":   "- one\n- two",
+	})
+	assert.Equal(t, "Branch: feat\n- one\n- two\nKeep %{unknown} and %{}", got)
+}
@@ -0,0 +1,71 @@
+package cherrypick
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/commit/commitutils"
+)
+
+func NewCmdCherryPick(f *cmdutils.Factory) *cobra.Command {
+	opts := &commitutils.ApplyOptions{
+		Operation: commitutils.CherryPick,
+		IO:        f.IO,
+	}
+
+	commitCherryPickCmd := &cobra.Command{
+		Use:   "cherry-pick <sha> --target <branch> [flags]",
+		Short: `Cherry-pick a commit onto a branch.`,
+		Long: heredoc.Doc(`
+			Cherry-pick a commit onto a branch, through the API.
+
+			Use --create-mr to cherry-pick onto a new branch, and open a merge request into the
+			target branch. If the commit was introduced by a merge request, its labels and a
+			reference to it are copied to the new merge request.
+		`),
+		Example: heredoc.Doc(`
+			glab commit cherry-pick 1a2b3c4d --target stable-16-0
+			glab commit cherry-pick 1a2b3c4d --target stable-16-0 --create-mr
+			glab commit cherry-pick 1a2b3c4d --target stable-16-0 --dry-run
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			commit, err := api.GetCommit(apiClient, repo.FullName(), args[0])
+			if err != nil {
+				return err
+			}
+			opts.Commits = []*gitlab.Commit{commit}
+			opts.Subject = commit.Title
+
+			if opts.CreateMR {
+				opts.SourceMR, err = commitutils.SourceMR(apiClient, repo, commit.ID)
+				if err != nil {
+					return err
+				}
+			}
+
+			return commitutils.Apply(apiClient, repo, opts)
+		},
+	}
+
+	commitCherryPickCmd.Flags().StringVarP(&opts.TargetBranch, "target", "t", "", "Branch to cherry-pick onto.")
+	commitCherryPickCmd.Flags().BoolVar(&opts.CreateMR, "create-mr", false, "Cherry-pick onto a new branch, and create a merge request into the target branch.")
+	commitCherryPickCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only check whether the commit applies without conflicts.")
+	_ = commitCherryPickCmd.MarkFlagRequired("target")
+	commitCherryPickCmd.MarkFlagsMutuallyExclusive("create-mr", "dry-run")
+
+	return commitCherryPickCmd
+}
@@ -0,0 +1,34 @@
+package commit
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	commitCherryPickCmd "gitlab.com/gitlab-org/cli/commands/commit/cherrypick"
+	commitRevertCmd "gitlab.com/gitlab-org/cli/commands/commit/revert"
+)
+
+func NewCmdCommit(f *cmdutils.Factory) *cobra.Command {
+	commitCmd := &cobra.Command{
+		Use:   "commit <command> [flags]",
+		Short: `Cherry-pick and revert commits through the API.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab commit cherry-pick 1a2b3c4d --target stable-16-0
+			glab commit revert 1a2b3c4d --create-mr
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				A commit can be supplied as argument by its SHA, or by the name of a branch or tag.
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(commitCmd, f)
+
+	commitCmd.AddCommand(commitCherryPickCmd.NewCmdCherryPick(f))
+	commitCmd.AddCommand(commitRevertCmd.NewCmdRevert(f))
+
+	return commitCmd
+}
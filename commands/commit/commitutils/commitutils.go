@@ -0,0 +1,356 @@
+package commitutils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// Operation is the change applied to the target branch.
+type Operation string
+
+const (
+	CherryPick Operation = "cherry-pick"
+	Revert     Operation = "revert"
+)
+
+func (o Operation) pastTense() string {
+	if o == CherryPick {
+		return "Cherry-picked"
+	}
+	return "Reverted"
+}
+
+// ApplyOptions describes commits to cherry-pick onto, or revert from, a branch.
+type ApplyOptions struct {
+	Operation Operation
+	// Commits are applied in order.
+	Commits []*gitlab.Commit
+	// Subject is the title of the change, used to title the merge request created with CreateMR.
+	// When it is empty, the title of SourceMR is used.
+	Subject      string
+	TargetBranch string
+	DryRun       bool
+	CreateMR     bool
+	// SourceMR is the merge request that introduced the commits, if any. Its labels
+	// and reference are copied to the merge request created with CreateMR.
+	SourceMR *gitlab.MergeRequest
+
+	IO *iostreams.IOStreams
+}
+
+// MRCommits returns the commits to cherry-pick or revert for a merged merge request:
+// its merge commit or squash commit if it has one, or else all of its commits.
+// Commits are in the order the operation must apply them.
+func MRCommits(client *gitlab.Client, repo glrepo.Interface, mr *gitlab.MergeRequest, op Operation) ([]*gitlab.Commit, error) {
+	if mr.State != "merged" {
+		return nil, fmt.Errorf("merge request !%d is %s. Only merged merge requests can be %s.", mr.IID, mr.State, strings.ToLower(op.pastTense()))
+	}
+
+	for _, sha := range []string{mr.MergeCommitSHA, mr.SquashCommitSHA} {
+		if sha == "" {
+			continue
+		}
+		commit, err := api.GetCommit(client, repo.FullName(), sha)
+		if err != nil {
+			return nil, err
+		}
+		return []*gitlab.Commit{commit}, nil
+	}
+
+	// Fast-forward merges have neither a merge commit nor a squash commit.
+	commits, err := api.ListMRCommits(client, repo.FullName(), mr.IID)
+	if err != nil {
+		return nil, err
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("merge request !%d has no commits.", mr.IID)
+	}
+
+	// The API lists the most recent commit first, which is the order to revert them in.
+	if op == CherryPick {
+		for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+			commits[i], commits[j] = commits[j], commits[i]
+		}
+	}
+	return commits, nil
+}
+
+// SourceMR returns the merged merge request that introduced a commit, or nil if there is none.
+func SourceMR(client *gitlab.Client, repo glrepo.Interface, sha string) (*gitlab.MergeRequest, error) {
+	mrs, err := api.ListMRsByCommit(client, repo.FullName(), sha)
+	if err != nil {
+		return nil, err
+	}
+	for _, mr := range mrs {
+		if mr.State == "merged" {
+			return mr, nil
+		}
+	}
+	return nil, nil
+}
+
+// Apply cherry-picks or reverts the commits through the API. With DryRun, it only reports
+// conflicts. With CreateMR, the commits are applied to a new branch and a merge request
+// into the target branch is opened.
+func Apply(client *gitlab.Client, repo glrepo.Interface, opts *ApplyOptions) error {
+	c := opts.IO.Color()
+
+	if opts.DryRun {
+		return dryRun(client, repo, opts)
+	}
+
+	branch := opts.TargetBranch
+	if opts.CreateMR {
+		branch = fmt.Sprintf("%s-%s-%s", opts.Operation, ShortSHA(opts.Commits[0].ID), opts.TargetBranch)
+		_, err := api.CreateBranch(client, repo.FullName(), &gitlab.CreateBranchOptions{
+			Branch: gitlab.Ptr(branch),
+			Ref:    gitlab.Ptr(opts.TargetBranch),
+		})
+		if err != nil {
+			return cmdutils.WrapError(err, fmt.Sprintf("failed to create branch %s.", branch))
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Created branch %s from %s.\n", c.GreenCheck(), branch, opts.TargetBranch)
+	} else if len(opts.Commits) > 1 {
+		// a series that conflicts halfway must not leave the target branch half-changed.
+		if err := checkSeries(client, repo, opts); err != nil {
+			return err
+		}
+	}
+
+	for _, commit := range opts.Commits {
+		applied, err := applyCommit(client, repo, opts.Operation, commit.ID, branch, false)
+		if err != nil {
+			if isConflict(err) {
+				fmt.Fprintf(opts.IO.StdErr, "%s Could not %s %s onto %s: %s\n", c.FailedIcon(), opts.Operation, ShortSHA(commit.ID), branch, errorMessage(err))
+				return cmdutils.SilentError
+			}
+			return err
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s %s %s onto %s as %s.\n", c.GreenCheck(), opts.Operation.pastTense(), ShortSHA(commit.ID), branch, ShortSHA(applied.ID))
+	}
+
+	if !opts.CreateMR {
+		return nil
+	}
+
+	mr, err := api.CreateMR(client, repo.FullName(), createMROptions(opts, branch))
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create merge request.")
+	}
+	fmt.Fprintf(opts.IO.StdOut, "%s Created merge request !%d.\n%s\n", c.GreenCheck(), mr.IID, mr.WebURL)
+	return nil
+}
+
+// checkSeries applies a series of commits to a temporary branch before they are applied to the
+// target branch. The API can't move a branch to another commit, so the series is then applied
+// again to the target branch, which must still be at the commit the temporary branch started from.
+func checkSeries(client *gitlab.Client, repo glrepo.Interface, opts *ApplyOptions) error {
+	c := opts.IO.Color()
+
+	base, conflict, err := trySeries(client, repo, opts)
+	if err != nil {
+		return err
+	}
+	if conflict != nil {
+		commit := opts.Commits[conflict.index]
+		fmt.Fprintf(opts.IO.StdErr, "%s Could not %s %s onto %s: %s\n", c.FailedIcon(), opts.Operation, ShortSHA(commit.ID), opts.TargetBranch, errorMessage(conflict.err))
+		fmt.Fprintf(opts.IO.StdErr, "No commits were applied to %s.\n", opts.TargetBranch)
+		return cmdutils.SilentError
+	}
+
+	target, err := api.GetBranch(client, repo.FullName(), opts.TargetBranch)
+	if err != nil {
+		return err
+	}
+	if target.Commit == nil || target.Commit.ID != base {
+		return fmt.Errorf("%s changed while the commits were checked. No commits were applied. Run the command again.", opts.TargetBranch)
+	}
+	return nil
+}
+
+// seriesConflict is the first commit of a series that doesn't apply, and the error of the API.
+type seriesConflict struct {
+	index int
+	err   error
+}
+
+// trySeries applies the commits in order to a temporary branch created from the target branch,
+// so each commit is applied on top of the ones before it, then deletes the branch. It stops at
+// the first conflict. base is the commit the temporary branch was created from.
+func trySeries(client *gitlab.Client, repo glrepo.Interface, opts *ApplyOptions) (base string, conflict *seriesConflict, err error) {
+	branch := fmt.Sprintf("glab-tmp-%s-%s-%s", opts.Operation, ShortSHA(opts.Commits[0].ID), opts.TargetBranch)
+	created, err := api.CreateBranch(client, repo.FullName(), &gitlab.CreateBranchOptions{
+		Branch: gitlab.Ptr(branch),
+		Ref:    gitlab.Ptr(opts.TargetBranch),
+	})
+	if err != nil {
+		return "", nil, cmdutils.WrapError(err, fmt.Sprintf("failed to create temporary branch %s.", branch))
+	}
+	defer func() {
+		if err := api.DeleteBranch(client, repo.FullName(), branch); err != nil {
+			fmt.Fprintf(opts.IO.StdErr, "%s Could not delete temporary branch %s: %s\n", opts.IO.Color().WarnIcon(), branch, err)
+		}
+	}()
+	if created.Commit != nil {
+		base = created.Commit.ID
+	}
+
+	for i, commit := range opts.Commits {
+		_, err := applyCommit(client, repo, opts.Operation, commit.ID, branch, false)
+		if err != nil {
+			if isConflict(err) {
+				return base, &seriesConflict{index: i, err: err}, nil
+			}
+			return "", nil, err
+		}
+	}
+	return base, nil, nil
+}
+
+// dryRun reports the commits that can't be applied. A single commit is checked with the dry run
+// of the API. A series is applied to a temporary branch, as each commit may depend on the ones
+// before it.
+func dryRun(client *gitlab.Client, repo glrepo.Interface, opts *ApplyOptions) error {
+	c := opts.IO.Color()
+	pastTense := strings.ToLower(opts.Operation.pastTense())
+
+	var conflict *seriesConflict
+	if len(opts.Commits) == 1 {
+		_, err := applyCommit(client, repo, opts.Operation, opts.Commits[0].ID, opts.TargetBranch, true)
+		if err != nil {
+			if !isConflict(err) {
+				return err
+			}
+			conflict = &seriesConflict{index: 0, err: err}
+		}
+	} else {
+		var err error
+		_, conflict, err = trySeries(client, repo, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, commit := range opts.Commits {
+		switch {
+		case conflict == nil || i < conflict.index:
+			fmt.Fprintf(opts.IO.StdOut, "%s %s can be %s onto %s.\n", c.GreenCheck(), ShortSHA(commit.ID), pastTense, opts.TargetBranch)
+		case i == conflict.index:
+			fmt.Fprintf(opts.IO.StdOut, "%s %s cannot be %s onto %s: %s\n", c.FailedIcon(), ShortSHA(commit.ID), pastTense, opts.TargetBranch, errorMessage(conflict.err))
+		default:
+			fmt.Fprintf(opts.IO.StdOut, "- %s was not checked, as it comes after a conflict.\n", ShortSHA(commit.ID))
+		}
+	}
+
+	if conflict != nil {
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+func applyCommit(client *gitlab.Client, repo glrepo.Interface, op Operation, sha, branch string, dryRun bool) (*gitlab.Commit, error) {
+	var dryRunOpt *bool
+	if dryRun {
+		dryRunOpt = gitlab.Ptr(true)
+	}
+
+	if op == CherryPick {
+		return api.CherryPickCommit(client, repo.FullName(), sha, &gitlab.CherryPickCommitOptions{
+			Branch: gitlab.Ptr(branch),
+			DryRun: dryRunOpt,
+		})
+	}
+	return api.RevertCommit(client, repo.FullName(), sha, &api.RevertCommitOptions{
+		Branch: gitlab.Ptr(branch),
+		DryRun: dryRunOpt,
+	})
+}
+
+func createMROptions(opts *ApplyOptions, branch string) *gitlab.CreateMergeRequestOptions {
+	subject := opts.Subject
+	if subject == "" && opts.SourceMR != nil {
+		subject = opts.SourceMR.Title
+	}
+
+	var title string
+	var description strings.Builder
+	if opts.Operation == CherryPick {
+		title = fmt.Sprintf("Cherry-pick %q into %s", subject, opts.TargetBranch)
+	} else {
+		title = fmt.Sprintf("Revert %q", subject)
+	}
+
+	if opts.SourceMR != nil {
+		reference := fmt.Sprintf("!%d", opts.SourceMR.IID)
+		if opts.SourceMR.References != nil {
+			reference = opts.SourceMR.References.Full
+		}
+		if opts.Operation == CherryPick {
+			fmt.Fprintf(&description, "Cherry-picked from %s.\n\n", reference)
+		} else {
+			fmt.Fprintf(&description, "Reverts %s.\n\n", reference)
+		}
+	}
+	for _, commit := range opts.Commits {
+		fmt.Fprintf(&description, "- %s %s\n", commit.ID, commit.Title)
+	}
+
+	mrOpts := &gitlab.CreateMergeRequestOptions{
+		Title:              gitlab.Ptr(title),
+		Description:        gitlab.Ptr(description.String()),
+		SourceBranch:       gitlab.Ptr(branch),
+		TargetBranch:       gitlab.Ptr(opts.TargetBranch),
+		RemoveSourceBranch: gitlab.Ptr(true),
+	}
+	if opts.SourceMR != nil && len(opts.SourceMR.Labels) > 0 {
+		labels := gitlab.LabelOptions(opts.SourceMR.Labels)
+		mrOpts.Labels = &labels
+	}
+	return mrOpts
+}
+
+// isConflict reports whether the API refused to apply a commit, which it does with a
+// "400 Bad Request" when the commit does not apply cleanly or is already applied.
+func isConflict(err error) bool {
+	var errResponse *gitlab.ErrorResponse
+	return errors.As(err, &errResponse) &&
+		errResponse.Response != nil &&
+		errResponse.Response.StatusCode == http.StatusBadRequest
+}
+
+// errorMessage returns the message of an API error, without the request details.
+func errorMessage(err error) string {
+	var errResponse *gitlab.ErrorResponse
+	if !errors.As(err, &errResponse) {
+		return err.Error()
+	}
+
+	var body struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(errResponse.Body, &body) == nil && body.Message != "" {
+		return body.Message
+	}
+	if errResponse.Message != "" {
+		return errResponse.Message
+	}
+	return err.Error()
+}
+
+// ShortSHA abbreviates a commit SHA to 8 characters, like GitLab does.
+func ShortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
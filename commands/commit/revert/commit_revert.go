@@ -0,0 +1,79 @@
+package revert
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/commit/commitutils"
+)
+
+func NewCmdRevert(f *cmdutils.Factory) *cobra.Command {
+	opts := &commitutils.ApplyOptions{
+		Operation: commitutils.Revert,
+		IO:        f.IO,
+	}
+
+	commitRevertCmd := &cobra.Command{
+		Use:   "revert <sha> [flags]",
+		Short: `Revert a commit.`,
+		Long: heredoc.Doc(`
+			Revert a commit on a branch, through the API.
+
+			By default, the commit is reverted on the default branch of the project.
+			Use --create-mr to revert it on a new branch, and open a merge request. If the commit
+			was introduced by a merge request, its labels and a reference to it are copied to
+			the new merge request.
+		`),
+		Example: heredoc.Doc(`
+			glab commit revert 1a2b3c4d
+			glab commit revert 1a2b3c4d --target stable-16-0 --create-mr
+			glab commit revert 1a2b3c4d --dry-run
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			commit, err := api.GetCommit(apiClient, repo.FullName(), args[0])
+			if err != nil {
+				return err
+			}
+			opts.Commits = []*gitlab.Commit{commit}
+			opts.Subject = commit.Title
+
+			if opts.TargetBranch == "" {
+				project, err := api.GetProject(apiClient, repo.FullName())
+				if err != nil {
+					return err
+				}
+				opts.TargetBranch = project.DefaultBranch
+			}
+
+			if opts.CreateMR {
+				opts.SourceMR, err = commitutils.SourceMR(apiClient, repo, commit.ID)
+				if err != nil {
+					return err
+				}
+			}
+
+			return commitutils.Apply(apiClient, repo, opts)
+		},
+	}
+
+	commitRevertCmd.Flags().StringVarP(&opts.TargetBranch, "target", "t", "", "Branch to revert the commit on. Defaults to the default branch of the project.")
+	commitRevertCmd.Flags().BoolVar(&opts.CreateMR, "create-mr", false, "Revert on a new branch, and create a merge request into the target branch.")
+	commitRevertCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only check whether the commit can be reverted without conflicts.")
+	commitRevertCmd.MarkFlagsMutuallyExclusive("create-mr", "dry-run")
+
+	return commitRevertCmd
+}
@@ -0,0 +1,96 @@
+package revert
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdRevert(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestCommitRevertCreateMR(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/1a2b3c4d`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": "1a2b3c4d5e6f", "title": "Add feature"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "default_branch": "main"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/1a2b3c4d5e6f/merge_requests`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"iid": 7, "title": "Feature work", "state": "merged", "labels": ["feature"], "references": {"full": "OWNER/REPO!7"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/branches`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"name": "revert-1a2b3c4d-main"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/1a2b3c4d5e6f/revert`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "9f8e7d6c5b4a"}`))
+
+	var mrBody map[string]any
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/merge_requests`,
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &mrBody)
+			return httpmock.NewStringResponse(http.StatusCreated, `{"iid": 8, "web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/8"}`)(req)
+		})
+
+	output, err := runCommand(fakeHTTP, "1a2b3c4d --create-mr")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ Created branch revert-1a2b3c4d-main from main.
+		✓ Reverted 1a2b3c4d onto revert-1a2b3c4d-main as 9f8e7d6c.
+		✓ Created merge request !8.
+		https://gitlab.com/OWNER/REPO/-/merge_requests/8
+	`), output.String())
+
+	assert.Equal(t, `Revert "Add feature"`, mrBody["title"])
+	assert.Equal(t, "Reverts OWNER/REPO!7.\n\n- 1a2b3c4d5e6f Add feature\n", mrBody["description"])
+	assert.Equal(t, "feature", mrBody["labels"])
+	assert.Equal(t, "main", mrBody["target_branch"])
+}
@@ -0,0 +1,53 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+)
+
+func NewCmdClose(f *cmdutils.Factory) *cobra.Command {
+	epicCloseCmd := &cobra.Command{
+		Use:   "close <epic> [flags]",
+		Short: `Close an epic.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab epic close 12
+			glab epic close https://gitlab.com/groups/gitlab-org/-/epics/12
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			epic, group, err := epicutils.EpicFromArg(apiClient, f, cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			if epic.State == "closed" {
+				fmt.Fprintf(f.IO.StdErr, "%s Epic &%d is already closed.\n", c.WarnIcon(), epic.IID)
+				return nil
+			}
+
+			_, err = api.UpdateEpic(apiClient, group, epic.IID, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Closed epic &%d %s\n", c.RedCheck(), epic.IID, epic.Title)
+			return nil
+		},
+	}
+
+	return epicCloseCmd
+}
@@ -0,0 +1,99 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+)
+
+func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
+	epicCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an epic.`,
+		Long:    ``,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			glab epic create --title "Roadmap Q3"
+			glab epic create --title "New CLI commands" --parent 12 --label roadmap
+			glab epic create --title "Launch" --start-date 2024-07-01 --due-date 2024-09-30
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, _ := cmd.Flags().GetString("title")
+			l := &gitlab.CreateEpicOptions{
+				Title: gitlab.Ptr(title),
+			}
+
+			if s, _ := cmd.Flags().GetString("description"); s != "" {
+				l.Description = gitlab.Ptr(s)
+			}
+			if labels, _ := cmd.Flags().GetStringSlice("label"); len(labels) != 0 {
+				l.Labels = (*gitlab.LabelOptions)(&labels)
+			}
+			if confidential, _ := cmd.Flags().GetBool("confidential"); confidential {
+				l.Confidential = gitlab.Ptr(true)
+			}
+			if s, _ := cmd.Flags().GetString("start-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("start-date", s)
+				if err != nil {
+					return err
+				}
+				l.StartDateFixed = date
+				l.StartDateIsFixed = gitlab.Ptr(true)
+			}
+			if s, _ := cmd.Flags().GetString("due-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("due-date", s)
+				if err != nil {
+					return err
+				}
+				l.DueDateFixed = date
+				l.DueDateIsFixed = gitlab.Ptr(true)
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := flag.GroupOrRepoGroup(cmd, f.BaseRepo)
+			if err != nil {
+				return err
+			}
+
+			if s, _ := cmd.Flags().GetString("parent"); s != "" {
+				parent, _, err := epicutils.EpicFromArg(apiClient, f, cmd, s)
+				if err != nil {
+					return err
+				}
+				l.ParentID = gitlab.Ptr(parent.ID)
+			}
+
+			epic, err := api.CreateEpic(apiClient, group, l)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Created epic %s %s\n%s\n", c.GreenCheck(), epicutils.EpicState(c, epic), epic.Title, epic.WebURL)
+			return nil
+		},
+	}
+
+	epicCreateCmd.Flags().StringP("title", "t", "", "Title of the epic.")
+	_ = epicCreateCmd.MarkFlagRequired("title")
+	epicCreateCmd.Flags().StringP("description", "d", "", "Description of the epic.")
+	epicCreateCmd.Flags().StringSliceP("label", "l", []string{}, "Add labels to the epic. Multiple labels can be comma-separated or specified by repeating the flag.")
+	epicCreateCmd.Flags().BoolP("confidential", "c", false, "Set the epic as confidential.")
+	epicCreateCmd.Flags().String("parent", "", "Add the epic as a child of this epic.")
+	epicCreateCmd.Flags().String("start-date", "", "Fixed start date of the epic, in the YYYY-MM-DD format.")
+	epicCreateCmd.Flags().String("due-date", "", "Fixed due date of the epic, in the YYYY-MM-DD format.")
+
+	return epicCreateCmd
+}
@@ -0,0 +1,48 @@
+package epic
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	epicCloseCmd "gitlab.com/gitlab-org/cli/commands/epic/close"
+	epicCreateCmd "gitlab.com/gitlab-org/cli/commands/epic/create"
+	epicIssueCmd "gitlab.com/gitlab-org/cli/commands/epic/issue"
+	epicListCmd "gitlab.com/gitlab-org/cli/commands/epic/list"
+	epicUpdateCmd "gitlab.com/gitlab-org/cli/commands/epic/update"
+	epicViewCmd "gitlab.com/gitlab-org/cli/commands/epic/view"
+)
+
+func NewCmdEpic(f *cmdutils.Factory) *cobra.Command {
+	epicCmd := &cobra.Command{
+		Use:   "epic <command> [flags]",
+		Short: `Work with GitLab epics.`,
+		Long: heredoc.Doc(`
+			Work with the epics of a group. Epics belong to the group of the current
+			repository, unless you use the --group flag. Requires GitLab Premium or Ultimate.
+		`),
+		Example: heredoc.Doc(`
+			glab epic list
+			glab epic view &12
+			glab epic issue add 12 34 35
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				An epic can be supplied as argument by its number, with or without the '&' prefix,
+				or by its URL.
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(epicCmd, f)
+	epicCmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Defaults to the group of the current repository.")
+
+	epicCmd.AddCommand(epicListCmd.NewCmdList(f))
+	epicCmd.AddCommand(epicViewCmd.NewCmdView(f))
+	epicCmd.AddCommand(epicCreateCmd.NewCmdCreate(f))
+	epicCmd.AddCommand(epicUpdateCmd.NewCmdUpdate(f))
+	epicCmd.AddCommand(epicCloseCmd.NewCmdClose(f))
+	epicCmd.AddCommand(epicIssueCmd.NewCmdIssue(f))
+
+	return epicCmd
+}
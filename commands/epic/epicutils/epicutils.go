@@ -0,0 +1,90 @@
+package epicutils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+var epicURLPathRE = regexp.MustCompile(`^/groups/(.+)/-/epics/(\d+)`)
+
+// EpicFromArg returns an epic, and the path of its group, from its IID, which can be
+// prefixed with '&', or from its URL. Epics given by IID belong to the group of the
+// --group flag, or to the group of the current repository.
+func EpicFromArg(apiClient *gitlab.Client, f *cmdutils.Factory, cmd *cobra.Command, arg string) (*gitlab.Epic, string, error) {
+	group, iid, err := parseEpicArg(arg)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if group == "" {
+		group, err = flag.GroupOrRepoGroup(cmd, f.BaseRepo)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	epic, err := api.GetEpic(apiClient, group, iid)
+	if err != nil {
+		return nil, "", cmdutils.WrapError(err, fmt.Sprintf("failed to get epic &%d of group %s.", iid, group))
+	}
+	return epic, group, nil
+}
+
+func parseEpicArg(arg string) (string, int, error) {
+	if u, err := url.Parse(arg); err == nil && u.Scheme != "" {
+		m := epicURLPathRE.FindStringSubmatch(u.Path)
+		if m == nil {
+			return "", 0, fmt.Errorf("invalid epic URL: %q.", arg)
+		}
+		iid, _ := strconv.Atoi(m[2])
+		return m[1], iid, nil
+	}
+
+	iid, err := strconv.Atoi(strings.TrimPrefix(arg, "&"))
+	if err != nil || iid <= 0 {
+		return "", 0, fmt.Errorf("invalid epic: %q. Use the number of the epic, like &12, or its URL.", arg)
+	}
+	return "", iid, nil
+}
+
+// EpicState returns the reference of an epic, colored by its state.
+func EpicState(c *iostreams.ColorPalette, epic *gitlab.Epic) string {
+	if epic.State == "opened" {
+		return c.Green(fmt.Sprintf("&%d", epic.IID))
+	}
+	return c.Red(fmt.Sprintf("&%d", epic.IID))
+}
+
+func DisplayEpicList(streams *iostreams.IOStreams, epics []*gitlab.Epic) string {
+	c := streams.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(streams.IsOutputTTY())
+	for _, epic := range epics {
+		table.AddCell(streams.Hyperlink(EpicState(c, epic), epic.WebURL))
+		table.AddCell(epic.Title)
+
+		if len(epic.Labels) > 0 {
+			table.AddCellf("(%s)", c.Cyan(strings.Join(epic.Labels, ", ")))
+		} else {
+			table.AddCell("")
+		}
+
+		table.AddCell(c.Gray(utils.TimeToPrettyTimeAgo(*epic.CreatedAt)))
+		table.EndRow()
+	}
+
+	return table.Render()
+}
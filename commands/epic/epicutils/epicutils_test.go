@@ -0,0 +1,38 @@
+package epicutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseEpicArg(t *testing.T) {
+	tests := []struct {
+		arg       string
+		wantGroup string
+		wantIID   int
+		wantErr   string
+	}{
+		{arg: "12", wantIID: 12},
+		{arg: "&12", wantIID: 12},
+		{arg: "https://gitlab.com/groups/gitlab-org/-/epics/12", wantGroup: "gitlab-org", wantIID: 12},
+		{arg: "https://gitlab.example.com/groups/a/b/c/-/epics/7#note_1", wantGroup: "a/b/c", wantIID: 7},
+		{arg: "https://gitlab.com/gitlab-org/cli/-/issues/12", wantErr: `invalid epic URL: "https://gitlab.com/gitlab-org/cli/-/issues/12".`},
+		{arg: "abc", wantErr: `invalid epic: "abc". Use the number of the epic, like &12, or its URL.`},
+		{arg: "&0", wantErr: `invalid epic: "&0". Use the number of the epic, like &12, or its URL.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			group, iid, err := parseEpicArg(tt.arg)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantGroup, group)
+			assert.Equal(t, tt.wantIID, iid)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package issue
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+func NewCmdIssue(f *cmdutils.Factory) *cobra.Command {
+	epicIssueCmd := &cobra.Command{
+		Use:   "issue <command> [flags]",
+		Short: `Add issues to an epic, or remove them from it.`,
+		Long: heredoc.Doc(`
+			Add issues to an epic, or remove them from it. Issues are supplied by their number,
+			which belongs to the current repository or to the one of the --repo flag, or by their URL.
+		`),
+		Example: heredoc.Doc(`
+			glab epic issue add 12 34 35
+			glab epic issue add &12 https://gitlab.com/gitlab-org/cli/-/issues/34
+			glab epic issue remove 12 34
+		`),
+	}
+
+	epicIssueCmd.AddCommand(newCmdAdd(f))
+	epicIssueCmd.AddCommand(newCmdRemove(f))
+
+	return epicIssueCmd
+}
+
+func newCmdAdd(f *cmdutils.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add <epic> <issue> [<issue>...] [flags]",
+		Short: `Add issues to an epic.`,
+		Long: heredoc.Doc(`
+			Add issues to an epic. An issue can only belong to one epic, so issues that belong
+			to another epic are moved to this one.
+		`),
+		Example: heredoc.Doc(`
+			glab epic issue add 12 34
+			glab epic issue add 12 34,35,36
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			epic, group, err := epicutils.EpicFromArg(apiClient, f, cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, issue := range issues {
+				if err := api.AssignEpicIssue(apiClient, group, epic.IID, issue.ID); err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to add issue #%d to epic &%d.", issue.IID, epic.IID))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Added issue %s to epic %s\n", c.GreenCheck(), issueutils.IssueState(c, issue), epicutils.EpicState(c, epic))
+			}
+			return nil
+		},
+	}
+}
+
+func newCmdRemove(f *cmdutils.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove <epic> <issue> [<issue>...] [flags]",
+		Short:   `Remove issues from an epic.`,
+		Long:    ``,
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			glab epic issue remove 12 34
+			glab epic issue remove 12 34,35,36
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			epic, group, err := epicutils.EpicFromArg(apiClient, f, cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			issues, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			// removing an issue takes the ID of its link to the epic, which only the
+			// issues of the epic have.
+			epicIssues, err := api.ListEpicIssues(apiClient, group, epic.IID)
+			if err != nil {
+				return err
+			}
+			links := make(map[int]*gitlab.Issue, len(epicIssues))
+			for _, issue := range epicIssues {
+				links[issue.ID] = issue
+			}
+
+			c := f.IO.Color()
+			for _, issue := range issues {
+				link, ok := links[issue.ID]
+				if !ok {
+					return fmt.Errorf("issue #%d is not in epic &%d.", issue.IID, epic.IID)
+				}
+				if err := api.RemoveEpicIssue(apiClient, group, epic.IID, link.EpicIssueID); err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to remove issue #%d from epic &%d.", issue.IID, epic.IID))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Removed issue %s from epic %s\n", c.RedCheck(), issueutils.IssueState(c, issue), epicutils.EpicState(c, epic))
+			}
+			return nil
+		},
+	}
+}
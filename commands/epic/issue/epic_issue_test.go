@@ -0,0 +1,89 @@
+package issue
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdIssue(factory)
+	cmdutils.EnableRepoOverride(cmd, factory)
+	cmd.PersistentFlags().StringP("group", "g", "", "")
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestEpicIssueAdd(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Roadmap", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/34",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 234, "iid": 34, "title": "First issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/35",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 235, "iid": 35, "title": "Second issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/groups/OWNER/epics/12/issues/234",
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 1}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/groups/OWNER/epics/12/issues/235",
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 2}`))
+
+	output, err := runCommand(t, fakeHTTP, "add &12 34 35")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Added issue #34 to epic &12\n✓ Added issue #35 to epic &12\n", output.String())
+}
+
+func TestEpicIssueRemove(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Roadmap", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/34",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 234, "iid": 34, "title": "First issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/35",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 235, "iid": 35, "title": "Second issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 234, "iid": 34, "state": "opened", "epic_issue_id": 7},
+			{"id": 235, "iid": 35, "state": "opened", "epic_issue_id": 8}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/groups/OWNER/epics/12/issues/7",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 7}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/groups/OWNER/epics/12/issues/8",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 8}`))
+
+	output, err := runCommand(t, fakeHTTP, "remove 12 35,34")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed issue #35 from epic &12\n✓ Removed issue #34 from epic &12\n", output.String())
+}
+
+func TestEpicIssueRemoveNotInEpic(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Roadmap", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/34",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 234, "iid": 34, "title": "First issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	_, err := runCommand(t, fakeHTTP, "remove 12 34")
+	require.EqualError(t, err, "issue #34 is not in epic &12.")
+}
@@ -0,0 +1,116 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ListOptions struct {
+	State        string
+	Labels       []string
+	Author       string
+	Search       string
+	Page         int
+	PerPage      int
+	OutputFormat string
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &ListOptions{}
+
+	epicListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List epics of a group.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab epic list
+			glab epic list --state all --label roadmap
+			glab epic list --group gitlab-org --search "CLI"
+			glab epic list --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.State != "opened" && opts.State != "closed" && opts.State != "all" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--state must be one of: opened, closed, all.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := flag.GroupOrRepoGroup(cmd, f.BaseRepo)
+			if err != nil {
+				return err
+			}
+
+			l := &gitlab.ListGroupEpicsOptions{
+				State: gitlab.Ptr(opts.State),
+			}
+			l.Page = opts.Page
+			l.PerPage = opts.PerPage
+			if len(opts.Labels) != 0 {
+				l.Labels = (*gitlab.LabelOptions)(&opts.Labels)
+			}
+			if opts.Search != "" {
+				l.Search = gitlab.Ptr(opts.Search)
+			}
+			if opts.Author != "" {
+				author, err := api.UserByName(apiClient, opts.Author)
+				if err != nil {
+					return err
+				}
+				l.AuthorID = gitlab.Ptr(author.ID)
+			}
+
+			epics, err := api.ListGroupEpics(apiClient, group, l)
+			if err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				epicsJSON, _ := json.Marshal(epics)
+				fmt.Fprintln(f.IO.StdOut, string(epicsJSON))
+				return nil
+			}
+
+			title := utils.NewListTitle(fmt.Sprintf("%s epic", opts.State))
+			if opts.State == "all" {
+				title = utils.NewListTitle("epic")
+			}
+			title.RepoName = group
+			title.Page = opts.Page
+			title.CurrentPageTotal = len(epics)
+
+			err = f.IO.StartPager()
+			if err != nil {
+				return err
+			}
+			defer f.IO.StopPager()
+
+			fmt.Fprintf(f.IO.StdOut, "%s\n%s\n", title.Describe(), epicutils.DisplayEpicList(f.IO, epics))
+			return nil
+		},
+	}
+
+	epicListCmd.Flags().StringVarP(&opts.State, "state", "s", "opened", "List epics in this state: opened, closed, all.")
+	epicListCmd.Flags().StringSliceVarP(&opts.Labels, "label", "l", []string{}, "List epics with these labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	epicListCmd.Flags().StringVarP(&opts.Author, "author", "a", "", "List epics created by this user. Use @me for yourself.")
+	epicListCmd.Flags().StringVar(&opts.Search, "search", "", "List epics whose title or description contains this text.")
+	epicListCmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number.")
+	epicListCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
+	epicListCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return epicListCmd
+}
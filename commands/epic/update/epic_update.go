@@ -0,0 +1,130 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+)
+
+func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	epicUpdateCmd := &cobra.Command{
+		Use:   "update <epic> [flags]",
+		Short: `Update an epic.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab epic update 12 --title "Roadmap Q4" --label roadmap --unlabel draft
+			glab epic update 12 --parent 10
+			glab epic update &12 --due-date 2024-12-20
+			glab epic update 12 --reopen
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("confidential") && cmd.Flags().Changed("public") {
+				return &cmdutils.FlagError{Err: errors.New("--public and --confidential can't be used together.")}
+			}
+
+			l := &gitlab.UpdateEpicOptions{}
+			var changed bool
+
+			if s, _ := cmd.Flags().GetString("title"); s != "" {
+				l.Title = gitlab.Ptr(s)
+				changed = true
+			}
+			if cmd.Flags().Changed("description") {
+				s, _ := cmd.Flags().GetString("description")
+				l.Description = gitlab.Ptr(s)
+				changed = true
+			}
+			if labels, _ := cmd.Flags().GetStringSlice("label"); len(labels) != 0 {
+				l.AddLabels = (*gitlab.LabelOptions)(&labels)
+				changed = true
+			}
+			if labels, _ := cmd.Flags().GetStringSlice("unlabel"); len(labels) != 0 {
+				l.RemoveLabels = (*gitlab.LabelOptions)(&labels)
+				changed = true
+			}
+			if confidential, _ := cmd.Flags().GetBool("confidential"); confidential {
+				l.Confidential = gitlab.Ptr(true)
+				changed = true
+			}
+			if public, _ := cmd.Flags().GetBool("public"); public {
+				l.Confidential = gitlab.Ptr(false)
+				changed = true
+			}
+			if s, _ := cmd.Flags().GetString("start-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("start-date", s)
+				if err != nil {
+					return err
+				}
+				l.StartDateFixed = date
+				l.StartDateIsFixed = gitlab.Ptr(true)
+				changed = true
+			}
+			if s, _ := cmd.Flags().GetString("due-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("due-date", s)
+				if err != nil {
+					return err
+				}
+				l.DueDateFixed = date
+				l.DueDateIsFixed = gitlab.Ptr(true)
+				changed = true
+			}
+			if reopen, _ := cmd.Flags().GetBool("reopen"); reopen {
+				l.StateEvent = gitlab.Ptr("reopen")
+				changed = true
+			}
+			parentArg, _ := cmd.Flags().GetString("parent")
+
+			if !changed && parentArg == "" {
+				return &cmdutils.FlagError{Err: errors.New("no changes to apply. Use flags like '--title' or '--label'.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			epic, group, err := epicutils.EpicFromArg(apiClient, f, cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			if parentArg != "" {
+				parent, _, err := epicutils.EpicFromArg(apiClient, f, cmd, parentArg)
+				if err != nil {
+					return err
+				}
+				l.ParentID = gitlab.Ptr(parent.ID)
+			}
+
+			epic, err = api.UpdateEpic(apiClient, group, epic.IID, l)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Updated epic %s %s\n%s\n", c.GreenCheck(), epicutils.EpicState(c, epic), epic.Title, epic.WebURL)
+			return nil
+		},
+	}
+
+	epicUpdateCmd.Flags().StringP("title", "t", "", "Title of the epic.")
+	epicUpdateCmd.Flags().StringP("description", "d", "", "Description of the epic.")
+	epicUpdateCmd.Flags().StringSliceP("label", "l", []string{}, "Add labels to the epic. Multiple labels can be comma-separated or specified by repeating the flag.")
+	epicUpdateCmd.Flags().StringSliceP("unlabel", "u", []string{}, "Remove labels from the epic.")
+	epicUpdateCmd.Flags().BoolP("confidential", "c", false, "Set the epic as confidential.")
+	epicUpdateCmd.Flags().BoolP("public", "p", false, "Set the epic as public.")
+	epicUpdateCmd.Flags().String("parent", "", "Move the epic under this parent epic.")
+	epicUpdateCmd.Flags().String("start-date", "", "Fixed start date of the epic, in the YYYY-MM-DD format.")
+	epicUpdateCmd.Flags().String("due-date", "", "Fixed due date of the epic, in the YYYY-MM-DD format.")
+	epicUpdateCmd.Flags().Bool("reopen", false, "Reopen a closed epic.")
+
+	return epicUpdateCmd
+}
@@ -0,0 +1,212 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ViewOptions struct {
+	Web          bool
+	OutputFormat string
+}
+
+// EpicNode is an epic with its child epics and child issues.
+type EpicNode struct {
+	*gitlab.Epic
+	Issues   []*gitlab.Issue `json:"issues"`
+	Children []*EpicNode     `json:"children"`
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	opts := &ViewOptions{}
+
+	epicViewCmd := &cobra.Command{
+		Use:   "view <epic> [flags]",
+		Short: `Display an epic, and its child epics and issues as a tree.`,
+		Long: heredoc.Doc(`
+			Display the title, description, and other information about an epic, followed
+			by the tree of its child epics and issues. Child epics of other groups are included.
+		`),
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab epic view 12
+			glab epic view &12 --group gitlab-org
+			glab epic view https://gitlab.com/groups/gitlab-org/-/epics/12
+			glab epic view 12 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			epic, group, err := epicutils.EpicFromArg(apiClient, f, cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			if opts.Web {
+				if f.IO.IsaTTY && f.IO.IsErrTTY {
+					fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(epic.WebURL))
+				}
+
+				cfg, _ := f.Config()
+				browser, _ := cfg.Get("", "browser")
+				return utils.OpenInBrowser(epic.WebURL, browser)
+			}
+
+			tree, err := epicTree(apiClient, group, epic, map[int]bool{})
+			if err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				treeJSON, _ := json.Marshal(tree)
+				fmt.Fprintln(f.IO.StdOut, string(treeJSON))
+				return nil
+			}
+
+			err = f.IO.StartPager()
+			if err != nil {
+				return err
+			}
+			defer f.IO.StopPager()
+
+			printEpic(f.IO, tree)
+			return nil
+		},
+	}
+
+	epicViewCmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open epic in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	epicViewCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return epicViewCmd
+}
+
+// epicTree fetches the child epics and issues of an epic, recursively. Child epics can
+// belong to subgroups, so they are fetched from the group of their parent.
+func epicTree(apiClient *gitlab.Client, groupID interface{}, epic *gitlab.Epic, seen map[int]bool) (*EpicNode, error) {
+	seen[epic.ID] = true
+	node := &EpicNode{Epic: epic}
+
+	var err error
+	node.Issues, err = api.ListEpicIssues(apiClient, groupID, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := api.ListChildEpics(apiClient, groupID, epic.IID)
+	if err != nil {
+		return nil, err
+	}
+	node.Children = make([]*EpicNode, 0, len(children))
+	for _, child := range children {
+		if seen[child.ID] {
+			continue
+		}
+		childNode, err := epicTree(apiClient, child.GroupID, child, seen)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+
+	return node, nil
+}
+
+func printEpic(io *iostreams.IOStreams, node *EpicNode) {
+	c := io.Color()
+	epic := node.Epic
+
+	state := c.Green("Open")
+	if epic.State != "opened" {
+		state = c.Red("Closed")
+	}
+	fmt.Fprint(io.StdOut, state)
+	if epic.Author != nil {
+		fmt.Fprintf(io.StdOut, c.Gray(" • opened by %s"), epic.Author.Username)
+	}
+	if epic.CreatedAt != nil {
+		fmt.Fprintf(io.StdOut, c.Gray(" %s"), utils.TimeToPrettyTimeAgo(*epic.CreatedAt))
+	}
+	fmt.Fprintln(io.StdOut)
+	fmt.Fprintf(io.StdOut, "%s %s\n", c.Bold(epic.Title), c.Gray(fmt.Sprintf("&%d", epic.IID)))
+
+	if epic.Description != "" {
+		fmt.Fprintf(io.StdOut, "\n%s\n", epic.Description)
+	}
+
+	fmt.Fprintln(io.StdOut)
+	if len(epic.Labels) > 0 {
+		fmt.Fprintf(io.StdOut, "%s %s\n", c.Bold("Labels:"), strings.Join(epic.Labels, ", "))
+	}
+	if epic.StartDate != nil || epic.DueDate != nil {
+		fmt.Fprintf(io.StdOut, "%s %s to %s\n", c.Bold("Dates:"), formatDate(epic.StartDate), formatDate(epic.DueDate))
+	}
+
+	if len(node.Children) > 0 || len(node.Issues) > 0 {
+		fmt.Fprintf(io.StdOut, "\n%s\n", c.Bold("Child epics and issues:"))
+		fmt.Fprint(io.StdOut, renderTree(c, node, ""))
+	} else {
+		fmt.Fprintln(io.StdOut, c.Gray("This epic has no child epics or issues."))
+	}
+
+	fmt.Fprintf(io.StdOut, c.Gray("\nView this epic on GitLab: %s\n"), epic.WebURL)
+}
+
+// renderTree renders the child epics, then the child issues of an epic, one per line.
+func renderTree(c *iostreams.ColorPalette, node *EpicNode, prefix string) string {
+	var b strings.Builder
+
+	count := len(node.Children) + len(node.Issues)
+	i := 0
+	branch := func() (string, string) {
+		i++
+		if i == count {
+			return prefix + "└── ", prefix + "    "
+		}
+		return prefix + "├── ", prefix + "│   "
+	}
+
+	for _, child := range node.Children {
+		line, childPrefix := branch()
+		fmt.Fprintf(&b, "%s%s %s\n", line, epicutils.EpicState(c, child.Epic), child.Title)
+		b.WriteString(renderTree(c, child, childPrefix))
+	}
+	for _, issue := range node.Issues {
+		line, _ := branch()
+		fmt.Fprintf(&b, "%s%s %s\n", line, issueReference(c, issue), issue.Title)
+	}
+
+	return b.String()
+}
+
+func issueReference(c *iostreams.ColorPalette, issue *gitlab.Issue) string {
+	reference := fmt.Sprintf("#%d", issue.IID)
+	if issue.References != nil && issue.References.Full != "" {
+		reference = issue.References.Full
+	}
+	if issue.State == "opened" {
+		return c.Green(reference)
+	}
+	return c.Red(reference)
+}
+
+func formatDate(date *gitlab.ISOTime) string {
+	if date == nil {
+		return "-"
+	}
+	return date.String()
+}
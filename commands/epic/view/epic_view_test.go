@@ -0,0 +1,102 @@
+package view
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdView(factory)
+	cmdutils.EnableRepoOverride(cmd, factory)
+	cmd.Flags().StringP("group", "g", "", "")
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestEpicView(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "group_id": 1, "title": "Roadmap", "state": "opened",
+			"description": "Everything planned.", "labels": ["roadmap"], "start_date": "2024-07-01", "due_date": "2024-09-30",
+			"web_url": "https://gitlab.com/groups/OWNER/-/epics/12"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 201, "iid": 1, "title": "First issue", "state": "closed", "references": {"full": "OWNER/REPO#1"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 113, "iid": 13, "group_id": 5, "title": "Child epic", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/epics/13/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 202, "iid": 2, "title": "Second issue", "state": "opened", "references": {"full": "OWNER/sub/REPO#2"}},
+			{"id": 203, "iid": 3, "title": "Third issue", "state": "opened", "references": {"full": "OWNER/sub/REPO#3"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/epics/13/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "&12")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "Roadmap &12\n\nEverything planned.\n")
+	assert.Contains(t, output.String(), "Labels: roadmap\nDates: 2024-07-01 to 2024-09-30\n")
+	assert.Contains(t, output.String(), heredoc.Doc(`
+		Child epics and issues:
+		├── &13 Child epic
+		│   ├── OWNER/sub/REPO#2 Second issue
+		│   └── OWNER/sub/REPO#3 Third issue
+		└── OWNER/REPO#1 First issue
+	`))
+	assert.Contains(t, output.String(), "View this epic on GitLab: https://gitlab.com/groups/OWNER/-/epics/12")
+	assert.Empty(t, output.Stderr())
+}
+
+func TestEpicViewJSON(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "group_id": 1, "title": "Roadmap", "state": "opened",
+			"description": "Everything planned.", "labels": ["roadmap"], "start_date": "2024-07-01", "due_date": "2024-09-30",
+			"web_url": "https://gitlab.com/groups/OWNER/-/epics/12"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 201, "iid": 1, "title": "First issue", "state": "closed", "references": {"full": "OWNER/REPO#1"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/epics/12/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 113, "iid": 13, "group_id": 5, "title": "Child epic", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/epics/13/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 202, "iid": 2, "title": "Second issue", "state": "opened", "references": {"full": "OWNER/sub/REPO#2"}},
+			{"id": 203, "iid": 3, "title": "Third issue", "state": "opened", "references": {"full": "OWNER/sub/REPO#3"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/5/epics/13/epics",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "12 --output json")
+	require.NoError(t, err)
+
+	var tree EpicNode
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &tree))
+	assert.Equal(t, 12, tree.IID)
+	assert.Len(t, tree.Issues, 1)
+	require.Len(t, tree.Children, 1)
+	assert.Equal(t, "Child epic", tree.Children[0].Title)
+	assert.Len(t, tree.Children[0].Issues, 2)
+}
@@ -0,0 +1,73 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+func NewCmdDelete(f *cmdutils.Factory) *cobra.Command {
+	var command string
+	var global bool
+
+	filterDeleteCmd := &cobra.Command{
+		Use:   "delete <name> [flags]",
+		Short: `Delete a saved filter.`,
+		Long: heredoc.Doc(`
+			Delete a saved filter. The filter of the repository is deleted, if there is one,
+			otherwise the global filter.
+		`),
+		Example: heredoc.Doc(`
+			glab filter delete triage
+			glab filter delete triage --command mr --global
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			scopes := []bool{true, false}
+			if global {
+				scopes = []bool{false}
+			}
+
+			for _, local := range scopes {
+				filters, err := config.ReadFilters(local)
+				if err != nil {
+					return err
+				}
+
+				var commands []string
+				for _, c := range filters.Commands() {
+					if _, ok := filters.Get(c, name); ok && (command == "" || c == command) {
+						commands = append(commands, c)
+					}
+				}
+				switch len(commands) {
+				case 0:
+					continue
+				case 1:
+				default:
+					return &cmdutils.FlagError{Err: fmt.Errorf("filter %q is saved for several commands: %v. Select one with --command.", name, commands)}
+				}
+
+				err = filters.Delete(commands[0], name)
+				if err != nil {
+					return fmt.Errorf("failed to delete filter %q: %w", name, err)
+				}
+				fmt.Fprintf(f.IO.StdErr, "%s Deleted %s filter %q.\n", f.IO.Color().RedCheck(), commands[0], name)
+				return nil
+			}
+
+			return fmt.Errorf("no saved filter %q.", name)
+		},
+	}
+
+	filterDeleteCmd.Flags().StringVarP(&command, "command", "c", "", "Delete the filter of this list command: issue, incident, or mr.")
+	filterDeleteCmd.Flags().BoolVarP(&global, "global", "g", false, "Delete the filter from the global config file.")
+
+	return filterDeleteCmd
+}
@@ -0,0 +1,37 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+func TestFilterDelete(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	filters, err := config.ReadFilters(false)
+	require.NoError(t, err)
+	require.NoError(t, filters.Set("issue", "mine", config.Filter{"assignee": "@me"}))
+	require.NoError(t, filters.Set("mr", "mine", config.Filter{"assignee": "@me"}))
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, nil)
+
+	_, err = cmdtest.ExecuteCommand(NewCmdDelete(factory), "mine", stdout, stderr)
+	assert.EqualError(t, err, `filter "mine" is saved for several commands: [issue mr]. Select one with --command.`)
+
+	output, err := cmdtest.ExecuteCommand(NewCmdDelete(factory), "mine --command mr", stdout, stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Deleted mr filter \"mine\".\n", output.Stderr())
+
+	filters, err = config.ReadFilters(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"issue"}, filters.Commands())
+
+	_, err = cmdtest.ExecuteCommand(NewCmdDelete(factory), "unknown", stdout, stderr)
+	assert.EqualError(t, err, `no saved filter "unknown".`)
+}
@@ -0,0 +1,46 @@
+package filter
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	filterDeleteCmd "gitlab.com/gitlab-org/cli/commands/filter/delete"
+	filterListCmd "gitlab.com/gitlab-org/cli/commands/filter/list"
+)
+
+func NewCmdFilter(f *cmdutils.Factory) *cobra.Command {
+	filterCmd := &cobra.Command{
+		Use:   "filter <command> [flags]",
+		Short: `List and delete the saved filters of list commands.`,
+		Long: heredoc.Doc(`
+			Saved filters are named sets of flags for 'glab issue list', 'glab incident list'
+			and 'glab mr list'. Save the filter flags of a list command with '--save-filter <name>',
+			and apply them later with '--filter <name>'. Flags on the command line override
+			the flags of the filter.
+
+			Filters are saved in the repository's '.git/glab-cli/filters.yml' file, or with
+			'--global-filter' in the global 'filters.yml' file. Filters of the repository take
+			precedence over global filters with the same name.
+
+			The values of a filter can use these placeholders. They are saved as they are, and
+			replaced each time the filter is applied:
+
+			- '@me': your username.
+			- 'current-milestone': the title of the active milestone that has started and is not yet due.
+
+			'current-milestone' can also be used with '--milestone' on the command line.
+		`),
+		Example: heredoc.Doc(`
+			glab issue list --label bug --assignee @me --milestone current-milestone --save-filter triage
+			glab issue list --filter triage --closed
+			glab filter list
+			glab filter delete triage
+		`),
+	}
+
+	filterCmd.AddCommand(filterListCmd.NewCmdList(f))
+	filterCmd.AddCommand(filterDeleteCmd.NewCmdDelete(f))
+
+	return filterCmd
+}
@@ -0,0 +1,267 @@
+package filterutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// Placeholders that a saved filter can use in place of a value. They are replaced when the filter is applied.
+const (
+	PlaceholderMe               = "@me"
+	PlaceholderCurrentMilestone = "current-milestone"
+)
+
+// Flags that manage saved filters, and flags that don't filter, are never saved in a filter.
+var unsavedFlags = map[string]bool{
+	"save-filter":   true,
+	"filter":        true,
+	"global-filter": true,
+	"repo":          true,
+	"help":          true,
+}
+
+// placeholderFlags are the flags whose placeholders are replaced when they are set on the command line.
+// The list commands replace '@me' in the flags of users themselves.
+var placeholderFlags = []string{"milestone"}
+
+// AddFlags adds the --save-filter, --filter and --global-filter flags to a list command.
+func AddFlags(cmd *cobra.Command) {
+	cmd.Flags().String("save-filter", "", "Save the filter flags of this command as the filter <name>.")
+	cmd.Flags().String("filter", "", "Apply the saved filter <name>. Flags on the command line override the saved ones.")
+	cmd.Flags().Bool("global-filter", false, "Save the filter in the global config file, rather than in the repository's '.git/glab-cli/filters.yml' file.")
+}
+
+// Process saves the filter of the --save-filter flag, then applies the filter of the --filter
+// flag, and replaces the placeholders of the flags set on the command line. Filters are saved with
+// their placeholders, so they are replaced again each time the filter is applied.
+// command is the name of the saved filters' list, like issue or mr.
+func Process(f *cmdutils.Factory, cmd *cobra.Command, command string) error {
+	flags := cmd.Flags()
+	r := &resolver{f: f, cmd: cmd}
+
+	if name, _ := flags.GetString("save-filter"); name != "" {
+		global, _ := flags.GetBool("global-filter")
+		err := Save(flags, command, name, !global)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(f.IO.StdErr, "%s Saved filter %q.\n", f.IO.Color().GreenCheck(), name)
+	}
+
+	if name, _ := flags.GetString("filter"); name != "" {
+		filter, err := Load(command, name)
+		if err != nil {
+			return err
+		}
+		err = r.apply(name, filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.expandFlags()
+}
+
+// Save saves the flags set on the command line as a filter.
+func Save(flags *pflag.FlagSet, command, name string, local bool) error {
+	filter := config.Filter{}
+	flags.Visit(func(fl *pflag.Flag) {
+		if unsavedFlags[fl.Name] {
+			return
+		}
+		if v, ok := fl.Value.(pflag.SliceValue); ok {
+			filter[fl.Name] = strings.Join(v.GetSlice(), ",")
+			return
+		}
+		filter[fl.Name] = fl.Value.String()
+	})
+	if len(filter) == 0 {
+		return &cmdutils.FlagError{Err: fmt.Errorf("no filter flags to save as %q.", name)}
+	}
+
+	filters, err := config.ReadFilters(local)
+	if err != nil {
+		return err
+	}
+	return filters.Set(command, name, filter)
+}
+
+// Load returns a saved filter. Filters of the current repository take precedence over global filters.
+func Load(command, name string) (config.Filter, error) {
+	for _, local := range []bool{true, false} {
+		filters, err := config.ReadFilters(local)
+		if err != nil {
+			return nil, err
+		}
+		if filter, ok := filters.Get(command, name); ok {
+			return filter, nil
+		}
+	}
+	return nil, fmt.Errorf("no saved %s filter %q. Use 'glab filter list' to see the saved filters.", command, name)
+}
+
+// resolver replaces placeholders, fetching each value at most once.
+type resolver struct {
+	f   *cmdutils.Factory
+	cmd *cobra.Command
+
+	username  string
+	milestone string
+}
+
+// apply sets the flags of a filter that are not set on the command line, replacing its placeholders.
+func (r *resolver) apply(name string, filter config.Filter) error {
+	flags := r.cmd.Flags()
+
+	keys := make([]string, 0, len(filter))
+	for key := range filter {
+		keys = append(keys, key)
+	}
+	// --group is set first, as it selects the milestones of current-milestone.
+	sort.Slice(keys, func(i, j int) bool {
+		if (keys[i] == "group") != (keys[j] == "group") {
+			return keys[i] == "group"
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, key := range keys {
+		if flags.Lookup(key) == nil {
+			return fmt.Errorf("filter %q sets the unknown flag --%s.", name, key)
+		}
+		if flags.Changed(key) {
+			continue
+		}
+		value, err := r.expand(filter[key])
+		if err != nil {
+			return err
+		}
+		err = flags.Set(key, value)
+		if err != nil {
+			return fmt.Errorf("filter %q sets an invalid --%s: %w", name, key, err)
+		}
+	}
+	return nil
+}
+
+// expandFlags replaces the placeholders in the values of the placeholderFlags that are set.
+func (r *resolver) expandFlags() error {
+	for _, name := range placeholderFlags {
+		fl := r.cmd.Flags().Lookup(name)
+		if fl == nil || !fl.Changed {
+			continue
+		}
+		value := fl.Value.String()
+		expanded, err := r.expand(value)
+		if err != nil {
+			return err
+		}
+		if expanded != value {
+			if err := fl.Value.Set(expanded); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *resolver) expand(value string) (string, error) {
+	parts := strings.Split(value, ",")
+	for i, part := range parts {
+		var err error
+		switch strings.TrimSpace(part) {
+		case PlaceholderMe:
+			parts[i], err = r.currentUsername()
+		case PlaceholderCurrentMilestone:
+			parts[i], err = r.currentMilestone()
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(parts, ","), nil
+}
+
+func (r *resolver) currentUsername() (string, error) {
+	if r.username != "" {
+		return r.username, nil
+	}
+	client, err := r.f.HttpClient()
+	if err != nil {
+		return "", err
+	}
+	u, err := api.CurrentUser(client)
+	if err != nil {
+		return "", err
+	}
+	r.username = u.Username
+	return r.username, nil
+}
+
+func (r *resolver) currentMilestone() (string, error) {
+	if r.milestone != "" {
+		return r.milestone, nil
+	}
+	client, err := r.f.HttpClient()
+	if err != nil {
+		return "", err
+	}
+	scope, err := milestoneutils.NewScope(r.f, r.cmd)
+	if err != nil {
+		return "", err
+	}
+	milestones, err := scope.List(client, &api.ListMilestonesOptions{State: gitlab.Ptr("active")})
+	if err != nil {
+		return "", err
+	}
+	m := CurrentMilestone(milestones, time.Now())
+	if m == nil {
+		return "", fmt.Errorf("no current milestone in %s.", scope)
+	}
+	r.milestone = m.Title
+	return r.milestone, nil
+}
+
+// CurrentMilestone returns the milestone that has started and is not yet due on a day.
+// When several milestones are in progress, the one due first is returned.
+func CurrentMilestone(milestones []*gitlab.Milestone, now time.Time) *gitlab.Milestone {
+	today := now.Format(time.DateOnly)
+
+	var current *gitlab.Milestone
+	for _, m := range milestones {
+		if m.StartDate != nil && m.StartDate.String() > today {
+			continue
+		}
+		if m.DueDate != nil && m.DueDate.String() < today {
+			continue
+		}
+		if m.StartDate == nil && m.DueDate == nil {
+			continue
+		}
+		if current == nil || dueBefore(m, current) {
+			current = m
+		}
+	}
+	return current
+}
+
+func dueBefore(a, b *gitlab.Milestone) bool {
+	switch {
+	case a.DueDate == nil:
+		return false
+	case b.DueDate == nil:
+		return true
+	}
+	return a.DueDate.String() < b.DueDate.String()
+}
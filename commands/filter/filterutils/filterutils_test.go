@@ -0,0 +1,62 @@
+package filterutils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func isoDate(t *testing.T, date string) *gitlab.ISOTime {
+	t.Helper()
+
+	d, err := gitlab.ParseISOTime(date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &d
+}
+
+func TestCurrentMilestone(t *testing.T) {
+	now := time.Date(2024, time.March, 15, 10, 0, 0, 0, time.UTC)
+
+	past := &gitlab.Milestone{Title: "past", StartDate: isoDate(t, "2024-01-01"), DueDate: isoDate(t, "2024-01-31")}
+	future := &gitlab.Milestone{Title: "future", StartDate: isoDate(t, "2024-04-01")}
+	undated := &gitlab.Milestone{Title: "undated"}
+	quarter := &gitlab.Milestone{Title: "quarter", StartDate: isoDate(t, "2024-01-01"), DueDate: isoDate(t, "2024-03-31")}
+	sprint := &gitlab.Milestone{Title: "sprint", StartDate: isoDate(t, "2024-03-11"), DueDate: isoDate(t, "2024-03-15")}
+	open := &gitlab.Milestone{Title: "open", StartDate: isoDate(t, "2024-03-01")}
+
+	tests := []struct {
+		name       string
+		milestones []*gitlab.Milestone
+		want       *gitlab.Milestone
+	}{
+		{
+			name:       "none in progress",
+			milestones: []*gitlab.Milestone{past, future, undated},
+		},
+		{
+			name:       "in progress",
+			milestones: []*gitlab.Milestone{past, quarter, future},
+			want:       quarter,
+		},
+		{
+			name:       "due first",
+			milestones: []*gitlab.Milestone{open, quarter, sprint},
+			want:       sprint,
+		},
+		{
+			name:       "without due date",
+			milestones: []*gitlab.Milestone{undated, open},
+			want:       open,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CurrentMilestone(tt.milestones, now))
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+// SavedFilter is a saved filter, as listed in JSON.
+type SavedFilter struct {
+	Name    string        `json:"name"`
+	Command string        `json:"command"`
+	Scope   string        `json:"scope"`
+	Flags   config.Filter `json:"flags"`
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	filterListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the saved filters.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab filter list
+			glab filter list --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var saved []SavedFilter
+			for _, local := range []bool{true, false} {
+				filters, err := config.ReadFilters(local)
+				if err != nil {
+					return err
+				}
+				for _, command := range filters.Commands() {
+					for _, name := range filters.Names(command) {
+						filter, _ := filters.Get(command, name)
+						saved = append(saved, SavedFilter{
+							Name:    name,
+							Command: command,
+							Scope:   scope(local),
+							Flags:   filter,
+						})
+					}
+				}
+			}
+
+			if outputFormat == "json" {
+				savedJSON, _ := json.Marshal(saved)
+				fmt.Fprintln(f.IO.StdOut, string(savedJSON))
+				return nil
+			}
+
+			if len(saved) == 0 {
+				fmt.Fprintln(f.IO.StdErr, "No saved filters. Save one with the --save-filter flag of 'glab issue list' or 'glab mr list'.")
+				return nil
+			}
+
+			c := f.IO.Color()
+			table := tableprinter.NewTablePrinter()
+			table.AddRow(c.Bold("NAME"), c.Bold("COMMAND"), c.Bold("SCOPE"), c.Bold("FLAGS"))
+			for _, s := range saved {
+				table.AddRow(s.Name, s.Command, c.Gray(s.Scope), FormatFlags(s.Flags))
+			}
+			fmt.Fprint(f.IO.StdOut, table.Render())
+			return nil
+		},
+	}
+
+	filterListCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return filterListCmd
+}
+
+func scope(local bool) string {
+	if local {
+		return "local"
+	}
+	return "global"
+}
+
+// FormatFlags formats the flags of a filter as they are written on the command line.
+func FormatFlags(filter config.Filter) string {
+	keys := make([]string, 0, len(filter))
+	for key := range filter {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	flags := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if filter[key] == "true" {
+			flags = append(flags, "--"+key)
+			continue
+		}
+		flags = append(flags, fmt.Sprintf("--%s=%q", key, filter[key]))
+	}
+	return strings.Join(flags, " ")
+}
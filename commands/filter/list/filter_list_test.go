@@ -0,0 +1,37 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+func TestFilterList(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, nil)
+
+	output, err := cmdtest.ExecuteCommand(NewCmdList(factory), "", stdout, stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Contains(t, output.Stderr(), "No saved filters.")
+
+	filters, err := config.ReadFilters(false)
+	require.NoError(t, err)
+	require.NoError(t, filters.Set("mr", "review", config.Filter{"reviewer": "@me", "not-draft": "true"}))
+	require.NoError(t, filters.Set("issue", "triage", config.Filter{"label": "bug,needs triage", "milestone": "current-milestone"}))
+
+	output, err = cmdtest.ExecuteCommand(NewCmdList(factory), "", stdout, stderr)
+	require.NoError(t, err)
+	assert.Equal(t, heredoc.Doc(`
+		NAME	COMMAND	SCOPE	FLAGS
+		triage	issue	global	--label="bug,needs triage" --milestone="current-milestone"
+		review	mr	global	--not-draft --reviewer="@me"
+	`), output.String())
+}
@@ -3,6 +3,8 @@ package flag
 import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 )
 
 func GroupOverride(cmd *cobra.Command) (string, error) {
@@ -39,3 +41,18 @@ func GroupOverride(cmd *cobra.Command) (string, error) {
 		return groupFromEnv, nil
 	}
 }
+
+// GroupOrRepoGroup returns the group of GroupOverride or, if there is none, the group
+// or namespace of the current repository.
+func GroupOrRepoGroup(cmd *cobra.Command, baseRepo func() (glrepo.Interface, error)) (string, error) {
+	group, err := GroupOverride(cmd)
+	if err != nil || group != "" {
+		return group, err
+	}
+
+	repo, err := baseRepo()
+	if err != nil {
+		return "", err
+	}
+	return repo.RepoOwner(), nil
+}
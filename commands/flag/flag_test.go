@@ -148,3 +148,23 @@ func TestGroupOverride(t *testing.T) {
 		assert.Equal(t, gotRepo.FullName(), "OWNER2/REPO2")
 	})
 }
+
+func TestGroupOrRepoGroup(t *testing.T) {
+	t.Setenv("GITLAB_GROUP", "")
+
+	baseRepo := func() (glrepo.Interface, error) {
+		return glrepo.New("OWNER", "REPO"), nil
+	}
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("group", "g", "", "")
+	cmd.Flags().StringP("repo", "R", "", "")
+
+	group, err := GroupOrRepoGroup(cmd, baseRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "OWNER", group)
+
+	assert.NoError(t, cmd.Flags().Set("group", "GROUP/SUBGROUP"))
+	group, err = GroupOrRepoGroup(cmd, baseRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "GROUP/SUBGROUP", group)
+}
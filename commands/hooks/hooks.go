@@ -0,0 +1,23 @@
+package hooks
+
+import (
+	"github.com/spf13/cobra"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	hooksInstallCmd "gitlab.com/gitlab-org/cli/commands/hooks/install"
+	hooksRunCmd "gitlab.com/gitlab-org/cli/commands/hooks/run"
+	hooksUninstallCmd "gitlab.com/gitlab-org/cli/commands/hooks/uninstall"
+)
+
+func NewCmdHooks(f *cmdutils.Factory) *cobra.Command {
+	hooksCmd := &cobra.Command{
+		Use:   "hooks <command> [flags]",
+		Short: `Manage Git hooks that check your changes before they reach GitLab.`,
+		Long:  ``,
+	}
+
+	hooksCmd.AddCommand(hooksInstallCmd.NewCmdInstall(f))
+	hooksCmd.AddCommand(hooksUninstallCmd.NewCmdUninstall(f))
+	hooksCmd.AddCommand(hooksRunCmd.NewCmdRun(f))
+
+	return hooksCmd
+}
@@ -0,0 +1,170 @@
+package hookutils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+const (
+	PrePush   = "pre-push"
+	CommitMsg = "commit-msg"
+)
+
+// Hooks are the Git hooks managed by glab, in the order they are installed.
+var Hooks = []string{PrePush, CommitMsg}
+
+// Configuration keys read by the hooks. They can be set per repository with
+// `glab config set <key> <value>`, which writes to the local configuration.
+const (
+	// CILintKey enables linting `.gitlab-ci.yml` before it is pushed. Defaults to true.
+	CILintKey = "hooks_ci_lint"
+	// MRBehindKey enables checking that a branch with an open merge request is
+	// not behind its target branch before it is pushed. Defaults to true.
+	MRBehindKey = "hooks_mr_behind"
+	// TrailerKey is the commit trailer used by `glab changelog generate`. Defaults to 'Changelog'.
+	TrailerKey = "hooks_changelog_trailer"
+	// RequireTrailerKey requires every commit to have the changelog trailer. Defaults to false.
+	RequireTrailerKey = "hooks_require_changelog_trailer"
+)
+
+// marker identifies hook scripts written by glab.
+const marker = "# Installed by glab."
+
+// chainedSuffix is appended to the name of a hook that existed before glab was
+// installed. The glab hook runs it first.
+const chainedSuffix = ".glab-chained"
+
+// IsValid reports whether hook is managed by glab.
+func IsValid(hook string) bool {
+	for _, h := range Hooks {
+		if h == hook {
+			return true
+		}
+	}
+	return false
+}
+
+// Dir returns the hooks directory of the current repository. It respects `core.hooksPath`.
+var Dir = func() (string, error) {
+	output, err := run.PrepareCmd(git.GitCommand("rev-parse", "--git-path", "hooks")).Output()
+	if err != nil {
+		return "", fmt.Errorf("could not find the Git hooks directory: %w", err)
+	}
+
+	dir := strings.TrimSpace(string(output))
+	return filepath.Abs(dir)
+}
+
+// Script returns the content of the glab script for hook. The script runs the
+// hook that existed before glab was installed, if any, and then `glab hooks run`.
+func Script(hook string) string {
+	var b strings.Builder
+
+	b.WriteString("#!/bin/sh\n")
+	b.WriteString(marker + " Run 'glab hooks uninstall' to remove.\n\n")
+	b.WriteString(fmt.Sprintf("chained=\"$(dirname \"$0\")/%s%s\"\n\n", hook, chainedSuffix))
+
+	// pre-push reads the pushed refs from stdin, which both hooks need.
+	if hook == PrePush {
+		b.WriteString("input=$(cat)\n\n")
+		b.WriteString("if [ -x \"$chained\" ]; then\n")
+		b.WriteString("\tprintf '%s\\n' \"$input\" | \"$chained\" \"$@\" || exit $?\n")
+		b.WriteString("fi\n\n")
+	} else {
+		b.WriteString("if [ -x \"$chained\" ]; then\n")
+		b.WriteString("\t\"$chained\" \"$@\" || exit $?\n")
+		b.WriteString("fi\n\n")
+	}
+
+	b.WriteString("if ! command -v glab >/dev/null 2>&1; then\n")
+	b.WriteString("\techo \"glab: command not found, skipping the " + hook + " checks.\" >&2\n")
+	b.WriteString("\texit 0\n")
+	b.WriteString("fi\n\n")
+
+	if hook == PrePush {
+		b.WriteString("printf '%s\\n' \"$input\" | exec glab hooks run " + hook + " \"$@\"\n")
+	} else {
+		b.WriteString("exec glab hooks run " + hook + " \"$@\"\n")
+	}
+
+	return b.String()
+}
+
+// IsInstalled reports whether the glab script for hook is installed in dir.
+func IsInstalled(dir, hook string) (bool, error) {
+	content, err := os.ReadFile(filepath.Join(dir, hook))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return strings.Contains(string(content), marker), nil
+}
+
+// Install writes the glab script for hook to dir. An existing hook that was not
+// written by glab is kept, and chained: the glab script runs it first.
+// It returns true if an existing hook was chained.
+func Install(dir, hook string) (bool, error) {
+	path := filepath.Join(dir, hook)
+
+	installed, err := IsInstalled(dir, hook)
+	if err != nil {
+		return false, err
+	}
+
+	chained := false
+	if _, err := os.Stat(path); err == nil && !installed {
+		chainedPath := path + chainedSuffix
+		if _, err := os.Stat(chainedPath); err == nil {
+			return false, fmt.Errorf("could not chain the existing %s hook: %s already exists.", hook, chainedPath)
+		}
+
+		if err := os.Rename(path, chainedPath); err != nil {
+			return false, fmt.Errorf("could not chain the existing %s hook: %w", hook, err)
+		}
+		chained = true
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return false, err
+	}
+
+	if err := os.WriteFile(path, []byte(Script(hook)), 0o755); err != nil {
+		return false, fmt.Errorf("could not write the %s hook: %w", hook, err)
+	}
+
+	return chained, nil
+}
+
+// Uninstall removes the glab script for hook from dir, and restores the hook
+// that existed before glab was installed. Hooks not written by glab are left alone.
+// It returns true if a glab script was removed.
+func Uninstall(dir, hook string) (bool, error) {
+	path := filepath.Join(dir, hook)
+
+	installed, err := IsInstalled(dir, hook)
+	if err != nil || !installed {
+		return false, err
+	}
+
+	if err := os.Remove(path); err != nil {
+		return false, fmt.Errorf("could not remove the %s hook: %w", hook, err)
+	}
+
+	chainedPath := path + chainedSuffix
+	if _, err := os.Stat(chainedPath); err == nil {
+		if err := os.Rename(chainedPath, path); err != nil {
+			return true, fmt.Errorf("could not restore the existing %s hook: %w", hook, err)
+		}
+	}
+
+	return true, nil
+}
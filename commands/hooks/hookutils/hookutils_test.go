@@ -0,0 +1,80 @@
+package hookutils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Script(t *testing.T) {
+	prePush := Script(PrePush)
+	assert.Contains(t, prePush, marker)
+	assert.Contains(t, prePush, `chained="$(dirname "$0")/pre-push.glab-chained"`)
+	assert.Contains(t, prePush, "input=$(cat)")
+	assert.Contains(t, prePush, `printf '%s\n' "$input" | exec glab hooks run pre-push "$@"`)
+
+	commitMsg := Script(CommitMsg)
+	assert.NotContains(t, commitMsg, "input=$(cat)")
+	assert.Contains(t, commitMsg, `exec glab hooks run commit-msg "$@"`)
+}
+
+func Test_InstallAndUninstall(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "hooks")
+
+	chained, err := Install(dir, PrePush)
+	require.NoError(t, err)
+	assert.False(t, chained)
+
+	installed, err := IsInstalled(dir, PrePush)
+	require.NoError(t, err)
+	assert.True(t, installed)
+
+	// installing again replaces the glab hook, without chaining it.
+	chained, err = Install(dir, PrePush)
+	require.NoError(t, err)
+	assert.False(t, chained)
+	assert.NoFileExists(t, filepath.Join(dir, PrePush+chainedSuffix))
+
+	removed, err := Uninstall(dir, PrePush)
+	require.NoError(t, err)
+	assert.True(t, removed)
+	assert.NoFileExists(t, filepath.Join(dir, PrePush))
+
+	removed, err = Uninstall(dir, PrePush)
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func Test_InstallChainsExistingHook(t *testing.T) {
+	dir := t.TempDir()
+	existing := "#!/bin/sh\necho existing\n"
+
+	path := filepath.Join(dir, CommitMsg)
+	require.NoError(t, os.WriteFile(path, []byte(existing), 0o755))
+
+	chained, err := Install(dir, CommitMsg)
+	require.NoError(t, err)
+	assert.True(t, chained)
+
+	content, err := os.ReadFile(path + chainedSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, existing, string(content))
+
+	removed, err := Uninstall(dir, CommitMsg)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, existing, string(content))
+	assert.NoFileExists(t, path+chainedSuffix)
+
+	// hooks not installed by glab are left alone.
+	removed, err = Uninstall(dir, CommitMsg)
+	require.NoError(t, err)
+	assert.False(t, removed)
+	assert.FileExists(t, path)
+}
@@ -0,0 +1,90 @@
+package install
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/hooks/hookutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+type InstallOpts struct {
+	Hooks []string
+
+	IO *iostreams.IOStreams
+}
+
+func NewCmdInstall(f *cmdutils.Factory) *cobra.Command {
+	opts := &InstallOpts{
+		IO: f.IO,
+	}
+
+	hooksInstallCmd := &cobra.Command{
+		Use:   "install [flags]",
+		Short: `Install Git hooks that check your changes before they are committed and pushed.`,
+		Long: heredoc.Docf(`
+			Install Git hooks in the current repository that run glab checks:
+
+			- %[1]spre-push%[1]s: If %[1]s.gitlab-ci.yml%[1]s changed in the pushed commits, validates it
+			  like %[1]sglab ci lint%[1]s. If a pushed branch has an open merge request, checks
+			  that the branch is not behind the target branch of the merge request.
+			- %[1]scommit-msg%[1]s: Checks that the changelog trailer used by %[1]sglab changelog generate%[1]s
+			  has a valid category, and optionally that every commit has one.
+
+			Existing hooks are kept, and run before the glab checks. The hooks run
+			%[1]sglab%[1]s from your PATH. To skip them for one command, use %[1]s--no-verify%[1]s.
+
+			Configure the checks for the repository with %[1]sglab config set%[1]s:
+
+			- %[1]s%[2]s%[1]s: Set to false to skip linting the CI/CD configuration. Defaults to true.
+			- %[1]s%[3]s%[1]s: Set to false to skip checking merge requests. Defaults to true.
+			- %[1]s%[4]s%[1]s: The changelog trailer. Defaults to 'Changelog'.
+			- %[1]s%[5]s%[1]s: Set to true to require the trailer in every commit. Defaults to false.
+		`, "`", hookutils.CILintKey, hookutils.MRBehindKey, hookutils.TrailerKey, hookutils.RequireTrailerKey),
+		Example: heredoc.Doc(`
+			glab hooks install
+			glab hooks install --hook pre-push
+			glab config set hooks_require_changelog_trailer true
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, hook := range opts.Hooks {
+				if !hookutils.IsValid(hook) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid hook %q. Must be one of: %v.", hook, hookutils.Hooks)}
+				}
+			}
+
+			return installRun(opts)
+		},
+	}
+
+	hooksInstallCmd.Flags().StringSliceVar(&opts.Hooks, "hook", hookutils.Hooks, "Hooks to install. Options: pre-push, commit-msg.")
+
+	return hooksInstallCmd
+}
+
+func installRun(opts *InstallOpts) error {
+	c := opts.IO.Color()
+
+	dir, err := hookutils.Dir()
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range opts.Hooks {
+		chained, err := hookutils.Install(dir, hook)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.StdOut, "%s Installed the %s hook.\n", c.GreenCheck(), hook)
+		if chained {
+			fmt.Fprintf(opts.IO.StdOut, "  The existing %s hook runs before the glab checks.\n", hook)
+		}
+	}
+
+	return nil
+}
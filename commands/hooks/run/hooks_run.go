@@ -0,0 +1,395 @@
+package run
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/hooks/hookutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const (
+	ciConfigFile        = ".gitlab-ci.yml"
+	changelogConfigFile = ".gitlab/changelog_config.yml"
+	defaultTrailer      = "Changelog"
+	zeroSHA             = "0000000000000000000000000000000000000000"
+)
+
+// defaultCategories are the changelog categories GitLab knows without a changelog configuration.
+// https://docs.gitlab.com/ee/user/project/changelogs.html
+var defaultCategories = []string{"added", "fixed", "changed", "deprecated", "removed", "security", "performance", "other"}
+
+// ignoredPrefixes start the messages of commits that don't need a changelog trailer.
+var ignoredPrefixes = []string{"fixup! ", "squash! ", "amend! ", "Merge "}
+
+type RunOpts struct {
+	Hook string
+	Args []string
+
+	IO         *iostreams.IOStreams
+	Config     func() (config.Config, error)
+	HttpClient func() (*gitlab.Client, error)
+	BaseRepo   func() (glrepo.Interface, error)
+	Git        git.GitRunner
+}
+
+// pushedRef is a line of the standard input of the pre-push hook.
+type pushedRef struct {
+	LocalRef  string
+	LocalSHA  string
+	RemoteRef string
+	RemoteSHA string
+}
+
+func NewCmdRun(f *cmdutils.Factory) *cobra.Command {
+	opts := &RunOpts{
+		IO:         f.IO,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		BaseRepo:   f.BaseRepo,
+		Git:        git.StandardGitCommand{},
+	}
+
+	hooksRunCmd := &cobra.Command{
+		Use:   "run <hook> [<args>...]",
+		Short: `Run the glab checks of a Git hook.`,
+		Long: heredoc.Doc(`
+			Run the glab checks of a Git hook. The hooks installed by 'glab hooks install'
+			call this command with the arguments and standard input Git passes to them.
+		`),
+		Example: heredoc.Doc(`
+			glab hooks run commit-msg .git/COMMIT_EDITMSG
+			echo "refs/heads/main $(git rev-parse main) refs/heads/main $(git rev-parse origin/main)" | glab hooks run pre-push origin
+		`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Hook = args[0]
+			opts.Args = args[1:]
+
+			switch opts.Hook {
+			case hookutils.PrePush:
+				if len(opts.Args) < 1 {
+					return &cmdutils.FlagError{Err: errors.New("the pre-push hook requires the name of the remote.")}
+				}
+				return prePushRun(opts)
+			case hookutils.CommitMsg:
+				if len(opts.Args) < 1 {
+					return &cmdutils.FlagError{Err: errors.New("the commit-msg hook requires the path of the commit message file.")}
+				}
+				return commitMsgRun(opts)
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid hook %q. Must be one of: %v.", opts.Hook, hookutils.Hooks)}
+			}
+		},
+	}
+
+	return hooksRunCmd
+}
+
+// configValue returns the value of key, or def if it is not set.
+func configValue(opts *RunOpts, key, def string) string {
+	cfg, err := opts.Config()
+	if err != nil {
+		return def
+	}
+
+	value, _ := cfg.Get("", key)
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+func enabled(opts *RunOpts, key string, def bool) bool {
+	value, err := strconv.ParseBool(configValue(opts, key, strconv.FormatBool(def)))
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+func parsePushedRefs(r io.Reader) ([]pushedRef, error) {
+	var refs []pushedRef
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid pre-push input: %q", scanner.Text())
+		}
+
+		// deleting a remote branch pushes no commits.
+		if fields[1] == zeroSHA {
+			continue
+		}
+
+		refs = append(refs, pushedRef{
+			LocalRef:  fields[0],
+			LocalSHA:  fields[1],
+			RemoteRef: fields[2],
+			RemoteSHA: fields[3],
+		})
+	}
+
+	return refs, scanner.Err()
+}
+
+func prePushRun(opts *RunOpts) error {
+	remote := opts.Args[0]
+
+	refs, err := parsePushedRefs(opts.IO.In)
+	if err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	lint := enabled(opts, hookutils.CILintKey, true)
+	behind := enabled(opts, hookutils.MRBehindKey, true)
+	if !lint && !behind {
+		return nil
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, ref := range refs {
+		if lint {
+			ok, err := lintPushedCIConfig(opts, client, repo, remote, ref)
+			if err != nil {
+				return err
+			}
+			failed = failed || !ok
+		}
+
+		if behind {
+			ok, err := checkMRNotBehind(opts, client, repo, remote, ref)
+			if err != nil {
+				return err
+			}
+			failed = failed || !ok
+		}
+	}
+
+	if failed {
+		fmt.Fprintln(opts.IO.StdErr, "To push anyway, run 'git push --no-verify'.")
+		return cmdutils.SilentError
+	}
+
+	return nil
+}
+
+// changedFiles returns the files changed by the commits of ref that are not on the remote yet.
+func changedFiles(opts *RunOpts, remote string, ref pushedRef) ([]string, error) {
+	args := []string{"log", "--format=", "--name-only"}
+	if ref.RemoteSHA == zeroSHA {
+		args = append(args, ref.LocalSHA, "--not", "--remotes="+remote)
+	} else {
+		args = append(args, ref.RemoteSHA+".."+ref.LocalSHA)
+	}
+
+	output, err := opts.Git.Git(args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not list the pushed changes of %s: %w", ref.LocalRef, err)
+	}
+
+	return strings.Fields(output), nil
+}
+
+func lintPushedCIConfig(opts *RunOpts, client *gitlab.Client, repo glrepo.Interface, remote string, ref pushedRef) (bool, error) {
+	c := opts.IO.Color()
+
+	files, err := changedFiles(opts, remote, ref)
+	if err != nil {
+		return false, err
+	}
+	if !slices.Contains(files, ciConfigFile) {
+		return true, nil
+	}
+
+	content, err := opts.Git.Git("show", ref.LocalSHA+":"+ciConfigFile)
+	if err != nil {
+		// the file was deleted.
+		return true, nil
+	}
+
+	project, err := repo.Project(client)
+	if err != nil {
+		return false, err
+	}
+
+	fmt.Fprintf(opts.IO.StdErr, "Validating %s of %s...\n", ciConfigFile, ref.LocalRef)
+
+	result, err := api.ProjectNamespaceLint(client, project.ID, content, "", false, false)
+	if err != nil {
+		return false, err
+	}
+
+	if !result.Valid {
+		fmt.Fprintln(opts.IO.StdErr, c.Red(ciConfigFile+" is invalid."))
+		for i, err := range result.Errors {
+			fmt.Fprintln(opts.IO.StdErr, i+1, err)
+		}
+		return false, nil
+	}
+
+	fmt.Fprintln(opts.IO.StdErr, c.GreenCheck(), "CI/CD YAML is valid!")
+	return true, nil
+}
+
+func checkMRNotBehind(opts *RunOpts, client *gitlab.Client, repo glrepo.Interface, remote string, ref pushedRef) (bool, error) {
+	c := opts.IO.Color()
+
+	branch, ok := strings.CutPrefix(ref.RemoteRef, "refs/heads/")
+	if !ok {
+		return true, nil
+	}
+
+	mrs, err := api.ListMRs(client, repo.FullName(), &gitlab.ListProjectMergeRequestsOptions{
+		SourceBranch: gitlab.Ptr(branch),
+		State:        gitlab.Ptr("opened"),
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(mrs) == 0 {
+		return true, nil
+	}
+	mr := mrs[0]
+
+	if _, err := opts.Git.Git("fetch", "--quiet", remote, mr.TargetBranch); err != nil {
+		fmt.Fprintf(opts.IO.StdErr, "%s Could not fetch %s to check if %s is behind it. Skipping.\n", c.WarnIcon(), mr.TargetBranch, branch)
+		return true, nil
+	}
+
+	output, err := opts.Git.Git("rev-list", "--count", ref.LocalSHA+"..FETCH_HEAD")
+	if err != nil {
+		return false, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return false, err
+	}
+
+	if count > 0 {
+		fmt.Fprintf(opts.IO.StdErr, "%s %s is %s behind %s, the target branch of !%d. Rebase it before pushing.\n",
+			c.FailedIcon(), branch, utils.Pluralize(count, "commit"), mr.TargetBranch, mr.IID)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func commitMsgRun(opts *RunOpts) error {
+	c := opts.IO.Color()
+	path := opts.Args[0]
+
+	message, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range ignoredPrefixes {
+		if strings.HasPrefix(string(message), prefix) {
+			return nil
+		}
+	}
+
+	trailer := configValue(opts, hookutils.TrailerKey, defaultTrailer)
+	required := enabled(opts, hookutils.RequireTrailerKey, false)
+
+	output, err := opts.Git.Git("interpret-trailers", "--parse", path)
+	if err != nil {
+		return fmt.Errorf("could not parse the trailers of the commit message: %w", err)
+	}
+
+	var values []string
+	for _, line := range strings.Split(output, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if ok && strings.EqualFold(strings.TrimSpace(key), trailer) {
+			values = append(values, strings.TrimSpace(value))
+		}
+	}
+
+	if len(values) == 0 {
+		if required {
+			fmt.Fprintf(opts.IO.StdErr, "%s The commit message has no %q trailer. Add one, like '%s: added'.\n", c.FailedIcon(), trailer, trailer)
+			return cmdutils.SilentError
+		}
+		return nil
+	}
+
+	categories := changelogCategories()
+	for _, value := range values {
+		if !slices.Contains(categories, strings.ToLower(value)) {
+			fmt.Fprintf(opts.IO.StdErr, "%s Invalid %q trailer: %q. Must be one of: %s.\n", c.FailedIcon(), trailer, value, strings.Join(categories, ", "))
+			return cmdutils.SilentError
+		}
+	}
+
+	return nil
+}
+
+// changelogCategories returns the default categories, and the categories of the
+// changelog configuration of the repository, if any.
+func changelogCategories() []string {
+	categories := slices.Clone(defaultCategories)
+
+	dir, err := git.ToplevelDir()
+	if err != nil {
+		return categories
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, changelogConfigFile))
+	if err != nil {
+		return categories
+	}
+
+	var cfg struct {
+		Categories map[string]string `yaml:"categories"`
+	}
+	if err := yaml.Unmarshal(content, &cfg); err != nil {
+		return categories
+	}
+
+	for category := range cfg.Categories {
+		category = strings.ToLower(category)
+		if !slices.Contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+	slices.Sort(categories[len(defaultCategories):])
+
+	return categories
+}
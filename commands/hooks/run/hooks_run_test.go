@@ -0,0 +1,211 @@
+package run
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cfg string, stdin string, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, in, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	in.WriteString(stdin)
+
+	factory := cmdtest.InitFactory(ios, rt)
+	factory.Config = func() (config.Config, error) {
+		return config.NewFromString(cfg), nil
+	}
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdRun(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func gitOutput(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := git.StandardGitCommand{}.Git(args...)
+	require.NoError(t, err)
+
+	return strings.TrimSpace(output)
+}
+
+func writeCommitMessage(t *testing.T, message string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	require.NoError(t, os.WriteFile(path, []byte(message), 0o644))
+
+	return path
+}
+
+func Test_commitMsg(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+
+	tests := []struct {
+		name    string
+		config  string
+		message string
+		wantErr string
+	}{
+		{
+			name:    "no trailer",
+			message: "Add a feature\n",
+		},
+		{
+			name:    "valid trailer",
+			message: "Add a feature\n\nChangelog: added\n",
+		},
+		{
+			name:    "invalid trailer",
+			message: "Add a feature\n\nChangelog: new\n",
+			wantErr: `Invalid "Changelog" trailer: "new"`,
+		},
+		{
+			name:    "required trailer missing",
+			config:  "hooks_require_changelog_trailer: true\n",
+			message: "Add a feature\n",
+			wantErr: `The commit message has no "Changelog" trailer`,
+		},
+		{
+			name:    "required trailer missing in a fixup commit",
+			config:  "hooks_require_changelog_trailer: true\n",
+			message: "fixup! Add a feature\n",
+		},
+		{
+			name:    "custom trailer",
+			config:  "hooks_changelog_trailer: Type\n",
+			message: "Fix a bug\n\nType: Fixed\nChangelog: invalid\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeCommitMessage(t, tc.message)
+
+			output, err := runCommand(t, nil, tc.config, "", "commit-msg "+path)
+			if tc.wantErr != "" {
+				require.ErrorIs(t, err, cmdutils.SilentError)
+				assert.Contains(t, output.Stderr(), tc.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Empty(t, output.Stderr())
+		})
+	}
+}
+
+func Test_commitMsgChangelogConfig(t *testing.T) {
+	dir := git.InitGitRepoWithCommit(t)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".gitlab"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, changelogConfigFile), []byte("categories:\n  feature: Features\n"), 0o644))
+
+	path := writeCommitMessage(t, "Add a feature\n\nChangelog: feature\n")
+
+	_, err := runCommand(t, nil, "", "", "commit-msg "+path)
+	require.NoError(t, err)
+}
+
+func Test_prePush(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+
+	base := gitOutput(t, "rev-parse", "HEAD")
+
+	require.NoError(t, os.WriteFile(ciConfigFile, []byte("test:\n  script: echo\n"), 0o644))
+	gitOutput(t, "add", ciConfigFile)
+	gitOutput(t, "commit", "-m", "Add CI")
+
+	head := gitOutput(t, "rev-parse", "HEAD")
+	stdin := fmt.Sprintf("refs/heads/main %s refs/heads/main %s\n", head, base)
+
+	t.Run("invalid CI config", func(t *testing.T) {
+		fakeHTTP := httpmock.New()
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO",
+			httpmock.NewStringResponse(http.StatusOK, `{"id": 123}`))
+		fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/123/ci/lint",
+			httpmock.NewStringResponse(http.StatusOK, `{"valid": false, "errors": ["jobs config should contain at least one visible job"]}`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+		output, err := runCommand(t, fakeHTTP, "", stdin, "pre-push origin")
+		require.ErrorIs(t, err, cmdutils.SilentError)
+		assert.Contains(t, output.Stderr(), ".gitlab-ci.yml is invalid.")
+		assert.Contains(t, output.Stderr(), "1 jobs config should contain at least one visible job")
+		assert.Contains(t, output.Stderr(), "git push --no-verify")
+	})
+
+	t.Run("unchanged CI config", func(t *testing.T) {
+		fakeHTTP := httpmock.New()
+		defer fakeHTTP.Verify(t)
+
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+		_, err := runCommand(t, fakeHTTP, "", fmt.Sprintf("refs/heads/main %s refs/heads/main %s\n", head, head), "pre-push origin")
+		require.NoError(t, err)
+	})
+
+	t.Run("checks disabled", func(t *testing.T) {
+		fakeHTTP := httpmock.New()
+		defer fakeHTTP.Verify(t)
+
+		_, err := runCommand(t, fakeHTTP, "hooks_ci_lint: false\nhooks_mr_behind: false\n", stdin, "pre-push origin")
+		require.NoError(t, err)
+	})
+
+	t.Run("deleted branch", func(t *testing.T) {
+		fakeHTTP := httpmock.New()
+		defer fakeHTTP.Verify(t)
+
+		_, err := runCommand(t, fakeHTTP, "", fmt.Sprintf("(delete) %s refs/heads/main %s\n", zeroSHA, head), "pre-push origin")
+		require.NoError(t, err)
+	})
+}
+
+func Test_prePushMRBehind(t *testing.T) {
+	dir := git.InitGitRepoWithCommit(t)
+
+	gitOutput(t, "branch", "-M", "main")
+	gitOutput(t, "checkout", "-b", "feature")
+	gitOutput(t, "commit", "--allow-empty", "-m", "Feature")
+	feature := gitOutput(t, "rev-parse", "HEAD")
+
+	gitOutput(t, "checkout", "main")
+	gitOutput(t, "commit", "--allow-empty", "-m", "Main")
+
+	// the repository is its own remote, so fetching the target branch works offline.
+	gitOutput(t, "remote", "add", "origin", dir)
+
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[{"iid": 12, "source_branch": "feature", "target_branch": "main"}]`))
+
+	stdin := fmt.Sprintf("refs/heads/feature %s refs/heads/feature %s\n", feature, zeroSHA)
+
+	output, err := runCommand(t, fakeHTTP, "hooks_ci_lint: false\n", stdin, "pre-push origin")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, output.Stderr(), "feature is 1 commit behind main, the target branch of !12.")
+}
@@ -0,0 +1,54 @@
+package uninstall
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/hooks/hookutils"
+)
+
+func NewCmdUninstall(f *cmdutils.Factory) *cobra.Command {
+	hooksUninstallCmd := &cobra.Command{
+		Use:   "uninstall",
+		Short: `Remove the Git hooks installed by glab.`,
+		Long: heredoc.Doc(`
+			Remove the Git hooks installed by 'glab hooks install' from the current
+			repository. Hooks that existed before glab was installed are restored.
+		`),
+		Example: heredoc.Doc(`
+			glab hooks uninstall
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO.Color()
+
+			dir, err := hookutils.Dir()
+			if err != nil {
+				return err
+			}
+
+			removed := false
+			for _, hook := range hookutils.Hooks {
+				ok, err := hookutils.Uninstall(dir, hook)
+				if err != nil {
+					return err
+				}
+				if ok {
+					removed = true
+					fmt.Fprintf(f.IO.StdOut, "%s Removed the %s hook.\n", c.GreenCheck(), hook)
+				}
+			}
+
+			if !removed {
+				fmt.Fprintln(f.IO.StdOut, "No glab hooks are installed.")
+			}
+
+			return nil
+		},
+	}
+
+	return hooksUninstallCmd
+}
@@ -12,6 +12,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/filter/filterutils"
 	"gitlab.com/gitlab-org/cli/commands/flag"
 	"gitlab.com/gitlab-org/cli/commands/issuable"
 	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
@@ -79,6 +80,8 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error, issueTy
 			glab %[1]s ls --all
 			glab %[1]s list --assignee=@me
 			glab %[1]s list --milestone release-2.0.0 --opened
+			glab %[1]s list --label bug --milestone current-milestone --save-filter triage
+			glab %[1]s list --filter triage
 		`, issueType)),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,6 +89,11 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error, issueTy
 			opts.BaseRepo = f.BaseRepo
 			opts.HTTPClient = f.HttpClient
 
+			err := filterutils.Process(f, cmd, string(issueType))
+			if err != nil {
+				return err
+			}
+
 			if len(opts.Labels) != 0 && len(opts.NotLabels) != 0 {
 				return cmdutils.FlagError{
 					Err: errors.New("flags --label and --not-label are mutually exclusive."),
@@ -146,6 +154,7 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error, issueTy
 	issueListCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
 	issueListCmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Ignored if a repo argument is set.")
 	issueListCmd.MarkFlagsMutuallyExclusive("output", "output-format")
+	filterutils.AddFlags(issueListCmd)
 
 	if issueType == issuable.TypeIssue {
 		issueListCmd.Flags().StringVarP(&opts.IssueType, "issue-type", "t", "", "Filter issue by its type. Options: issue, incident, test_case.")
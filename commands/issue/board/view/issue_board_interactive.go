@@ -0,0 +1,506 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const boardHelp = "[gray]←/→[-] list  [gray]</>[-] move  [gray]enter/o[-] browser  [gray]a[-] assign me  [gray]c[-] close  [gray]/[-] filter  [gray]r[-] refresh  [gray]q[-] quit"
+
+// boardView is the interactive view of an issue board. Each board list is a column,
+// and the selected issue of the focused column can be moved to another list.
+type boardView struct {
+	factory *cmdutils.Factory
+	board   boardMeta
+	lists   []*gitlab.BoardList
+	opts    *issueBoardViewOptions
+	user    *gitlab.User
+
+	app     *tview.Application
+	pages   *tview.Pages
+	columns []*tview.List
+	status  *tview.TextView
+	filter  *tview.InputField
+
+	issues  []*gitlab.Issue
+	shown   [][]*gitlab.Issue
+	focused int
+}
+
+func newBoardView(f *cmdutils.Factory, board boardMeta, lists []*gitlab.BoardList, opts *issueBoardViewOptions, user *gitlab.User) *boardView {
+	v := &boardView{
+		factory: f,
+		board:   board,
+		opts:    opts,
+		user:    user,
+		app:     tview.NewApplication(),
+		pages:   tview.NewPages(),
+	}
+
+	// only label, assignee, and milestone lists can be displayed and moved to
+	for _, l := range lists {
+		if l.Label != nil || l.Assignee != nil || l.Milestone != nil {
+			v.lists = append(v.lists, l)
+		}
+	}
+
+	return v
+}
+
+func (v *boardView) run(title string) error {
+	defer recoverPanic(v.app)
+
+	columns := tview.NewFlex()
+	columns.SetBackgroundColor(tcell.ColorDefault)
+	for i, l := range v.lists {
+		column := tview.NewList().
+			ShowSecondaryText(true).
+			SetSelectedFocusOnly(true).
+			SetHighlightFullLine(true)
+		column.
+			SetBackgroundColor(tcell.ColorDefault).
+			SetBorder(true).
+			SetTitle(" " + tview.Escape(listTitle(l)) + " ")
+		if l.Label != nil {
+			column.SetTitleColor(tcell.GetColor(l.Label.Color))
+		}
+		column.SetInputCapture(v.handleKey)
+		column.SetFocusFunc(func() { v.focused = i })
+
+		v.columns = append(v.columns, column)
+		columns.AddItem(column, 0, 1, i == 0)
+	}
+
+	v.status = tview.NewTextView().SetDynamicColors(true)
+	v.status.SetBackgroundColor(tcell.ColorDefault)
+
+	v.filter = tview.NewInputField().
+		SetLabel("Filter: ").
+		SetFieldBackgroundColor(tcell.ColorDefault).
+		SetChangedFunc(func(string) { v.render() }).
+		SetDoneFunc(func(tcell.Key) { v.app.SetFocus(v.columns[v.focused]) })
+	v.filter.SetBackgroundColor(tcell.ColorDefault)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(columns, 0, 1, true).
+		AddItem(v.filter, 1, 0, false).
+		AddItem(v.status, 1, 0, false)
+	layout.
+		SetBackgroundColor(tcell.ColorDefault).
+		SetBorderPadding(1, 0, 2, 2).
+		SetBorder(true).
+		SetTitle(title)
+	v.pages.AddPage("board", layout, true, true)
+
+	if len(v.columns) == 0 {
+		return fmt.Errorf("the board has no lists to display.")
+	}
+
+	v.refresh()
+
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	return v.app.SetScreen(screen).SetRoot(v.pages, true).SetFocus(v.columns[0]).Run()
+}
+
+func (v *boardView) setStatus(message string) {
+	v.status.SetText(message + "  " + boardHelp)
+}
+
+// refresh fetches the issues of the board in the background, then renders them.
+func (v *boardView) refresh() {
+	v.setStatus("[yellow]Refreshing…[-]")
+
+	go func() {
+		defer recoverPanic(v.app)
+
+		issues, err := v.fetchIssues()
+		v.app.QueueUpdateDraw(func() {
+			if err != nil {
+				v.setStatus(fmt.Sprintf("[red]Failed to refresh: %s[-]", tview.Escape(err.Error())))
+				return
+			}
+			v.issues = issues
+			v.render()
+			v.setStatus(fmt.Sprintf("[gray]%s.[-]", utils.Pluralize(len(issues), "issue")))
+		})
+	}()
+}
+
+// fetchIssues returns the open and closed issues of the board, which match the command filters.
+func (v *boardView) fetchIssues() ([]*gitlab.Issue, error) {
+	var issues []*gitlab.Issue
+	for _, state := range []string{opened, closed} {
+		opts := *v.opts
+		opts.state = state
+
+		var stateIssues []*gitlab.Issue
+		var err error
+		if v.board.group != nil {
+			stateIssues, err = getGroupBoardIssues(v.board.group.ID, &opts)
+		} else {
+			stateIssues, err = getProjectBoardIssues(&opts)
+		}
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, stateIssues...)
+	}
+	return issues, nil
+}
+
+// render fills the columns with the issues of their list that match the filter bar,
+// and keeps the selected issue of every column when it is still there.
+func (v *boardView) render() {
+	query := v.filter.GetText()
+
+	shown := make([][]*gitlab.Issue, len(v.lists))
+	for i, l := range v.lists {
+		var selectedIID int
+		if issue := v.issueAt(i); issue != nil {
+			selectedIID = issue.IID
+		}
+
+		column := v.columns[i]
+		column.Clear()
+		for _, issue := range v.issues {
+			if !issueInList(v.lists, issue, l, listState(l)) || !matchesFilter(issue, query) {
+				continue
+			}
+			shown[i] = append(shown[i], issue)
+			column.AddItem(issueMainText(issue), issueSecondaryText(issue), 0, nil)
+			if issue.IID == selectedIID {
+				column.SetCurrentItem(column.GetItemCount() - 1)
+			}
+		}
+	}
+	v.shown = shown
+}
+
+// issueAt returns the selected issue of column i, if any.
+func (v *boardView) issueAt(i int) *gitlab.Issue {
+	if i >= len(v.shown) {
+		return nil
+	}
+	current := v.columns[i].GetCurrentItem()
+	if current < 0 || current >= len(v.shown[i]) {
+		return nil
+	}
+	return v.shown[i][current]
+}
+
+func (v *boardView) focus(i int) {
+	if i < 0 || i >= len(v.columns) {
+		return
+	}
+	v.focused = i
+	v.app.SetFocus(v.columns[i])
+}
+
+func (v *boardView) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape:
+		v.app.Stop()
+		return nil
+	case tcell.KeyLeft:
+		v.focus(v.focused - 1)
+		return nil
+	case tcell.KeyRight:
+		v.focus(v.focused + 1)
+		return nil
+	case tcell.KeyEnter:
+		v.openIssue()
+		return nil
+	}
+
+	switch event.Rune() {
+	case 'q':
+		v.app.Stop()
+	case 'h':
+		v.focus(v.focused - 1)
+	case 'l':
+		v.focus(v.focused + 1)
+	case 'j':
+		return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+	case 'k':
+		return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+	case '<', 'H':
+		v.moveIssue(v.focused - 1)
+	case '>', 'L':
+		v.moveIssue(v.focused + 1)
+	case 'o':
+		v.openIssue()
+	case 'a':
+		v.assignMe()
+	case 'c':
+		v.closeIssue()
+	case 'r':
+		v.refresh()
+	case '/':
+		v.app.SetFocus(v.filter)
+	default:
+		return event
+	}
+	return nil
+}
+
+// moveIssue moves the selected issue of the focused column to the list of column to.
+func (v *boardView) moveIssue(to int) {
+	issue := v.issueAt(v.focused)
+	if issue == nil || to < 0 || to >= len(v.lists) {
+		return
+	}
+	from, target := v.lists[v.focused], v.lists[to]
+
+	v.update(issue, moveIssueOptions(issue, from, target),
+		fmt.Sprintf("Moved #%d to %s.", issue.IID, listTitle(target)))
+	v.focus(to)
+}
+
+func (v *boardView) assignMe() {
+	issue := v.issueAt(v.focused)
+	if issue == nil {
+		return
+	}
+
+	assigneeIDs := issueAssigneeIDs(issue)
+	if slices.Contains(assigneeIDs, v.user.ID) {
+		v.setStatus(fmt.Sprintf("[yellow]#%d is already assigned to you.[-]", issue.IID))
+		return
+	}
+
+	v.update(issue, &gitlab.UpdateIssueOptions{AssigneeIDs: gitlab.Ptr(append(assigneeIDs, v.user.ID))},
+		fmt.Sprintf("Assigned #%d to you.", issue.IID))
+}
+
+func (v *boardView) closeIssue() {
+	issue := v.issueAt(v.focused)
+	if issue == nil || issue.State == closed {
+		return
+	}
+
+	modal := tview.NewModal().
+		SetBackgroundColor(tcell.ColorDefault).
+		SetText(fmt.Sprintf("Close #%d?", issue.IID)).
+		AddButtons([]string{"✘ No", "✔ Yes"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			v.pages.RemovePage("yesno")
+			v.app.SetFocus(v.columns[v.focused])
+			if buttonLabel != "✔ Yes" {
+				return
+			}
+			v.update(issue, &gitlab.UpdateIssueOptions{StateEvent: gitlab.Ptr("close")},
+				fmt.Sprintf("Closed #%d.", issue.IID))
+		})
+	v.pages.AddPage("yesno", modal, false, true)
+	v.app.SetFocus(modal)
+}
+
+func (v *boardView) openIssue() {
+	issue := v.issueAt(v.focused)
+	if issue == nil {
+		return
+	}
+
+	browser := ""
+	if cfg, err := v.factory.Config(); err == nil {
+		browser, _ = cfg.Get(apiClient.BaseURL().Host, "browser")
+	}
+	if err := utils.OpenInBrowser(issue.WebURL, browser); err != nil {
+		v.setStatus(fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error())))
+	}
+}
+
+// update applies opts to issue, then replaces it on the board with the updated issue.
+func (v *boardView) update(issue *gitlab.Issue, opts *gitlab.UpdateIssueOptions, done string) {
+	updated, err := api.UpdateIssue(apiClient, issue.ProjectID, issue.IID, opts)
+	if err != nil {
+		v.setStatus(fmt.Sprintf("[red]Failed to update #%d: %s[-]", issue.IID, tview.Escape(err.Error())))
+		return
+	}
+
+	// the update endpoint does not return label details, which the board displays.
+	updated.LabelDetails = labelDetails(v.issues, updated.Labels)
+	for i, existing := range v.issues {
+		if existing.ID == updated.ID {
+			v.issues[i] = updated
+		}
+	}
+
+	v.render()
+	v.setStatus("[green]" + tview.Escape(done) + "[-]")
+}
+
+// labelDetails returns the details of the labels, as known from the issues of the board.
+func labelDetails(issues []*gitlab.Issue, labels []string) []*gitlab.LabelDetails {
+	known := map[string]*gitlab.LabelDetails{}
+	for _, issue := range issues {
+		for _, ld := range issue.LabelDetails {
+			known[ld.Name] = ld
+		}
+	}
+
+	var details []*gitlab.LabelDetails
+	for _, name := range labels {
+		ld, ok := known[name]
+		if !ok {
+			ld = &gitlab.LabelDetails{Name: name, Color: "gray"}
+		}
+		details = append(details, ld)
+	}
+	return details
+}
+
+// listState returns the state of the issues of the "Open" and "Closed" lists,
+// which are added to the board lists by getBoardLists, and "" for any other list.
+func listState(l *gitlab.BoardList) string {
+	if l.ID != 0 || l.Label == nil {
+		return ""
+	}
+	switch l.Label.Name {
+	case "Open":
+		return opened
+	case "Closed":
+		return closed
+	}
+	return ""
+}
+
+// listTitle returns the title of a board list.
+func listTitle(l *gitlab.BoardList) string {
+	switch {
+	case l.Label != nil:
+		return l.Label.Name
+	case l.Assignee != nil:
+		return "@" + l.Assignee.Username
+	case l.Milestone != nil:
+		return l.Milestone.Title
+	}
+	return ""
+}
+
+// matchesList returns true if issue has the label, assignee, or milestone of a board list.
+func matchesList(issue *gitlab.Issue, l *gitlab.BoardList) bool {
+	switch {
+	case l.Label != nil:
+		return slices.Contains(issue.Labels, l.Label.Name)
+	case l.Assignee != nil:
+		for _, assignee := range issue.Assignees {
+			if assignee.Username == l.Assignee.Username {
+				return true
+			}
+		}
+		return issue.Assignee != nil && issue.Assignee.Username == l.Assignee.Username
+	case l.Milestone != nil:
+		return issue.Milestone != nil && issue.Milestone.ID == l.Milestone.ID
+	}
+	return false
+}
+
+// matchesFilter returns true if the title, a label, an assignee, or the reference of
+// issue contains query, ignoring case.
+func matchesFilter(issue *gitlab.Issue, query string) bool {
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+
+	fields := []string{issue.Title, "#" + strconv.Itoa(issue.IID)}
+	fields = append(fields, issue.Labels...)
+	for _, assignee := range issue.Assignees {
+		fields = append(fields, assignee.Username)
+	}
+
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func issueAssigneeIDs(issue *gitlab.Issue) []int {
+	ids := []int{}
+	for _, assignee := range issue.Assignees {
+		ids = append(ids, assignee.ID)
+	}
+	if len(ids) == 0 && issue.Assignee != nil {
+		ids = append(ids, issue.Assignee.ID)
+	}
+	return ids
+}
+
+// moveIssueOptions returns the changes that move issue from a board list to another:
+// the label, assignee, or milestone of the source list is removed, and the one of
+// the target list is added. Moving to or from the "Closed" list closes or reopens the issue.
+func moveIssueOptions(issue *gitlab.Issue, from, to *gitlab.BoardList) *gitlab.UpdateIssueOptions {
+	opts := &gitlab.UpdateIssueOptions{}
+
+	assigneeIDs := issueAssigneeIDs(issue)
+	assigneesChanged := false
+
+	switch listState(from) {
+	case closed:
+		opts.StateEvent = gitlab.Ptr("reopen")
+	case opened:
+	default:
+		switch {
+		case from.Label != nil:
+			opts.RemoveLabels = &gitlab.LabelOptions{from.Label.Name}
+		case from.Assignee != nil:
+			assigneeIDs = slices.DeleteFunc(assigneeIDs, func(id int) bool { return id == from.Assignee.ID })
+			assigneesChanged = true
+		case from.Milestone != nil:
+			opts.MilestoneID = gitlab.Ptr(0)
+		}
+	}
+
+	switch listState(to) {
+	case closed:
+		opts.StateEvent = gitlab.Ptr("close")
+	case opened:
+	default:
+		switch {
+		case to.Label != nil:
+			opts.AddLabels = &gitlab.LabelOptions{to.Label.Name}
+		case to.Assignee != nil:
+			if !slices.Contains(assigneeIDs, to.Assignee.ID) {
+				assigneeIDs = append(assigneeIDs, to.Assignee.ID)
+			}
+			assigneesChanged = true
+		case to.Milestone != nil:
+			opts.MilestoneID = gitlab.Ptr(to.Milestone.ID)
+		}
+	}
+
+	if assigneesChanged {
+		opts.AssigneeIDs = &assigneeIDs
+	}
+
+	return opts
+}
+
+// issueMainText returns the first line of an issue in a board list, with tview color tags.
+func issueMainText(issue *gitlab.Issue) string {
+	return fmt.Sprintf("[green]#%d[-] [::b]%s", issue.IID, tview.Escape(issue.Title))
+}
+
+// issueSecondaryText returns the second line of an issue in a board list: its labels and assignee.
+func issueSecondaryText(issue *gitlab.Issue) string {
+	text := strings.TrimSpace(buildLabelString(issue.LabelDetails))
+	if issue.Assignee != nil {
+		text += " [darkgray]@" + issue.Assignee.Username
+	}
+	return strings.TrimSpace(text)
+}
@@ -0,0 +1,193 @@
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func boardList(t *testing.T, data string) *gitlab.BoardList {
+	t.Helper()
+
+	l := &gitlab.BoardList{}
+	require.NoError(t, json.Unmarshal([]byte(data), l))
+	return l
+}
+
+func Test_issueInList(t *testing.T) {
+	openList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}}
+	closedList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}}
+	labelList := boardList(t, `{"id": 1, "label": {"name": "doing"}}`)
+	assigneeList := boardList(t, `{"id": 2, "assignee": {"id": 7, "username": "jdoe"}}`)
+	milestoneList := boardList(t, `{"id": 3, "milestone": {"id": 5, "title": "v1.0"}}`)
+	lists := []*gitlab.BoardList{openList, labelList, assigneeList, milestoneList, closedList}
+
+	tests := []struct {
+		name  string
+		issue *gitlab.Issue
+		want  []*gitlab.BoardList
+	}{
+		{
+			name:  "open issue without list attributes",
+			issue: &gitlab.Issue{State: opened},
+			want:  []*gitlab.BoardList{openList},
+		},
+		{
+			name:  "labeled issue",
+			issue: &gitlab.Issue{State: opened, Labels: []string{"doing"}},
+			want:  []*gitlab.BoardList{labelList},
+		},
+		{
+			name:  "assigned issue",
+			issue: &gitlab.Issue{State: opened, Assignees: []*gitlab.IssueAssignee{{ID: 7, Username: "jdoe"}}},
+			want:  []*gitlab.BoardList{assigneeList},
+		},
+		{
+			name:  "issue in a milestone and with a label",
+			issue: &gitlab.Issue{State: opened, Labels: []string{"doing"}, Milestone: &gitlab.Milestone{ID: 5}},
+			want:  []*gitlab.BoardList{labelList, milestoneList},
+		},
+		{
+			name:  "closed issue",
+			issue: &gitlab.Issue{State: closed, Labels: []string{"doing"}},
+			want:  []*gitlab.BoardList{closedList},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []*gitlab.BoardList
+			for _, l := range lists {
+				if issueInList(lists, tc.issue, l, listState(l)) {
+					got = append(got, l)
+				}
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_listTitle(t *testing.T) {
+	assert.Equal(t, "doing", listTitle(boardList(t, `{"id": 1, "label": {"name": "doing"}}`)))
+	assert.Equal(t, "@jdoe", listTitle(boardList(t, `{"id": 2, "assignee": {"id": 7, "username": "jdoe"}}`)))
+	assert.Equal(t, "v1.0", listTitle(boardList(t, `{"id": 3, "milestone": {"id": 5, "title": "v1.0"}}`)))
+}
+
+func Test_moveIssueOptions(t *testing.T) {
+	openList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}}
+	closedList := &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}}
+	todoList := boardList(t, `{"id": 1, "label": {"name": "todo"}}`)
+	doingList := boardList(t, `{"id": 2, "label": {"name": "doing"}}`)
+	assigneeList := boardList(t, `{"id": 3, "assignee": {"id": 7, "username": "jdoe"}}`)
+	otherAssigneeList := boardList(t, `{"id": 4, "assignee": {"id": 8, "username": "alice"}}`)
+	milestoneList := boardList(t, `{"id": 5, "milestone": {"id": 9, "title": "v1.0"}}`)
+
+	issue := &gitlab.Issue{
+		Labels:    []string{"todo"},
+		Assignees: []*gitlab.IssueAssignee{{ID: 7, Username: "jdoe"}, {ID: 10, Username: "bob"}},
+	}
+
+	tests := []struct {
+		name     string
+		from, to *gitlab.BoardList
+		want     *gitlab.UpdateIssueOptions
+	}{
+		{
+			name: "from a label list to another",
+			from: todoList,
+			to:   doingList,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"todo"},
+				AddLabels:    &gitlab.LabelOptions{"doing"},
+			},
+		},
+		{
+			name: "from the open list to a label list",
+			from: openList,
+			to:   doingList,
+			want: &gitlab.UpdateIssueOptions{AddLabels: &gitlab.LabelOptions{"doing"}},
+		},
+		{
+			name: "from a label list to the open list",
+			from: todoList,
+			to:   openList,
+			want: &gitlab.UpdateIssueOptions{RemoveLabels: &gitlab.LabelOptions{"todo"}},
+		},
+		{
+			name: "from a label list to the closed list",
+			from: todoList,
+			to:   closedList,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"todo"},
+				StateEvent:   gitlab.Ptr("close"),
+			},
+		},
+		{
+			name: "from the closed list to a label list",
+			from: closedList,
+			to:   doingList,
+			want: &gitlab.UpdateIssueOptions{
+				StateEvent: gitlab.Ptr("reopen"),
+				AddLabels:  &gitlab.LabelOptions{"doing"},
+			},
+		},
+		{
+			name: "from an assignee list to another",
+			from: assigneeList,
+			to:   otherAssigneeList,
+			want: &gitlab.UpdateIssueOptions{AssigneeIDs: &[]int{10, 8}},
+		},
+		{
+			name: "from a label list to a milestone list",
+			from: todoList,
+			to:   milestoneList,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"todo"},
+				MilestoneID:  gitlab.Ptr(9),
+			},
+		},
+		{
+			name: "from a milestone list to the open list",
+			from: milestoneList,
+			to:   openList,
+			want: &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Ptr(0)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, moveIssueOptions(issue, tc.from, tc.to))
+		})
+	}
+}
+
+func Test_matchesFilter(t *testing.T) {
+	issue := &gitlab.Issue{
+		IID:       42,
+		Title:     "Fix the login page",
+		Labels:    []string{"frontend"},
+		Assignees: []*gitlab.IssueAssignee{{Username: "jdoe"}},
+	}
+
+	for _, query := range []string{"", "  ", "login", "LOGIN", "#42", "front", "jdoe"} {
+		assert.True(t, matchesFilter(issue, query), query)
+	}
+	for _, query := range []string{"signup", "#43", "backend"} {
+		assert.False(t, matchesFilter(issue, query), query)
+	}
+}
+
+func Test_labelDetails(t *testing.T) {
+	issues := []*gitlab.Issue{
+		{LabelDetails: []*gitlab.LabelDetails{{Name: "doing", Color: "#ff0000"}}},
+	}
+
+	got := labelDetails(issues, []string{"doing", "new"})
+	assert.Equal(t, []*gitlab.LabelDetails{
+		{Name: "doing", Color: "#ff0000"},
+		{Name: "new", Color: "gray"},
+	}, got)
+}
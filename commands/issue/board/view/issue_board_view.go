@@ -7,7 +7,7 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
-	"github.com/gdamore/tcell/v2"
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/rivo/tview"
 	"github.com/spf13/cobra"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -49,12 +49,32 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 	viewCmd := &cobra.Command{
 		Use:   "view [flags]",
 		Short: `View project issue board.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			View an issue board interactively. Each list of the board is a column.
+
+			Moving an issue to another list updates it according to the type of the lists:
+			the label, assignee, or milestone of the source list is replaced with the one
+			of the target list. Moving an issue to the "Closed" list closes it, and moving
+			it out of the "Closed" list reopens it.
+
+			Key bindings:
+
+			- Left/Right, h/l: select a list.
+			- Up/Down, j/k: select an issue.
+			- <, >: move the selected issue to the previous or next list.
+			- Enter, o: open the issue in the browser.
+			- a: assign the issue to yourself.
+			- c: close the issue.
+			- /: filter the issues by title, label, assignee, or number. Press Enter to return to the board.
+			- r: refresh.
+			- q, Esc: quit.
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 
-			a := tview.NewApplication()
-			defer recoverPanic(a)
+			if !f.IO.IsOutputTTY() {
+				return fmt.Errorf("the issue board requires an interactive terminal.")
+			}
 
 			apiClient, err = f.HttpClient()
 			if err != nil {
@@ -107,57 +127,9 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 				return fmt.Errorf("getting issue board lists: %w", err)
 			}
 
-			root := tview.NewFlex()
-			root.SetBackgroundColor(tcell.ColorDefault)
-			for _, l := range boardLists {
-				opts.state = ""
-				var boardIssues, listTitle, listColor string
-
-				if l.Label == nil {
-					continue
-				}
-
-				if l.Label != nil {
-					listTitle = l.Label.Name
-					listColor = l.Label.Color
-				}
-
-				// automatically request using state for default "open" and "closed" lists
-				// this is required as these lists aren't returned with the board lists api call
-				switch l.Label.Name {
-				case "Closed":
-					opts.state = closed
-				case "Open":
-					opts.state = opened
-				}
-
-				issues := []*gitlab.Issue{}
-				if selectedBoard.group != nil {
-					groupID := selectedBoard.group.ID
-					issues, err = getGroupBoardIssues(groupID, opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				if selectedBoard.group == nil {
-					issues, err = getProjectBoardIssues(opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				boardIssues = filterIssues(boardLists, issues, l, opts)
-				bx := tview.NewTextView()
-				bx.
-					SetDynamicColors(true).
-					SetText(boardIssues).
-					SetWrap(true).
-					SetBackgroundColor(tcell.ColorDefault).
-					SetBorder(true).
-					SetTitle(listTitle).
-					SetTitleColor(tcell.GetColor(listColor))
-				root.AddItem(bx, 0, 1, false)
+			user, err := api.CurrentUser(apiClient)
+			if err != nil {
+				return err
 			}
 
 			// format table title
@@ -170,18 +142,9 @@ func NewCmdView(f *cmdutils.Factory) *cobra.Command {
 				boardType = caser.String("project")
 				boardContext = project.NameWithNamespace
 			}
-			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(
-				fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext),
-			)
+			title := fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext)
 
-			screen, err := tcell.NewScreen()
-			if err != nil {
-				return err
-			}
-			if err := a.SetScreen(screen).SetRoot(root, true).Run(); err != nil {
-				return err
-			}
-			return nil
+			return newBoardView(f, selectedBoard, boardLists, opts, user).run(title)
 		},
 	}
 
@@ -436,56 +399,31 @@ func getProjectBoardIssues(opts *issueBoardViewOptions) ([]*gitlab.Issue, error)
 	return issues, nil
 }
 
-// filterIssues scans through the issues passed to it, filtering for the ones that belong in targetList
-// This function returns a string representation of the issues for targetList which will be displayed in the table view
-func filterIssues(
+// issueInList returns true if issue belongs in targetList. state is the state of the issues
+// of the "Open" and "Closed" lists, and "" for the other lists.
+func issueInList(
 	boardLists []*gitlab.BoardList,
-	issues []*gitlab.Issue,
+	issue *gitlab.Issue,
 	targetList *gitlab.BoardList,
-	opts *issueBoardViewOptions,
-) string {
-	var boardIssues string
-next:
-	for _, issue := range issues {
-		switch opts.state {
-		// skip all issues that are not in the "closed" state for the "closed" list
-		case closed:
-			if issue.State != closed {
-				continue next
-			}
-		// skip issues labeled for other board lists when populating the "open" list
-		case opened:
-			for _, boardList := range boardLists {
-				for _, issueLabel := range issue.Labels {
-					if issueLabel == boardList.Label.Name {
-						continue next
-					}
-				}
-			}
-		// filter labeled issues into board lists with corresponding labels
-		default:
-			var hasListLabel bool
-			for _, issueLabel := range issue.Labels {
-				if issueLabel == targetList.Label.Name {
-					hasListLabel = true
-					break
-				}
-			}
-			if !hasListLabel || issue.State == closed {
-				continue next
-			}
+	state string,
+) bool {
+	switch state {
+	// skip all issues that are not in the "closed" state for the "closed" list
+	case closed:
+		return issue.State == closed
+	// skip issues that belong in other board lists when populating the "open" list
+	case opened:
+		if issue.State == closed {
+			return false
 		}
-
-		var assignee, labelString string
-		if len(issue.Labels) > 0 {
-			labelString = buildLabelString(issue.LabelDetails)
-		}
-		if issue.Assignee != nil {
-			assignee = issue.Assignee.Username
+		for _, boardList := range boardLists {
+			if listState(boardList) == "" && matchesList(issue, boardList) {
+				return false
+			}
 		}
-
-		boardIssues += fmt.Sprintf("[white::b]%s\n%s[green:-:-]#%d[darkgray] - %s\n\n",
-			issue.Title, labelString, issue.IID, assignee)
+		return true
+	// filter issues into board lists with the corresponding label, assignee, or milestone
+	default:
+		return issue.State != closed && matchesList(issue, targetList)
 	}
-	return boardIssues
 }
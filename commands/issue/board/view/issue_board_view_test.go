@@ -228,91 +228,3 @@ func Test_mapBoardData(t *testing.T) {
 		})
 	}
 }
-
-func Test_filterIssues(t *testing.T) {
-	type args struct {
-		boardLists []*gitlab.BoardList
-		issues     []*gitlab.Issue
-		targetList *gitlab.BoardList
-		opts       *issueBoardViewOptions
-	}
-	tests := []struct {
-		name string
-		args args
-		want string
-	}{
-		{
-			name: "return empty string on no matches",
-			want: "",
-		},
-		{
-			name: "filter out closed issues when targetList is not the 'closed' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{{Label: &gitlab.Label{Name: "A"}}},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "closed"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
-				opts:       &issueBoardViewOptions{},
-			},
-			want: "",
-		},
-		{
-			name: "filter out issues not in the 'closed' state when populating the 'closed' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "Closed"}},
-					{Label: &gitlab.Label{Name: "A"}},
-				},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "opened"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}},
-				opts:       &issueBoardViewOptions{state: closed},
-			},
-			want: "",
-		},
-		{
-			name: "filter out issues labeled for other board lists when iterating over the 'open' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "Open"}},
-					{Label: &gitlab.Label{Name: "A"}},
-				},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "opened"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}},
-				opts:       &issueBoardViewOptions{state: opened},
-			},
-			want: "",
-		},
-		{
-			name: "return formatted string on successful filter and match",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "A"}},
-					{Label: &gitlab.Label{Name: "B"}},
-					{Label: &gitlab.Label{Name: "C"}},
-				},
-				issues: []*gitlab.Issue{
-					{
-						Assignee:     &gitlab.IssueAssignee{Username: "user"},
-						Labels:       []string{"A"},
-						LabelDetails: []*gitlab.LabelDetails{{Name: "A", Color: "green"}},
-						Title:        "Issue",
-						IID:          1,
-					},
-				},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
-				opts:       &issueBoardViewOptions{},
-			},
-			want: "[white::b]Issue\n[white:green:-]A[white:-:-]\n[green:-:-]#1[darkgray] - user\n\n",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got := filterIssues(
-				tt.args.boardLists,
-				tt.args.issues,
-				tt.args.targetList,
-				tt.args.opts,
-			)
-			assert.Equal(t, tt.want, got)
-		})
-	}
-}
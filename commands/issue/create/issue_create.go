@@ -1,6 +1,7 @@
 package create
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -28,6 +29,7 @@ import (
 type CreateOpts struct {
 	Title       string   `json:"title,omitempty"`
 	Description string   `json:"description,omitempty"`
+	Template    string   `json:"template,omitempty"`
 	Labels      []string `json:"labels,omitempty"`
 	Assignees   []string `json:"assignees,omitempty"`
 
@@ -50,9 +52,13 @@ type CreateOpts struct {
 	Yes            bool `json:"-"`
 	Web            bool `json:"-"`
 	Recover        bool `json:"-"`
+	DryRun         bool `json:"-"`
+
+	OutputFormat string `json:"-"`
 
 	IO         *iostreams.IOStreams             `json:"-"`
 	BaseRepo   func() (glrepo.Interface, error) `json:"-"`
+	Branch     func() (string, error)           `json:"-"`
 	HTTPClient func() (*gitlab.Client, error)   `json:"-"`
 	Remotes    func() (glrepo.Remotes, error)   `json:"-"`
 	Config     func() (config.Config, error)    `json:"-"`
@@ -65,11 +71,21 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 		IO:      f.IO,
 		Remotes: f.Remotes,
 		Config:  f.Config,
+		Branch:  f.Branch,
 	}
 	issueCreateCmd := &cobra.Command{
-		Use:     "create [flags]",
-		Short:   `Create an issue.`,
-		Long:    ``,
+		Use:   "create [flags]",
+		Short: `Create an issue.`,
+		Long: heredoc.Doc(`
+			Create an issue.
+
+			Use --template to fill the description from a template in '.gitlab/issue_templates'.
+			These placeholders are replaced:
+
+			- %{current_branch}: the current git branch.
+			- %{linked_issues}: the issues set with --linked-issues.
+			- %{linked_mr}: the merge request set with --linked-mr.
+		`),
 		Aliases: []string{"new"},
 		Example: heredoc.Doc(`
 			glab issue create
@@ -77,6 +93,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			glab issue create -m release-2.0.0 -t "we need this feature" --label important
 			glab issue new -t "Fix CVE-YYYY-XXXX" -l security --linked-mr 123
 			glab issue create -m release-1.0.1 -t "security fix" --label security --web --recover
+			glab issue create -t "Crash on startup" --template Bug --linked-issues 12 --dry-run
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,7 +117,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 			hasTitle := cmd.Flags().Changed("title")
-			hasDescription := cmd.Flags().Changed("description")
+			hasDescription := cmd.Flags().Changed("description") || cmd.Flags().Changed("template")
 
 			// disable interactive mode if title and description are explicitly defined
 			opts.IsInteractive = !(hasTitle && hasDescription)
@@ -158,6 +175,11 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	issueCreateCmd.Flags().BoolVar(&opts.Recover, "recover", false, "Save the options to a file if the issue fails to be created. If the file exists, the options will be loaded from the recovery file. (EXPERIMENTAL.)")
 	issueCreateCmd.Flags().IntVarP(&opts.EpicID, "epic", "", 0, "ID of the epic to add the issue to.")
 	issueCreateCmd.Flags().StringVarP(&opts.DueDate, "due-date", "", "", "A date in 'YYYY-MM-DD' format.")
+	issueCreateCmd.Flags().StringVar(&opts.Template, "template", "", "Use a template from '.gitlab/issue_templates' as the description, filling its %{variables}.")
+	issueCreateCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the issue that would be created, without creating it.")
+	issueCreateCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("dry-run", "web")
 
 	return issueCreateCmd
 }
@@ -196,6 +218,12 @@ var createRun = func(opts *CreateOpts) error {
 		}
 	}
 
+	if opts.Template != "" {
+		if err := applyTemplate(opts); err != nil {
+			return err
+		}
+	}
+
 	if opts.IsInteractive {
 		if opts.Description == "" {
 			if opts.NoEditor {
@@ -267,7 +295,7 @@ var createRun = func(opts *CreateOpts) error {
 	var action cmdutils.Action
 
 	// submit without prompting for non interactive mode
-	if !opts.IsInteractive || opts.Yes {
+	if !opts.IsInteractive || opts.Yes || opts.DryRun {
 		action = cmdutils.SubmitAction
 	}
 
@@ -379,6 +407,11 @@ var createRun = func(opts *CreateOpts) error {
 			}
 			issueCreateOpts.AssigneeIDs = cmdutils.IDsFromUsers(users)
 		}
+
+		if opts.DryRun {
+			return printDryRun(opts, issueCreateOpts)
+		}
+
 		fmt.Fprintln(opts.IO.StdErr, "- Creating issue in", repo.FullName())
 		issue, err := api.CreateIssue(apiClient, repo.FullName(), issueCreateOpts)
 		if err != nil {
@@ -388,6 +421,12 @@ var createRun = func(opts *CreateOpts) error {
 			return err
 		}
 
+		if opts.OutputFormat == "json" {
+			issueJSON, _ := json.Marshal(issue)
+			fmt.Fprintln(opts.IO.StdOut, string(issueJSON))
+			return nil
+		}
+
 		fmt.Fprintln(opts.IO.StdOut, issueutils.DisplayIssue(opts.IO.Color(), issue, opts.IO.IsaTTY))
 		return nil
 	}
@@ -395,6 +434,68 @@ var createRun = func(opts *CreateOpts) error {
 	return errors.New("expected to cancel, preview in browser, add metadata, or submit")
 }
 
+// applyTemplate renders the template selected with --template as the description.
+func applyTemplate(opts *CreateOpts) error {
+	tmpl, err := cmdutils.FindGitLabTemplate(cmdutils.IssueTemplate, opts.Template)
+	if err != nil {
+		return err
+	}
+
+	// The current branch is only known when running in a git repository.
+	branch, _ := opts.Branch()
+
+	var linkedMR string
+	if opts.LinkedMR != 0 {
+		linkedMR = fmt.Sprintf("!%d", opts.LinkedMR)
+	}
+
+	opts.Description = cmdutils.RenderGitLabTemplate(tmpl, map[string]string{
+		"current_branch": branch,
+		"linked_issues":  issueReferences(opts.LinkedIssues),
+		"linked_mr":      linkedMR,
+	})
+	return nil
+}
+
+func issueReferences(iids []int) string {
+	refs := make([]string, 0, len(iids))
+	for _, iid := range iids {
+		refs = append(refs, fmt.Sprintf("#%d", iid))
+	}
+	return strings.Join(refs, ", ")
+}
+
+// printDryRun prints the issue that would be created.
+func printDryRun(opts *CreateOpts, issueCreateOpts *gitlab.CreateIssueOptions) error {
+	out := opts.IO.StdOut
+
+	if opts.OutputFormat == "json" {
+		optsJSON, err := json.Marshal(issueCreateOpts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(optsJSON))
+		return nil
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(out, "%s %s\n", c.Bold("Title:"), opts.Title)
+	if len(opts.Labels) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Labels:"), strings.Join(opts.Labels, ", "))
+	}
+	if len(opts.Assignees) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Assignees:"), strings.Join(opts.Assignees, ", "))
+	}
+	if opts.MilestoneFlag != "" {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Milestone:"), opts.MilestoneFlag)
+	}
+	if len(opts.LinkedIssues) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Linked issues:"), issueReferences(opts.LinkedIssues))
+	}
+	fmt.Fprintf(out, "\n%s\n", opts.Description)
+	return nil
+}
+
 func postCreateActions(apiClient *gitlab.Client, issue *gitlab.Issue, opts *CreateOpts, repo glrepo.Interface) error {
 	if len(opts.LinkedIssues) > 0 {
 		var err error
@@ -2,11 +2,15 @@ package create
 
 import (
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/httpmock"
 	"gitlab.com/gitlab-org/cli/test"
 )
@@ -78,3 +82,46 @@ func TestIssueCreateWhenIssuesDisabled(t *testing.T) {
 		"Make sure issues are enabled for the \"OWNER/REPO\" project, and if required, you are a member of the project.\n",
 		output.Stderr())
 }
+
+func TestIssueCreateTemplateDryRun(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO?license=true&with_custom_attributes=true",
+		httpmock.NewStringResponse(http.StatusOK, `{
+							  "id": 37777023,
+							  "path_with_namespace": "OWNER/REPO",
+							  "web_url": "https://gitlab.com/OWNER/REPO",
+							  "issues_enabled": true
+							}`))
+
+	tmplDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmplDir, ".gitlab", "issue_templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmplDir, ".gitlab", "issue_templates", "Bug.md"),
+		[]byte("Found on %{current_branch}.\nRelated to %{linked_issues} and %{linked_mr}.\n"), 0o644))
+
+	oldToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return tmplDir, nil }
+	t.Cleanup(func() { git.ToplevelDir = oldToplevelDir })
+
+	t.Run("text", func(t *testing.T) {
+		output, err := runCommand(fakeHTTP, false, `--title "Crash" --template Bug --linked-issues 3,4 --linked-mr 7 --label bug --dry-run`)
+		require.NoError(t, err)
+		assert.Equal(t, "Title: Crash\nLabels: bug\nLinked issues: #3, #4\n\nFound on main.\nRelated to #3, #4 and !7.\n", output.String())
+	})
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO?license=true&with_custom_attributes=true",
+		httpmock.NewStringResponse(http.StatusOK, `{
+							  "id": 37777023,
+							  "path_with_namespace": "OWNER/REPO",
+							  "issues_enabled": true
+							}`))
+
+	t.Run("json", func(t *testing.T) {
+		output, err := runCommand(fakeHTTP, false, `--title "Crash" --template Bug --dry-run --output json`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"title": "Crash", "description": "Found on main.\nRelated to  and .", "labels": ""}`, output.String())
+	})
+}
@@ -0,0 +1,392 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issuable"
+	issuableListCmd "gitlab.com/gitlab-org/cli/commands/issuable/list"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+// Issue is an exported issue, as written by 'issue export' and read by 'issue import'.
+type Issue struct {
+	Reference    string     `json:"reference"`
+	ProjectID    int        `json:"project_id"`
+	IID          int        `json:"iid"`
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	State        string     `json:"state"`
+	IssueType    string     `json:"issue_type"`
+	Confidential bool       `json:"confidential"`
+	Author       string     `json:"author"`
+	Assignees    []string   `json:"assignees"`
+	Labels       []string   `json:"labels"`
+	Milestone    string     `json:"milestone"`
+	DueDate      string     `json:"due_date"`
+	Weight       int        `json:"weight"`
+	CreatedAt    *time.Time `json:"created_at"`
+	ClosedAt     *time.Time `json:"closed_at"`
+	WebURL       string     `json:"web_url"`
+	TimeEstimate int        `json:"time_estimate"`
+	TimeSpent    int        `json:"time_spent"`
+	Notes        []Note     `json:"notes"`
+	Links        []Link     `json:"links"`
+}
+
+// Note is a comment of an exported issue. System notes record changes made to the issue.
+type Note struct {
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	System    bool       `json:"system"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// Link is a link from an exported issue to another issue.
+type Link struct {
+	LinkType  string `json:"link_type"`
+	Reference string `json:"reference"`
+	ProjectID int    `json:"project_id"`
+	IID       int    `json:"iid"`
+	WebURL    string `json:"web_url"`
+}
+
+// Formats of exported issues.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// csvHeader are the columns of exported issues in CSV. Notes and links are written as JSON.
+var csvHeader = []string{
+	"reference", "project_id", "iid", "title", "description", "state", "issue_type", "confidential",
+	"author", "assignees", "labels", "milestone", "due_date", "weight", "created_at", "closed_at",
+	"web_url", "time_estimate", "time_spent", "notes", "links",
+}
+
+// exportPageSize is the number of notes listed per request.
+const exportPageSize = 100
+
+type ExportOptions struct {
+	Filter     string
+	Format     string
+	OutputFile string
+}
+
+func NewCmdExport(f *cmdutils.Factory) *cobra.Command {
+	opts := &ExportOptions{}
+
+	issueExportCmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: `Export issues with their notes, links, and time stats to JSON or CSV.`,
+		Long: heredoc.Doc(`
+			Export all issues listed by 'glab issue list' with the flags of --filter, including their
+			notes, links, and time stats. Import them into another project with 'glab issue import'.
+
+			In CSV, each issue is a row, and its notes and links are written as JSON.
+		`),
+		Example: heredoc.Doc(`
+			glab issue export --output-file issues.json
+			glab issue export --filter "--label bug --closed" --format csv --output-file bugs.csv
+			glab issue export --filter "--all --milestone 16.0" -R gitlab-org/cli > issues.json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Format != FormatJSON && opts.Format != FormatCSV {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--format must be one of: json, csv.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issues, err := cmdutils.ListMatches[*gitlab.Issue](f, func(f *cmdutils.Factory) *cobra.Command {
+				return issuableListCmd.NewCmdList(f, nil, issuable.TypeIssue)
+			}, opts.Filter, "issues")
+			if err != nil {
+				return err
+			}
+
+			exported := make([]*Issue, 0, len(issues))
+			for _, issue := range issues {
+				e, err := exportIssue(apiClient, issue)
+				if err != nil {
+					return fmt.Errorf("failed to export issue %s: %w", issueutils.IssueReference(issue), err)
+				}
+				exported = append(exported, e)
+			}
+
+			if opts.OutputFile == "" {
+				return Write(f.IO.StdOut, opts.Format, exported)
+			}
+
+			var buf bytes.Buffer
+			if err := Write(&buf, opts.Format, exported); err != nil {
+				return err
+			}
+			if err := os.WriteFile(opts.OutputFile, buf.Bytes(), 0o600); err != nil {
+				return err
+			}
+			fmt.Fprintf(f.IO.StdErr, "%s Exported %d issues to %s.\n", f.IO.Color().GreenCheck(), len(exported), opts.OutputFile)
+			return nil
+		},
+	}
+
+	issueExportCmd.Flags().StringVar(&opts.Filter, "filter", "--all", "Export the issues listed by 'glab issue list' with these flags.")
+	issueExportCmd.Flags().StringVarP(&opts.Format, "format", "F", FormatJSON, "Format output as: json, csv.")
+	issueExportCmd.Flags().StringVarP(&opts.OutputFile, "output-file", "o", "", "Write the issues to this file, rather than to standard output.")
+
+	return issueExportCmd
+}
+
+func exportIssue(client *gitlab.Client, issue *gitlab.Issue) (*Issue, error) {
+	e := &Issue{
+		Reference:    issueutils.IssueReference(issue),
+		ProjectID:    issue.ProjectID,
+		IID:          issue.IID,
+		Title:        issue.Title,
+		Description:  issue.Description,
+		State:        issue.State,
+		Confidential: issue.Confidential,
+		Labels:       issue.Labels,
+		Weight:       issue.Weight,
+		CreatedAt:    issue.CreatedAt,
+		ClosedAt:     issue.ClosedAt,
+		WebURL:       issue.WebURL,
+		Assignees:    []string{},
+		Notes:        []Note{},
+		Links:        []Link{},
+	}
+	if e.Labels == nil {
+		e.Labels = []string{}
+	}
+	if issue.IssueType != nil {
+		e.IssueType = *issue.IssueType
+	}
+	if issue.Author != nil {
+		e.Author = issue.Author.Username
+	}
+	for _, assignee := range issue.Assignees {
+		e.Assignees = append(e.Assignees, assignee.Username)
+	}
+	if issue.Milestone != nil {
+		e.Milestone = issue.Milestone.Title
+	}
+	if issue.DueDate != nil {
+		e.DueDate = issue.DueDate.String()
+	}
+	if issue.TimeStats != nil {
+		e.TimeEstimate = issue.TimeStats.TimeEstimate
+		e.TimeSpent = issue.TimeStats.TotalTimeSpent
+	}
+
+	notes, err := listNotes(client, issue)
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		e.Notes = append(e.Notes, Note{
+			Author:    note.Author.Username,
+			Body:      note.Body,
+			System:    note.System,
+			CreatedAt: note.CreatedAt,
+		})
+	}
+
+	links, err := api.ListIssueLinks(client, issue.ProjectID, issue.IID)
+	if err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		l := Link{
+			LinkType:  link.LinkType,
+			ProjectID: link.ProjectID,
+			IID:       link.IID,
+			WebURL:    link.WebURL,
+		}
+		if link.References != nil {
+			l.Reference = link.References.Full
+		}
+		e.Links = append(e.Links, l)
+	}
+
+	return e, nil
+}
+
+// listNotes returns all notes of an issue, oldest first.
+func listNotes(client *gitlab.Client, issue *gitlab.Issue) ([]*gitlab.Note, error) {
+	opts := &gitlab.ListIssueNotesOptions{
+		OrderBy: gitlab.Ptr("created_at"),
+		Sort:    gitlab.Ptr("asc"),
+	}
+	opts.PerPage = exportPageSize
+
+	var notes []*gitlab.Note
+	for opts.Page = 1; ; opts.Page++ {
+		pageNotes, err := api.ListIssueNotes(client, issue.ProjectID, issue.IID, opts)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, pageNotes...)
+		if len(pageNotes) < exportPageSize {
+			return notes, nil
+		}
+	}
+}
+
+// Write writes exported issues in a format.
+func Write(w io.Writer, format string, issues []*Issue) error {
+	if format == FormatJSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(issues)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		notes, err := json.Marshal(issue.Notes)
+		if err != nil {
+			return err
+		}
+		links, err := json.Marshal(issue.Links)
+		if err != nil {
+			return err
+		}
+		err = cw.Write([]string{
+			issue.Reference,
+			strconv.Itoa(issue.ProjectID),
+			strconv.Itoa(issue.IID),
+			issue.Title,
+			issue.Description,
+			issue.State,
+			issue.IssueType,
+			strconv.FormatBool(issue.Confidential),
+			issue.Author,
+			strings.Join(issue.Assignees, ","),
+			strings.Join(issue.Labels, ","),
+			issue.Milestone,
+			issue.DueDate,
+			strconv.Itoa(issue.Weight),
+			formatTime(issue.CreatedAt),
+			formatTime(issue.ClosedAt),
+			issue.WebURL,
+			strconv.Itoa(issue.TimeEstimate),
+			strconv.Itoa(issue.TimeSpent),
+			string(notes),
+			string(links),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Read reads issues written by Write in a format.
+func Read(r io.Reader, format string) ([]*Issue, error) {
+	var issues []*Issue
+	if format == FormatJSON {
+		if err := json.NewDecoder(r).Decode(&issues); err != nil {
+			return nil, fmt.Errorf("invalid JSON export: %w", err)
+		}
+		return issues, nil
+	}
+
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV export: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("invalid CSV export: the file is empty.")
+	}
+
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[name] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("invalid CSV export: no title column.")
+	}
+
+	for n, row := range rows[1:] {
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(row) {
+				return row[i]
+			}
+			return ""
+		}
+		issue := &Issue{
+			Reference:    field("reference"),
+			Title:        field("title"),
+			Description:  field("description"),
+			State:        field("state"),
+			IssueType:    field("issue_type"),
+			Confidential: field("confidential") == "true",
+			Author:       field("author"),
+			Assignees:    splitList(field("assignees")),
+			Labels:       splitList(field("labels")),
+			Milestone:    field("milestone"),
+			DueDate:      field("due_date"),
+			WebURL:       field("web_url"),
+		}
+		issue.ProjectID, _ = strconv.Atoi(field("project_id"))
+		issue.IID, _ = strconv.Atoi(field("iid"))
+		issue.Weight, _ = strconv.Atoi(field("weight"))
+		issue.TimeEstimate, _ = strconv.Atoi(field("time_estimate"))
+		issue.TimeSpent, _ = strconv.Atoi(field("time_spent"))
+		issue.CreatedAt = parseTime(field("created_at"))
+		issue.ClosedAt = parseTime(field("closed_at"))
+		if notes := field("notes"); notes != "" {
+			if err := json.Unmarshal([]byte(notes), &issue.Notes); err != nil {
+				return nil, fmt.Errorf("invalid CSV export: notes of row %d: %w", n+2, err)
+			}
+		}
+		if links := field("links"); links != "" {
+			if err := json.Unmarshal([]byte(links), &issue.Links); err != nil {
+				return nil, fmt.Errorf("invalid CSV export: links of row %d: %w", n+2, err)
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues, nil
+}
+
+func splitList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, ",")
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseTime(s string) *time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,143 @@
+package export
+
+import (
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdExport(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueExport(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 112, "iid": 12, "project_id": 1, "title": "Crash on start", "description": "It crashes.",
+				"state": "closed", "issue_type": "issue", "author": {"username": "alice"},
+				"assignees": [{"username": "bob"}], "labels": ["bug"], "milestone": {"title": "1.0"},
+				"created_at": "2024-01-02T10:00:00Z", "closed_at": "2024-01-05T10:00:00Z",
+				"web_url": "https://gitlab.com/OWNER/REPO/-/issues/12", "references": {"full": "OWNER/REPO#12"},
+				"time_stats": {"time_estimate": 7200, "total_time_spent": 5400}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/notes",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1, "body": "Fixed in !3.", "author": {"username": "bob"}, "system": false, "created_at": "2024-01-04T10:00:00Z"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "link_type": "blocks", "references": {"full": "OWNER/REPO#13"},
+				"web_url": "https://gitlab.com/OWNER/REPO/-/issues/13"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "--filter '--all --label bug'")
+	require.NoError(t, err)
+
+	assert.Contains(t, fakeHTTP.Requests[0].URL.RawQuery, "labels=bug")
+	assert.Contains(t, fakeHTTP.Requests[0].URL.RawQuery, "state=all")
+	assert.Contains(t, fakeHTTP.Requests[0].URL.RawQuery, "per_page=100")
+
+	issues, err := Read(bytes.NewBufferString(output.String()), FormatJSON)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+
+	issue := issues[0]
+	assert.Equal(t, "OWNER/REPO#12", issue.Reference)
+	assert.Equal(t, "closed", issue.State)
+	assert.Equal(t, "alice", issue.Author)
+	assert.Equal(t, []string{"bob"}, issue.Assignees)
+	assert.Equal(t, "1.0", issue.Milestone)
+	assert.Equal(t, 7200, issue.TimeEstimate)
+	assert.Equal(t, 5400, issue.TimeSpent)
+	require.Len(t, issue.Notes, 1)
+	assert.Equal(t, "Fixed in !3.", issue.Notes[0].Body)
+	assert.Equal(t, []Link{{
+		LinkType:  "blocks",
+		Reference: "OWNER/REPO#13",
+		ProjectID: 1,
+		IID:       13,
+		WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/13",
+	}}, issue.Links)
+}
+
+func TestIssueExportInvalidFormat(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "--format xml")
+	require.EqualError(t, err, "--format must be one of: json, csv.")
+}
+
+func TestWriteRead(t *testing.T) {
+	created := time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)
+	issues := []*Issue{
+		{
+			Reference:    "OWNER/REPO#12",
+			ProjectID:    1,
+			IID:          12,
+			Title:        "Crash, on start",
+			Description:  "Line one.\nLine \"two\".",
+			State:        "opened",
+			IssueType:    "issue",
+			Confidential: true,
+			Author:       "alice",
+			Assignees:    []string{"bob", "carol"},
+			Labels:       []string{"bug", "priority::1"},
+			Milestone:    "1.0",
+			DueDate:      "2024-02-01",
+			Weight:       3,
+			CreatedAt:    &created,
+			WebURL:       "https://gitlab.com/OWNER/REPO/-/issues/12",
+			TimeEstimate: 7200,
+			TimeSpent:    5400,
+			Notes:        []Note{{Author: "bob", Body: "Confirmed, it crashes.", CreatedAt: &created}},
+			Links:        []Link{{LinkType: "relates_to", Reference: "OWNER/REPO#13", ProjectID: 1, IID: 13}},
+		},
+	}
+
+	for _, format := range []string{FormatJSON, FormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, format, issues))
+
+			got, err := Read(&buf, format)
+			require.NoError(t, err)
+			assert.Equal(t, issues, got)
+		})
+	}
+}
+
+func TestIssueExportOutputFile(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	file := filepath.Join(t.TempDir(), "issues.csv")
+	output, err := runCommand(t, fakeHTTP, "--format csv --output-file "+file)
+	require.NoError(t, err)
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Exported 0 issues to "+file+".\n", output.Stderr())
+}
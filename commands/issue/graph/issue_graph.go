@@ -0,0 +1,442 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+type GraphOptions struct {
+	Depth        int
+	Format       string
+	OutputFormat string
+}
+
+// Node is an issue of the graph, with the issues that block it, recursively, and its merge requests.
+// The issues it blocks and the issues it relates to are only listed for the issue of the graph.
+type Node struct {
+	ProjectID     int             `json:"project_id"`
+	IID           int             `json:"iid"`
+	Reference     string          `json:"reference"`
+	Title         string          `json:"title"`
+	State         string          `json:"state"`
+	WebURL        string          `json:"web_url"`
+	BlockedBy     []*Node         `json:"blocked_by"`
+	Blocks        []*Node         `json:"blocks,omitempty"`
+	RelatesTo     []*Node         `json:"relates_to,omitempty"`
+	MergeRequests []*MergeRequest `json:"merge_requests"`
+}
+
+// MergeRequest is a merge request related to an issue of the graph.
+type MergeRequest struct {
+	ProjectID int    `json:"project_id"`
+	IID       int    `json:"iid"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	WebURL    string `json:"web_url"`
+	// Closes is true when merging the merge request closes the issue.
+	Closes bool `json:"closes"`
+}
+
+func NewCmdGraph(f *cmdutils.Factory) *cobra.Command {
+	opts := &GraphOptions{}
+
+	issueGraphCmd := &cobra.Command{
+		Use:   "graph <id> [flags]",
+		Short: `Show the issues that block an issue, and the related merge requests, as a graph.`,
+		Long: heredoc.Doc(`
+			Show the graph of an issue: the issues that block it, the issues that block them,
+			and so on, up to --depth levels, with the merge requests that close or mention
+			each issue. The issues the issue blocks and relates to are listed too.
+
+			Open blockers are highlighted: they stand between the issue and its completion.
+
+			The graph is rendered as a tree in the terminal, or in the Mermaid or DOT (Graphviz)
+			language with --format, to be included in Markdown or rendered as an image.
+		`),
+		Example: heredoc.Doc(`
+			glab issue graph 12
+			glab issue graph 12 --depth 5
+			glab issue graph 12 --format mermaid
+			glab issue graph 12 --format dot | dot -Tsvg > issue-12.svg
+			glab issue graph 12 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Depth < 1 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--depth must be at least 1.")}
+			}
+			switch opts.Format {
+			case "tree", "mermaid", "dot":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("--format must be one of: tree, mermaid, dot.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, _, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			b := &builder{client: apiClient, projectID: issue.ProjectID, maxDepth: opts.Depth, seen: map[string]bool{}}
+			root := &Node{
+				ProjectID: issue.ProjectID,
+				IID:       issue.IID,
+				Reference: fmt.Sprintf("#%d", issue.IID),
+				Title:     issue.Title,
+				State:     issue.State,
+				WebURL:    issue.WebURL,
+			}
+			if err := b.expand(root, 0); err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				graphJSON, _ := json.Marshal(root)
+				fmt.Fprintln(f.IO.StdOut, string(graphJSON))
+				return nil
+			}
+
+			switch opts.Format {
+			case "mermaid":
+				fmt.Fprint(f.IO.StdOut, renderMermaid(root))
+			case "dot":
+				fmt.Fprint(f.IO.StdOut, renderDOT(root))
+			default:
+				printTree(f.IO, root)
+			}
+			return nil
+		},
+	}
+
+	issueGraphCmd.Flags().IntVarP(&opts.Depth, "depth", "d", 3, "Maximum number of levels of blocking issues to follow.")
+	issueGraphCmd.Flags().StringVarP(&opts.Format, "format", "f", "tree", "Render the graph as: tree, mermaid, dot.")
+	issueGraphCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json. JSON ignores --format.")
+
+	return issueGraphCmd
+}
+
+type builder struct {
+	client *gitlab.Client
+	// projectID is the project of the issue of the graph. Issues and merge requests of other
+	// projects are referenced with the path of their project.
+	projectID int
+	maxDepth  int
+	seen      map[string]bool
+}
+
+// expand fetches the merge requests of an issue, and its links, then expands its blockers until
+// maxDepth. Issues already in the graph aren't expanded again, which stops cycles of blocking issues.
+func (b *builder) expand(node *Node, depth int) error {
+	b.seen[issueKey(node.ProjectID, node.IID)] = true
+	node.BlockedBy = []*Node{}
+
+	var err error
+	node.MergeRequests, err = b.mergeRequests(node)
+	if err != nil {
+		return err
+	}
+
+	if depth >= b.maxDepth {
+		return nil
+	}
+
+	relations, err := api.ListIssueLinks(b.client, node.ProjectID, node.IID)
+	if err != nil {
+		return err
+	}
+	for _, relation := range relations {
+		child := b.newNode(relation)
+		switch relation.LinkType {
+		case "is_blocked_by":
+			node.BlockedBy = append(node.BlockedBy, child)
+		case "blocks":
+			if depth == 0 {
+				node.Blocks = append(node.Blocks, child)
+			}
+		default:
+			if depth == 0 {
+				node.RelatesTo = append(node.RelatesTo, child)
+			}
+		}
+	}
+
+	for _, child := range node.BlockedBy {
+		if b.seen[issueKey(child.ProjectID, child.IID)] {
+			child.BlockedBy = []*Node{}
+			child.MergeRequests = []*MergeRequest{}
+			continue
+		}
+		if err := b.expand(child, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *builder) newNode(relation *gitlab.IssueRelation) *Node {
+	reference := fmt.Sprintf("#%d", relation.IID)
+	if relation.ProjectID != b.projectID && relation.References != nil && relation.References.Full != "" {
+		reference = relation.References.Full
+	}
+	return &Node{
+		ProjectID: relation.ProjectID,
+		IID:       relation.IID,
+		Reference: reference,
+		Title:     relation.Title,
+		State:     relation.State,
+		WebURL:    relation.WebURL,
+	}
+}
+
+// mergeRequests returns the merge requests that close an issue, then the other ones that mention it.
+func (b *builder) mergeRequests(node *Node) ([]*MergeRequest, error) {
+	closing, err := api.ListIssueClosingMRs(b.client, node.ProjectID, node.IID)
+	if err != nil {
+		return nil, err
+	}
+	related, err := api.ListIssueRelatedMRs(b.client, node.ProjectID, node.IID)
+	if err != nil {
+		return nil, err
+	}
+
+	mrs := []*MergeRequest{}
+	seen := map[int]bool{}
+	for i, list := range [][]*gitlab.MergeRequest{closing, related} {
+		for _, mr := range list {
+			if seen[mr.ID] {
+				continue
+			}
+			seen[mr.ID] = true
+
+			reference := fmt.Sprintf("!%d", mr.IID)
+			if mr.ProjectID != b.projectID && mr.References != nil && mr.References.Full != "" {
+				reference = mr.References.Full
+			}
+			mrs = append(mrs, &MergeRequest{
+				ProjectID: mr.ProjectID,
+				IID:       mr.IID,
+				Reference: reference,
+				Title:     mr.Title,
+				State:     mr.State,
+				WebURL:    mr.WebURL,
+				Closes:    i == 0,
+			})
+		}
+	}
+	return mrs, nil
+}
+
+func issueKey(projectID, iid int) string {
+	return fmt.Sprintf("%d#%d", projectID, iid)
+}
+
+// openBlockers returns the open issues that block an issue, directly or not. The issues of
+// seen are left out, like the issue itself when blockers form a cycle.
+func openBlockers(node *Node, seen map[string]bool) []*Node {
+	var blockers []*Node
+	for _, child := range node.BlockedBy {
+		key := issueKey(child.ProjectID, child.IID)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if child.State == "opened" {
+			blockers = append(blockers, child)
+		}
+		blockers = append(blockers, openBlockers(child, seen)...)
+	}
+	return blockers
+}
+
+func printTree(io *iostreams.IOStreams, root *Node) {
+	c := io.Color()
+
+	fmt.Fprintf(io.StdOut, "%s %s %s\n", c.Bold(root.Reference), c.Bold(root.Title), c.Gray("("+root.State+")"))
+	fmt.Fprint(io.StdOut, renderTree(c, root, ""))
+
+	blockers := openBlockers(root, map[string]bool{issueKey(root.ProjectID, root.IID): true})
+	fmt.Fprintln(io.StdOut)
+	if len(blockers) == 0 {
+		fmt.Fprintf(io.StdOut, "%s No open blockers.\n", c.GreenCheck())
+		return
+	}
+	references := make([]string, 0, len(blockers))
+	for _, blocker := range blockers {
+		references = append(references, blocker.Reference)
+	}
+	noun := "blocker"
+	if len(blockers) > 1 {
+		noun = "blockers"
+	}
+	fmt.Fprintf(io.StdOut, "%s %d open %s: %s\n", c.WarnIcon(), len(blockers), noun, strings.Join(references, ", "))
+}
+
+// renderTree renders the blockers, the blocked issues, the related issues, and the merge
+// requests of an issue, one per line.
+func renderTree(c *iostreams.ColorPalette, node *Node, prefix string) string {
+	type line struct {
+		text  string
+		child *Node
+	}
+	var lines []line
+	for _, child := range node.BlockedBy {
+		text := fmt.Sprintf("%s %s %s %s", c.Gray("blocked by"), c.Bold(child.Reference), child.Title, c.Gray("("+child.State+")"))
+		if child.State == "opened" {
+			text = fmt.Sprintf("%s %s %s %s", c.Gray("blocked by"), c.Red(child.Reference), child.Title, c.Red("(open blocker)"))
+		}
+		lines = append(lines, line{text, child})
+	}
+	for _, child := range node.Blocks {
+		lines = append(lines, line{fmt.Sprintf("%s %s %s %s", c.Gray("blocks"), child.Reference, child.Title, c.Gray("("+child.State+")")), nil})
+	}
+	for _, child := range node.RelatesTo {
+		lines = append(lines, line{fmt.Sprintf("%s %s %s %s", c.Gray("relates to"), child.Reference, child.Title, c.Gray("("+child.State+")")), nil})
+	}
+	for _, mr := range node.MergeRequests {
+		relation := "mentioned in"
+		if mr.Closes {
+			relation = "closed by"
+		}
+		lines = append(lines, line{fmt.Sprintf("%s %s %s %s", c.Gray(relation), c.Cyan(mr.Reference), mr.Title, c.Gray("("+mr.State+")")), nil})
+	}
+
+	var b strings.Builder
+	for i, l := range lines {
+		branch, childPrefix := "├── ", "│   "
+		if i == len(lines)-1 {
+			branch, childPrefix = "└── ", "    "
+		}
+		fmt.Fprintf(&b, "%s%s%s\n", prefix, branch, l.text)
+		if l.child != nil {
+			b.WriteString(renderTree(c, l.child, prefix+childPrefix))
+		}
+	}
+	return b.String()
+}
+
+// edge is an edge of the Mermaid and DOT graphs.
+type edge struct {
+	from, to, label string
+	dashed          bool
+}
+
+// graph flattens the tree of an issue into nodes and edges, for the Mermaid and DOT renderers.
+type graph struct {
+	root         string
+	ids          []string
+	labels       map[string]string
+	openBlockers map[string]bool
+	edges        []edge
+}
+
+func newGraph(root *Node) *graph {
+	g := &graph{labels: map[string]string{}, openBlockers: map[string]bool{}}
+	g.root = g.addIssue(root, false)
+	g.walk(root)
+	return g
+}
+
+func (g *graph) add(id, label string) {
+	if _, ok := g.labels[id]; ok {
+		return
+	}
+	g.ids = append(g.ids, id)
+	g.labels[id] = label
+}
+
+func (g *graph) addIssue(node *Node, blocker bool) string {
+	id := fmt.Sprintf("issue_%d_%d", node.ProjectID, node.IID)
+	g.add(id, fmt.Sprintf("%s %s (%s)", node.Reference, node.Title, node.State))
+	if blocker && node.State == "opened" && id != g.root {
+		g.openBlockers[id] = true
+	}
+	return id
+}
+
+func (g *graph) walk(node *Node) {
+	id := fmt.Sprintf("issue_%d_%d", node.ProjectID, node.IID)
+	for _, child := range node.BlockedBy {
+		g.edges = append(g.edges, edge{from: id, to: g.addIssue(child, true), label: "blocked by"})
+		g.walk(child)
+	}
+	for _, child := range node.Blocks {
+		g.edges = append(g.edges, edge{from: id, to: g.addIssue(child, false), label: "blocks"})
+	}
+	for _, child := range node.RelatesTo {
+		g.edges = append(g.edges, edge{from: id, to: g.addIssue(child, false), label: "relates to", dashed: true})
+	}
+	for _, mr := range node.MergeRequests {
+		mrID := fmt.Sprintf("mr_%d_%d", mr.ProjectID, mr.IID)
+		g.add(mrID, fmt.Sprintf("%s %s (%s)", mr.Reference, mr.Title, mr.State))
+		if mr.Closes {
+			g.edges = append(g.edges, edge{from: id, to: mrID, label: "closed by"})
+		} else {
+			g.edges = append(g.edges, edge{from: id, to: mrID, label: "mentioned in", dashed: true})
+		}
+	}
+}
+
+func renderMermaid(root *Node) string {
+	g := newGraph(root)
+
+	var b strings.Builder
+	b.WriteString("graph TD\n")
+	for _, id := range g.ids {
+		fmt.Fprintf(&b, "    %s[\"%s\"]\n", id, strings.ReplaceAll(g.labels[id], `"`, "#quot;"))
+	}
+	for _, e := range g.edges {
+		arrow := "-->"
+		if e.dashed {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "    %s %s|%s| %s\n", e.from, arrow, e.label, e.to)
+	}
+	if len(g.openBlockers) > 0 {
+		b.WriteString("    classDef openBlocker fill:#fdd,stroke:#c00,stroke-width:2px\n")
+		for _, id := range g.ids {
+			if g.openBlockers[id] {
+				fmt.Fprintf(&b, "    class %s openBlocker\n", id)
+			}
+		}
+	}
+	return b.String()
+}
+
+func renderDOT(root *Node) string {
+	g := newGraph(root)
+
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	b.WriteString("    node [shape=box];\n")
+	for _, id := range g.ids {
+		attributes := fmt.Sprintf("label=%q", g.labels[id])
+		if g.openBlockers[id] {
+			attributes += ", color=red, fontcolor=red, penwidth=2"
+		}
+		fmt.Fprintf(&b, "    %s [%s];\n", id, attributes)
+	}
+	for _, e := range g.edges {
+		attributes := fmt.Sprintf("label=%q", e.label)
+		if e.dashed {
+			attributes += ", style=dashed"
+		}
+		fmt.Fprintf(&b, "    %s -> %s [%s];\n", e.from, e.to, attributes)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdGraph(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueGraphTree(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	// Issue #12 is blocked by #13 and by #14 of another project.
+	// #13 is blocked by #15, which is blocked by #12: a cycle.
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened", "link_type": "is_blocked_by"},
+			{"id": 214, "iid": 14, "project_id": 2, "title": "Design", "state": "closed", "link_type": "is_blocked_by",
+				"references": {"full": "OWNER/DESIGN#14"}},
+			{"id": 120, "iid": 20, "project_id": 1, "title": "Launch", "state": "opened", "link_type": "blocks"},
+			{"id": 121, "iid": 21, "project_id": 1, "title": "Docs", "state": "opened", "link_type": "relates_to"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/closed_by",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/related_merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"},
+			{"id": 1005, "iid": 5, "project_id": 1, "title": "Prepare", "state": "merged"}
+		]`))
+
+	for _, issue := range []string{"1/issues/13", "2/issues/14"} {
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/closed_by",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/related_merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+	}
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/13/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 115, "iid": 15, "project_id": 1, "title": "Database", "state": "opened", "link_type": "is_blocked_by"},
+			{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened", "link_type": "blocks"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/2/issues/14/links",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/15/closed_by",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/15/related_merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/15/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened", "link_type": "is_blocked_by"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "12")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		#12 Deliverable (opened)
+		├── blocked by #13 Backend (open blocker)
+		│   └── blocked by #15 Database (open blocker)
+		│       └── blocked by #12 Deliverable (open blocker)
+		├── blocked by OWNER/DESIGN#14 Design (closed)
+		├── blocks #20 Launch (opened)
+		├── relates to #21 Docs (opened)
+		├── closed by !4 Ship it (opened)
+		└── mentioned in !5 Prepare (merged)
+
+		! 2 open blockers: #13, #15
+	`), output.String())
+}
+
+func TestIssueGraphDepth(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened", "link_type": "is_blocked_by"},
+			{"id": 214, "iid": 14, "project_id": 2, "title": "Design", "state": "closed", "link_type": "is_blocked_by",
+				"references": {"full": "OWNER/DESIGN#14"}},
+			{"id": 120, "iid": 20, "project_id": 1, "title": "Launch", "state": "opened", "link_type": "blocks"},
+			{"id": 121, "iid": 21, "project_id": 1, "title": "Docs", "state": "opened", "link_type": "relates_to"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/closed_by",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/related_merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"},
+			{"id": 1005, "iid": 5, "project_id": 1, "title": "Prepare", "state": "merged"}
+		]`))
+
+	for _, issue := range []string{"1/issues/13", "2/issues/14"} {
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/closed_by",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/related_merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+	}
+
+	output, err := runCommand(t, fakeHTTP, "12 --depth 1 --output json")
+	require.NoError(t, err)
+
+	var root Node
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &root))
+	require.Len(t, root.BlockedBy, 2)
+	assert.Empty(t, root.BlockedBy[0].BlockedBy)
+	assert.Len(t, root.Blocks, 1)
+	assert.Len(t, root.RelatesTo, 1)
+	require.Len(t, root.MergeRequests, 2)
+	assert.True(t, root.MergeRequests[0].Closes)
+	assert.False(t, root.MergeRequests[1].Closes)
+}
+
+func TestIssueGraphMermaid(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened", "link_type": "is_blocked_by"},
+			{"id": 214, "iid": 14, "project_id": 2, "title": "Design", "state": "closed", "link_type": "is_blocked_by",
+				"references": {"full": "OWNER/DESIGN#14"}},
+			{"id": 120, "iid": 20, "project_id": 1, "title": "Launch", "state": "opened", "link_type": "blocks"},
+			{"id": 121, "iid": 21, "project_id": 1, "title": "Docs", "state": "opened", "link_type": "relates_to"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/closed_by",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/related_merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"},
+			{"id": 1005, "iid": 5, "project_id": 1, "title": "Prepare", "state": "merged"}
+		]`))
+
+	for _, issue := range []string{"1/issues/13", "2/issues/14"} {
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/closed_by",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/related_merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+	}
+
+	output, err := runCommand(t, fakeHTTP, "12 --depth 1 --format mermaid")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		graph TD
+		    issue_1_12["#12 Deliverable (opened)"]
+		    issue_1_13["#13 Backend (opened)"]
+		    issue_2_14["OWNER/DESIGN#14 Design (closed)"]
+		    issue_1_20["#20 Launch (opened)"]
+		    issue_1_21["#21 Docs (opened)"]
+		    mr_1_4["!4 Ship it (opened)"]
+		    mr_1_5["!5 Prepare (merged)"]
+		    issue_1_12 -->|blocked by| issue_1_13
+		    issue_1_12 -->|blocked by| issue_2_14
+		    issue_1_12 -->|blocks| issue_1_20
+		    issue_1_12 -.->|relates to| issue_1_21
+		    issue_1_12 -->|closed by| mr_1_4
+		    issue_1_12 -.->|mentioned in| mr_1_5
+		    classDef openBlocker fill:#fdd,stroke:#c00,stroke-width:2px
+		    class issue_1_13 openBlocker
+	`), output.String())
+}
+
+func TestIssueGraphDOT(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened", "link_type": "is_blocked_by"},
+			{"id": 214, "iid": 14, "project_id": 2, "title": "Design", "state": "closed", "link_type": "is_blocked_by",
+				"references": {"full": "OWNER/DESIGN#14"}},
+			{"id": 120, "iid": 20, "project_id": 1, "title": "Launch", "state": "opened", "link_type": "blocks"},
+			{"id": 121, "iid": 21, "project_id": 1, "title": "Docs", "state": "opened", "link_type": "relates_to"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/closed_by",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/1/issues/12/related_merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1004, "iid": 4, "project_id": 1, "title": "Ship it", "state": "opened"},
+			{"id": 1005, "iid": 5, "project_id": 1, "title": "Prepare", "state": "merged"}
+		]`))
+
+	for _, issue := range []string{"1/issues/13", "2/issues/14"} {
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/closed_by",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+		fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/"+issue+"/related_merge_requests",
+			httpmock.NewStringResponse(http.StatusOK, `[]`))
+	}
+
+	output, err := runCommand(t, fakeHTTP, "12 --depth 1 --format dot")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "digraph {\n")
+	assert.Contains(t, output.String(), `issue_1_13 [label="#13 Backend (opened)", color=red, fontcolor=red, penwidth=2];`)
+	assert.Contains(t, output.String(), `issue_2_14 [label="OWNER/DESIGN#14 Design (closed)"];`)
+	assert.Contains(t, output.String(), `issue_1_12 -> mr_1_5 [label="mentioned in", style=dashed];`)
+}
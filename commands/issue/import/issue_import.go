@@ -0,0 +1,409 @@
+package issueimport
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/export"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/recovery"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// recoveryFile is the name of the recovery file that records the progress of an import.
+const recoveryFile = "issue-import.json"
+
+type ImportOptions struct {
+	File    string
+	Format  string
+	DryRun  bool
+	Recover bool
+}
+
+// Progress records the issues imported from a file, to resume an interrupted import.
+type Progress struct {
+	// File is the absolute path of the imported file.
+	File string `json:"file"`
+	// Imported records the import of each issue, keyed by the reference of the issue.
+	Imported map[string]*ImportedIssue `json:"imported"`
+}
+
+// ImportedIssue records the steps of the import of an issue that are done, so a resumed
+// import neither creates the issue again nor repeats its steps.
+type ImportedIssue struct {
+	// IID is the IID of the issue created for the imported issue.
+	IID int `json:"iid"`
+	// Notes and Links are the numbers of comments and links of the issue already handled.
+	Notes        int  `json:"notes"`
+	TimeEstimate bool `json:"time_estimate"`
+	TimeSpent    bool `json:"time_spent"`
+	Links        int  `json:"links"`
+	// Done is set once every step of the import of the issue is done.
+	Done bool `json:"done"`
+}
+
+// done returns the number of issues fully imported.
+func (p *Progress) done() int {
+	count := 0
+	for _, imported := range p.Imported {
+		if imported.Done {
+			count++
+		}
+	}
+	return count
+}
+
+func NewCmdImport(f *cmdutils.Factory) *cobra.Command {
+	opts := &ImportOptions{}
+
+	issueImportCmd := &cobra.Command{
+		Use:   "import <file> [flags]",
+		Short: `Create issues from a file written by 'glab issue export'.`,
+		Long: heredoc.Doc(`
+			Create issues in the current repository, or in the repository of --repo, from a file
+			written by 'glab issue export'. The format of the file is detected from its extension,
+			unless you use --format.
+
+			Assignees are matched by username, and milestones by title. Assignees and milestones
+			missing from the destination are skipped with a warning. Labels are created if needed.
+			The description of each issue links to the original issue, and records its author.
+			Comments are recreated, along with time estimates, time spent, and links between
+			imported issues. Closed issues are closed after they are created.
+
+			With --recover, the progress of a failed import is saved to a recovery file, and the next
+			run with --recover on the same file resumes the import where it stopped.
+		`),
+		Example: heredoc.Doc(`
+			glab issue import issues.json -R group/new-project --dry-run
+			glab issue import issues.json -R group/new-project --recover
+			glab issue import bugs.csv
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.File = args[0]
+			if opts.Format == "" {
+				opts.Format = export.FormatJSON
+				if strings.EqualFold(filepath.Ext(opts.File), ".csv") {
+					opts.Format = export.FormatCSV
+				}
+			}
+			if opts.Format != export.FormatJSON && opts.Format != export.FormatCSV {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--format must be one of: json, csv.")}
+			}
+
+			in, err := os.Open(opts.File)
+			if err != nil {
+				return err
+			}
+			issues, err := export.Read(in, opts.Format)
+			in.Close()
+			if err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			file, err := filepath.Abs(opts.File)
+			if err != nil {
+				return err
+			}
+			progress := &Progress{File: file, Imported: map[string]*ImportedIssue{}}
+			if opts.Recover {
+				recovered := &Progress{}
+				if err := recovery.FromFile(repo.FullName(), recoveryFile, recovered); err != nil {
+					// if the file to recover doesn't exist, we can just ignore the error and move on
+					if !errors.Is(err, os.ErrNotExist) {
+						fmt.Fprintf(f.IO.StdErr, "Failed to recover from file: %v\n", err)
+					}
+				} else if recovered.File != progress.File {
+					// keep the recovery file, to resume the other import later.
+					if _, err := recovery.CreateFile(repo.FullName(), recoveryFile, recovered); err != nil {
+						fmt.Fprintf(f.IO.StdErr, "Could not create recovery file: %v\n", err)
+					}
+					return fmt.Errorf("the recovery file is for the import of %s, not %s. Resume that import first, or run the command without '--recover'.", recovered.File, progress.File)
+				} else {
+					progress = recovered
+					if progress.Imported == nil {
+						progress.Imported = map[string]*ImportedIssue{}
+					}
+					fmt.Fprintf(f.IO.StdOut, "Recovered the progress of the import: %d issues already imported.\n", progress.done())
+				}
+			}
+
+			imp := &importer{
+				io:         f.IO,
+				client:     apiClient,
+				repo:       repo,
+				progress:   progress,
+				dryRun:     opts.DryRun,
+				users:      map[string]int{},
+				milestones: map[string]int{},
+			}
+			if err := imp.run(issues); err != nil {
+				if opts.Recover && !opts.DryRun {
+					recoverFile, recoverErr := recovery.CreateFile(repo.FullName(), recoveryFile, progress)
+					if recoverErr != nil {
+						fmt.Fprintf(f.IO.StdErr, "Could not create recovery file: %v\n", recoverErr)
+					} else {
+						fmt.Fprintf(f.IO.StdErr, "Failed to import issues. Created recovery file: %s\nRun the command again with the '--recover' option to resume.\n", recoverFile)
+					}
+				}
+				return err
+			}
+			return nil
+		},
+	}
+
+	issueImportCmd.Flags().StringVarP(&opts.Format, "format", "F", "", "Format of the file: json, csv. Default: detected from the file extension.")
+	issueImportCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List the issues to import, without creating them.")
+	issueImportCmd.Flags().BoolVar(&opts.Recover, "recover", false, "Save the progress to a file if the import fails. If the file exists, resume the import from it. (EXPERIMENTAL.)")
+
+	return issueImportCmd
+}
+
+type importer struct {
+	io       *iostreams.IOStreams
+	client   *gitlab.Client
+	repo     glrepo.Interface
+	progress *Progress
+	dryRun   bool
+
+	// users and milestones map names to IDs in the destination. Missing ones map to 0.
+	users      map[string]int
+	milestones map[string]int
+}
+
+func (imp *importer) run(issues []*export.Issue) error {
+	c := imp.io.Color()
+
+	count := 0
+	for _, issue := range issues {
+		if imported, ok := imp.progress.Imported[issueKey(issue)]; ok && imported.Done {
+			continue
+		}
+		count++
+
+		if imp.dryRun {
+			imp.resolve(issue)
+			fmt.Fprintf(imp.io.StdOut, "- Would import %s: %s\n", issueKey(issue), issue.Title)
+			continue
+		}
+
+		iid, err := imp.importIssue(issue)
+		if err != nil {
+			return fmt.Errorf("failed to import issue %s: %w", issueKey(issue), err)
+		}
+		fmt.Fprintf(imp.io.StdOut, "%s Imported %s as #%d: %s\n", c.GreenCheck(), issueKey(issue), iid, issue.Title)
+	}
+
+	if imp.dryRun {
+		fmt.Fprintf(imp.io.StdOut, "Would import %d issues into %s.\n", count, imp.repo.FullName())
+		return nil
+	}
+	fmt.Fprintf(imp.io.StdOut, "Imported %d issues into %s.\n", count, imp.repo.FullName())
+	return nil
+}
+
+// resolve returns the IDs of the assignees and the milestone of an issue in the destination.
+func (imp *importer) resolve(issue *export.Issue) ([]int, int) {
+	var assigneeIDs []int
+	for _, username := range issue.Assignees {
+		id, ok := imp.users[username]
+		if !ok {
+			user, err := api.UserByName(imp.client, username)
+			if err != nil {
+				fmt.Fprintf(imp.io.StdErr, "%s User %q not found. Skipping this assignee.\n", imp.io.Color().WarnIcon(), username)
+			} else {
+				id = user.ID
+			}
+			imp.users[username] = id
+		}
+		if id != 0 {
+			assigneeIDs = append(assigneeIDs, id)
+		}
+	}
+
+	if issue.Milestone == "" {
+		return assigneeIDs, 0
+	}
+	milestoneID, ok := imp.milestones[issue.Milestone]
+	if !ok {
+		m, err := api.ProjectMilestoneByTitle(imp.client, imp.repo.FullName(), issue.Milestone)
+		if err != nil {
+			fmt.Fprintf(imp.io.StdErr, "%s Milestone %q not found in %s. Skipping this milestone.\n", imp.io.Color().WarnIcon(), issue.Milestone, imp.repo.FullName())
+		} else {
+			milestoneID = m.ID
+		}
+		imp.milestones[issue.Milestone] = milestoneID
+	}
+	return assigneeIDs, milestoneID
+}
+
+// importIssue creates an issue, and then its comments, time stats and links, recording each step
+// in the progress of the import. An issue partly imported by a previous run is resumed at the first
+// step not done.
+func (imp *importer) importIssue(issue *export.Issue) (int, error) {
+	project := imp.repo.FullName()
+
+	imported, ok := imp.progress.Imported[issueKey(issue)]
+	if !ok {
+		iid, err := imp.createIssue(issue)
+		if err != nil {
+			return 0, err
+		}
+		imported = &ImportedIssue{IID: iid}
+		imp.progress.Imported[issueKey(issue)] = imported
+	}
+
+	for ; imported.Notes < len(issue.Notes); imported.Notes++ {
+		note := issue.Notes[imported.Notes]
+		if note.System {
+			continue
+		}
+		_, err := api.CreateIssueNote(imp.client, project, imported.IID, &gitlab.CreateIssueNoteOptions{
+			Body: gitlab.Ptr(importedNote(note)),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if issue.TimeEstimate > 0 && !imported.TimeEstimate {
+		_, err := api.SetIssueTimeEstimate(imp.client, project, imported.IID, &gitlab.SetTimeEstimateOptions{
+			Duration: gitlab.Ptr(utils.FmtTimeTracking(issue.TimeEstimate)),
+		})
+		if err != nil {
+			return 0, err
+		}
+		imported.TimeEstimate = true
+	}
+	if issue.TimeSpent > 0 && !imported.TimeSpent {
+		_, err := api.AddIssueTimeSpent(imp.client, project, imported.IID, &gitlab.AddSpentTimeOptions{
+			Duration: gitlab.Ptr(utils.FmtTimeTracking(issue.TimeSpent)),
+			Summary:  gitlab.Ptr("Imported time spent."),
+		})
+		if err != nil {
+			return 0, err
+		}
+		imported.TimeSpent = true
+	}
+
+	// links are created from the second issue of each link, once both issues are imported.
+	for ; imported.Links < len(issue.Links); imported.Links++ {
+		link := issue.Links[imported.Links]
+		target, ok := imp.progress.Imported[link.Reference]
+		if !ok || link.Reference == issueKey(issue) {
+			continue
+		}
+		_, _, err := api.LinkIssues(imp.client, project, imported.IID, &gitlab.CreateIssueLinkOptions{
+			TargetProjectID: gitlab.Ptr(project),
+			TargetIssueIID:  gitlab.Ptr(strconv.Itoa(target.IID)),
+			LinkType:        gitlab.Ptr(link.LinkType),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if issue.State == "closed" {
+		_, err := api.UpdateIssue(imp.client, project, imported.IID, &gitlab.UpdateIssueOptions{
+			StateEvent: gitlab.Ptr("close"),
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	imported.Done = true
+	return imported.IID, nil
+}
+
+func (imp *importer) createIssue(issue *export.Issue) (int, error) {
+	assigneeIDs, milestoneID := imp.resolve(issue)
+
+	opts := &gitlab.CreateIssueOptions{
+		Title:        gitlab.Ptr(issue.Title),
+		Description:  gitlab.Ptr(importedDescription(issue)),
+		Confidential: gitlab.Ptr(issue.Confidential),
+		Labels:       (*gitlab.LabelOptions)(&issue.Labels),
+	}
+	if len(assigneeIDs) != 0 {
+		opts.AssigneeIDs = &assigneeIDs
+	}
+	if milestoneID != 0 {
+		opts.MilestoneID = gitlab.Ptr(milestoneID)
+	}
+	if issue.IssueType != "" {
+		opts.IssueType = gitlab.Ptr(issue.IssueType)
+	}
+	if issue.Weight != 0 {
+		opts.Weight = gitlab.Ptr(issue.Weight)
+	}
+	if issue.DueDate != "" {
+		dueDate, err := gitlab.ParseISOTime(issue.DueDate)
+		if err == nil {
+			opts.DueDate = &dueDate
+		}
+	}
+
+	created, err := api.CreateIssue(imp.client, imp.repo.FullName(), opts)
+	if err != nil {
+		return 0, err
+	}
+	return created.IID, nil
+}
+
+// issueKey identifies an exported issue, and the issues it links to.
+func issueKey(issue *export.Issue) string {
+	if issue.Reference != "" {
+		return issue.Reference
+	}
+	return issue.WebURL
+}
+
+// importedDescription appends the origin of an imported issue to its description.
+// Usernames are not mentioned with '@', so the import doesn't notify anyone.
+func importedDescription(issue *export.Issue) string {
+	origin := "_Imported from " + issueKey(issue)
+	if issue.WebURL != "" {
+		origin = fmt.Sprintf("_Imported from [%s](%s)", issueKey(issue), issue.WebURL)
+	}
+	if issue.Author != "" {
+		origin += ". Created by " + issue.Author
+	}
+	if issue.CreatedAt != nil {
+		origin += " on " + issue.CreatedAt.UTC().Format("2006-01-02")
+	}
+	origin += "._"
+
+	if issue.Description == "" {
+		return origin
+	}
+	return issue.Description + "\n\n---\n\n" + origin
+}
+
+func importedNote(note export.Note) string {
+	header := "_Comment by " + note.Author
+	if note.CreatedAt != nil {
+		header += " on " + note.CreatedAt.UTC().Format("2006-01-02")
+	}
+	return header + ":_\n\n" + note.Body
+}
@@ -0,0 +1,196 @@
+package issueimport
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/recovery"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const exportedIssues = `[
+	{
+		"reference": "OLD/REPO#12", "project_id": 1, "iid": 12, "title": "Crash on start", "description": "It crashes.",
+		"state": "closed", "author": "alice", "assignees": ["bob"], "labels": ["bug"], "milestone": "1.0",
+		"created_at": "2024-01-02T10:00:00Z", "web_url": "https://gitlab.com/OLD/REPO/-/issues/12",
+		"time_estimate": 7200, "time_spent": 0,
+		"notes": [
+			{"author": "bob", "body": "Confirmed.", "system": false, "created_at": "2024-01-03T10:00:00Z"},
+			{"author": "bob", "body": "changed the description", "system": true, "created_at": "2024-01-03T11:00:00Z"}
+		],
+		"links": [{"link_type": "blocks", "reference": "OLD/REPO#13", "project_id": 1, "iid": 13}]
+	},
+	{
+		"reference": "OLD/REPO#13", "project_id": 1, "iid": 13, "title": "Release", "state": "opened",
+		"author": "carol", "assignees": ["carol"], "labels": [],
+		"links": [{"link_type": "is_blocked_by", "reference": "OLD/REPO#12", "project_id": 1, "iid": 12}]
+	}
+]`
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdImport(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func writeExport(t *testing.T) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "issues.json")
+	require.NoError(t, os.WriteFile(file, []byte(exportedIssues), 0o600))
+	return file
+}
+
+const (
+	createBody12 = `{
+		"title": "Crash on start",
+		"description": "It crashes.\n\n---\n\n_Imported from [OLD/REPO#12](https://gitlab.com/OLD/REPO/-/issues/12). Created by alice on 2024-01-02._",
+		"confidential": false,
+		"assignee_ids": [7],
+		"milestone_id": 4,
+		"labels": "bug"
+	}`
+	createBody13 = `{
+		"title": "Release",
+		"description": "_Imported from OLD/REPO#13. Created by carol._",
+		"confidential": false,
+		"labels": ""
+	}`
+)
+
+func TestIssueImport(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=bob",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "username": "bob"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=carol",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.MatchURL = httpmock.PathOnly
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "title": "1.0"}]`))
+
+	// stubs that match the body read it, even for other paths, so they are registered in the order of the requests.
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues", createBody12,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 201, "iid": 1}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/1/notes",
+		`{"body": "_Comment by bob on 2024-01-03:_\n\nConfirmed."}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 1}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/1/time_estimate",
+		`{"duration": "2h"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"time_estimate": 7200}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPut, "/api/v4/projects/OWNER/REPO/issues/1",
+		`{"state_event": "close"}`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 201, "iid": 1, "state": "closed"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues", createBody13,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 202, "iid": 2}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/2/links",
+		`{"target_project_id": "OWNER/REPO", "target_issue_iid": "1", "link_type": "is_blocked_by"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"source_issue": {"id": 202, "iid": 2}, "target_issue": {"id": 201, "iid": 1}}`))
+
+	output, err := runCommand(t, fakeHTTP, writeExport(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Imported OLD/REPO#12 as #1: Crash on start\n✓ Imported OLD/REPO#13 as #2: Release\nImported 2 issues into OWNER/REPO.\n", output.String())
+	assert.Equal(t, "! User \"carol\" not found. Skipping this assignee.\n", output.Stderr())
+}
+
+func TestIssueImportDryRun(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=bob",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "username": "bob"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=carol",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.MatchURL = httpmock.PathOnly
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "title": "1.0"}]`))
+
+	output, err := runCommand(t, fakeHTTP, writeExport(t)+" --dry-run")
+	require.NoError(t, err)
+
+	assert.Equal(t, "- Would import OLD/REPO#12: Crash on start\n- Would import OLD/REPO#13: Release\nWould import 2 issues into OWNER/REPO.\n", output.String())
+}
+
+func TestIssueImportRecover(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	file := writeExport(t)
+
+	// the import fails after #12 is created, when its comment is added.
+	fakeHTTP := httpmock.New()
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=bob",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "username": "bob"}]`))
+	fakeHTTP.MatchURL = httpmock.PathOnly
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "title": "1.0"}]`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues", createBody12,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 201, "iid": 1}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/1/notes",
+		httpmock.NewStringResponse(http.StatusInternalServerError, `{"message": "500 Internal Server Error"}`))
+
+	output, err := runCommand(t, fakeHTTP, file+" --recover")
+	require.Error(t, err)
+	fakeHTTP.Verify(t)
+
+	assert.Empty(t, output.String())
+	assert.Contains(t, output.Stderr(), "Failed to import issues. Created recovery file: ")
+
+	// the next run doesn't create #12 again, and resumes with its comment.
+	fakeHTTP = httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users?per_page=30&username=carol",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.MatchURL = httpmock.PathOnly
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/1/notes",
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 1}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/1/time_estimate",
+		httpmock.NewStringResponse(http.StatusOK, `{"time_estimate": 7200}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/issues/1",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 201, "iid": 1, "state": "closed"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues", createBody13,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": 202, "iid": 2}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/2/links",
+		httpmock.NewStringResponse(http.StatusCreated, `{"source_issue": {"id": 202, "iid": 2}, "target_issue": {"id": 201, "iid": 1}}`))
+
+	output, err = runCommand(t, fakeHTTP, file+" --recover")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Recovered the progress of the import: 0 issues already imported.\n✓ Imported OLD/REPO#12 as #1: Crash on start\n✓ Imported OLD/REPO#13 as #2: Release\nImported 2 issues into OWNER/REPO.\n", output.String())
+}
+
+func TestIssueImportRecoverOtherFile(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+	file := writeExport(t)
+
+	_, err := recovery.CreateFile("OWNER/REPO", recoveryFile, &Progress{File: "/tmp/other.json"})
+	require.NoError(t, err)
+
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err = runCommand(t, fakeHTTP, file+" --recover")
+	require.EqualError(t, err, "the recovery file is for the import of /tmp/other.json, not "+file+". Resume that import first, or run the command without '--recover'.")
+
+	// the recovery file of the other import is kept.
+	progress := &Progress{}
+	require.NoError(t, recovery.FromFile("OWNER/REPO", recoveryFile, progress))
+	assert.Equal(t, "/tmp/other.json", progress.File)
+}
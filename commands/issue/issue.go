@@ -7,10 +7,15 @@ import (
 	issueCloseCmd "gitlab.com/gitlab-org/cli/commands/issue/close"
 	issueCreateCmd "gitlab.com/gitlab-org/cli/commands/issue/create"
 	issueDeleteCmd "gitlab.com/gitlab-org/cli/commands/issue/delete"
+	issueExportCmd "gitlab.com/gitlab-org/cli/commands/issue/export"
+	issueGraphCmd "gitlab.com/gitlab-org/cli/commands/issue/graph"
+	issueImportCmd "gitlab.com/gitlab-org/cli/commands/issue/import"
+	issueLinksCmd "gitlab.com/gitlab-org/cli/commands/issue/links"
 	issueListCmd "gitlab.com/gitlab-org/cli/commands/issue/list"
 	issueNoteCmd "gitlab.com/gitlab-org/cli/commands/issue/note"
 	issueReopenCmd "gitlab.com/gitlab-org/cli/commands/issue/reopen"
 	issueSubscribeCmd "gitlab.com/gitlab-org/cli/commands/issue/subscribe"
+	issueTimeCmd "gitlab.com/gitlab-org/cli/commands/issue/time"
 	issueUnsubscribeCmd "gitlab.com/gitlab-org/cli/commands/issue/unsubscribe"
 	issueUpdateCmd "gitlab.com/gitlab-org/cli/commands/issue/update"
 	issueViewCmd "gitlab.com/gitlab-org/cli/commands/issue/view"
@@ -51,5 +56,10 @@ func NewCmdIssue(f *cmdutils.Factory) *cobra.Command {
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
+	issueCmd.AddCommand(issueTimeCmd.NewCmdTime(f))
+	issueCmd.AddCommand(issueLinksCmd.NewCmdLinks(f))
+	issueCmd.AddCommand(issueGraphCmd.NewCmdGraph(f))
+	issueCmd.AddCommand(issueExportCmd.NewCmdExport(f))
+	issueCmd.AddCommand(issueImportCmd.NewCmdImport(f))
 	return issueCmd
 }
@@ -67,6 +67,15 @@ func IssueState(c *iostreams.ColorPalette, i *gitlab.Issue) (issueID string) {
 	return
 }
 
+// IssueReference returns the full reference of an issue, like group/project#1,
+// or #1 when the API did not return it.
+func IssueReference(issue *gitlab.Issue) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
+
 func IssuesFromArgs(apiClient *gitlab.Client, baseRepoFn func() (glrepo.Interface, error), args []string) ([]*gitlab.Issue, glrepo.Interface, error) {
 	var baseRepo glrepo.Interface
 
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 )
 
@@ -100,3 +102,11 @@ func Test_issueMetadataFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueReference(t *testing.T) {
+	require.Equal(t, "#1", IssueReference(&gitlab.Issue{IID: 1}))
+	require.Equal(t, "group/project#1", IssueReference(&gitlab.Issue{
+		IID:        1,
+		References: &gitlab.IssueReferences{Full: "group/project#1"},
+	}))
+}
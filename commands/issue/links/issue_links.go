@@ -0,0 +1,258 @@
+package links
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+)
+
+// linkTypes are the types of issue links, in the order they're listed, with their description.
+var linkTypes = []struct {
+	name        string
+	description string
+}{
+	{"is_blocked_by", "Is blocked by"},
+	{"blocks", "Blocks"},
+	{"relates_to", "Relates to"},
+}
+
+func NewCmdLinks(f *cmdutils.Factory) *cobra.Command {
+	issueLinksCmd := &cobra.Command{
+		Use:   "links <command> [flags]",
+		Short: `List, add, and remove the links between issues.`,
+		Long: heredoc.Doc(`
+			List, add, and remove the links between issues. Issues can relate to, block,
+			or be blocked by other issues, of any project. Blocking links require GitLab
+			Premium or Ultimate.
+		`),
+		Example: heredoc.Doc(`
+			glab issue links list 12
+			glab issue links add 12 34 --link-type is_blocked_by
+			glab issue links remove 12 34
+		`),
+	}
+
+	issueLinksCmd.AddCommand(newCmdList(f))
+	issueLinksCmd.AddCommand(newCmdAdd(f))
+	issueLinksCmd.AddCommand(newCmdRemove(f))
+
+	return issueLinksCmd
+}
+
+func newCmdList(f *cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	issueLinksListCmd := &cobra.Command{
+		Use:     "list <id> [flags]",
+		Short:   `List the issues linked to an issue.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab issue links list 12
+			glab issue links list https://gitlab.com/NAMESPACE/REPO/-/issues/12 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			relations, err := api.ListIssueLinks(apiClient, repo.FullName(), issue.IID)
+			if err != nil {
+				return err
+			}
+
+			if outputFormat == "json" {
+				relationsJSON, _ := json.Marshal(relations)
+				fmt.Fprintln(f.IO.StdOut, string(relationsJSON))
+				return nil
+			}
+
+			if len(relations) == 0 {
+				fmt.Fprintf(f.IO.StdErr, "No issues are linked to issue #%d.\n", issue.IID)
+				return nil
+			}
+			fmt.Fprint(f.IO.StdOut, displayLinks(f.IO, relations))
+			return nil
+		},
+	}
+
+	issueLinksListCmd.Flags().StringVarP(&outputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return issueLinksListCmd
+}
+
+func newCmdAdd(f *cmdutils.Factory) *cobra.Command {
+	var linkType string
+
+	issueLinksAddCmd := &cobra.Command{
+		Use:   "add <id> <issue> [<issue>...] [flags]",
+		Short: `Link issues to an issue.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab issue links add 12 34 35
+			glab issue links add 12 34 --link-type blocks
+			glab issue links add 12 https://gitlab.com/NAMESPACE/OTHER-REPO/-/issues/3 --link-type is_blocked_by
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if linkTypeDescription(linkType) == "" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--link-type must be one of: relates_to, blocks, is_blocked_by.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			targets, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, target := range targets {
+				_, _, err := api.LinkIssues(apiClient, repo.FullName(), issue.IID, &gitlab.CreateIssueLinkOptions{
+					TargetProjectID: gitlab.Ptr(strconv.Itoa(target.ProjectID)),
+					TargetIssueIID:  gitlab.Ptr(strconv.Itoa(target.IID)),
+					LinkType:        gitlab.Ptr(linkType),
+				})
+				if err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to link issue %s to issue #%d.", issueutils.IssueReference(target), issue.IID))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Issue #%d %s %s\n", c.GreenCheck(), issue.IID,
+					linkTypeVerb(linkType), issueutils.IssueReference(target))
+			}
+			return nil
+		},
+	}
+
+	issueLinksAddCmd.Flags().StringVarP(&linkType, "link-type", "t", "relates_to", "Type of the links: relates_to, blocks, is_blocked_by.")
+
+	return issueLinksAddCmd
+}
+
+func newCmdRemove(f *cmdutils.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove <id> <issue> [<issue>...] [flags]",
+		Short:   `Remove the links between an issue and other issues.`,
+		Long:    ``,
+		Aliases: []string{"rm"},
+		Example: heredoc.Doc(`
+			glab issue links remove 12 34
+			glab issue links remove 12 34,35
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, args[0])
+			if err != nil {
+				return err
+			}
+
+			targets, _, err := issueutils.IssuesFromArgs(apiClient, f.BaseRepo, args[1:])
+			if err != nil {
+				return err
+			}
+
+			// removing a link takes its ID, which only the list of links has.
+			relations, err := api.ListIssueLinks(apiClient, repo.FullName(), issue.IID)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			for _, target := range targets {
+				var link *gitlab.IssueRelation
+				for _, relation := range relations {
+					if relation.ProjectID == target.ProjectID && relation.IID == target.IID {
+						link = relation
+						break
+					}
+				}
+				if link == nil {
+					return fmt.Errorf("issue %s is not linked to issue #%d.", issueutils.IssueReference(target), issue.IID)
+				}
+
+				if err := api.UnlinkIssues(apiClient, repo.FullName(), issue.IID, link.IssueLinkID); err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to remove the link to issue %s.", issueutils.IssueReference(target)))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Removed the link between issue #%d and %s\n", c.RedCheck(), issue.IID, issueutils.IssueReference(target))
+			}
+			return nil
+		},
+	}
+}
+
+func displayLinks(io *iostreams.IOStreams, relations []*gitlab.IssueRelation) string {
+	c := io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.SetIsTTY(io.IsOutputTTY())
+	for _, linkType := range linkTypes {
+		for _, relation := range relations {
+			if relation.LinkType != linkType.name {
+				continue
+			}
+			reference := fmt.Sprintf("#%d", relation.IID)
+			if relation.References != nil && relation.References.Relative != "" {
+				reference = relation.References.Relative
+			}
+			if relation.State == "opened" {
+				reference = c.Green(reference)
+			} else {
+				reference = c.Red(reference)
+			}
+
+			table.AddCell(c.Gray(linkType.description))
+			table.AddCell(io.Hyperlink(reference, relation.WebURL))
+			table.AddCell(relation.Title)
+			table.AddCell(c.Gray(relation.State))
+			table.EndRow()
+		}
+	}
+	return table.Render()
+}
+
+func linkTypeDescription(name string) string {
+	for _, linkType := range linkTypes {
+		if linkType.name == name {
+			return linkType.description
+		}
+	}
+	return ""
+}
+
+func linkTypeVerb(name string) string {
+	switch name {
+	case "blocks":
+		return "now blocks"
+	case "is_blocked_by":
+		return "is now blocked by"
+	}
+	return "now relates to"
+}
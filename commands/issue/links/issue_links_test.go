@@ -0,0 +1,112 @@
+package links
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdLinks(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueLinksList(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 121, "iid": 21, "project_id": 1, "title": "Docs", "state": "opened", "link_type": "relates_to",
+				"references": {"relative": "#21"}},
+			{"id": 213, "iid": 3, "project_id": 2, "title": "Design", "state": "closed", "link_type": "is_blocked_by",
+				"references": {"relative": "OWNER/DESIGN#3"}}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "list 12")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Is blocked by\tOWNER/DESIGN#3\tDesign\tclosed\nRelates to\t#21\tDocs\topened\n", output.String())
+}
+
+func TestIssueLinksAdd(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/13",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened",
+			"references": {"full": "OWNER/REPO#13"}}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/12/links",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			assert.JSONEq(t, `{"target_project_id": "1", "target_issue_iid": "13", "link_type": "is_blocked_by"}`, string(body))
+			return httpmock.NewStringResponse(http.StatusCreated, `{"source_issue": {"id": 112, "iid": 12}, "target_issue": {"id": 113, "iid": 13}}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, "add 12 13 --link-type is_blocked_by")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Issue #12 is now blocked by OWNER/REPO#13\n", output.String())
+}
+
+func TestIssueLinksAddInvalidType(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "add 12 13 --link-type duplicates")
+	require.EqualError(t, err, "--link-type must be one of: relates_to, blocks, is_blocked_by.")
+}
+
+func TestIssueLinksRemove(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/13",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened",
+			"references": {"full": "OWNER/REPO#13"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 113, "iid": 13, "project_id": 1, "link_type": "blocks", "issue_link_id": 77}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, "/api/v4/projects/OWNER/REPO/issues/12/links/77",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 77}`))
+
+	output, err := runCommand(t, fakeHTTP, "remove 12 13")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Removed the link between issue #12 and OWNER/REPO#13\n", output.String())
+}
+
+func TestIssueLinksRemoveNotLinked(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "project_id": 1, "title": "Deliverable", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/13",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 113, "iid": 13, "project_id": 1, "title": "Backend", "state": "opened",
+			"references": {"full": "OWNER/REPO#13"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12/links",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	_, err := runCommand(t, fakeHTTP, "remove 12 13")
+	require.EqualError(t, err, "issue OWNER/REPO#13 is not linked to issue #12.")
+}
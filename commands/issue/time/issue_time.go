@@ -0,0 +1,33 @@
+package time
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	timeEstimateCmd "gitlab.com/gitlab-org/cli/commands/timetracking/estimate"
+	timeReportCmd "gitlab.com/gitlab-org/cli/commands/timetracking/report"
+	timeResetCmd "gitlab.com/gitlab-org/cli/commands/timetracking/reset"
+	timeSpendCmd "gitlab.com/gitlab-org/cli/commands/timetracking/spend"
+)
+
+func NewCmdTime(f *cmdutils.Factory) *cobra.Command {
+	issueTimeCmd := &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: `Track the time estimate and the time spent of an issue.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab issue time estimate 12 2d
+			glab issue time spend 12 1h 30m --summary "Review"
+			glab issue time report --by user --output csv
+		`),
+	}
+
+	issueTimeCmd.AddCommand(timeEstimateCmd.NewCmdEstimate(f, timetracking.KindIssue))
+	issueTimeCmd.AddCommand(timeSpendCmd.NewCmdSpend(f, timetracking.KindIssue))
+	issueTimeCmd.AddCommand(timeResetCmd.NewCmdReset(f, timetracking.KindIssue))
+	issueTimeCmd.AddCommand(timeReportCmd.NewCmdReport(f, timetracking.KindIssue))
+
+	return issueTimeCmd
+}
@@ -15,15 +15,33 @@ import (
 )
 
 func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	bulkOpts := &cmdutils.BulkOptions{}
+
 	issueUpdateCmd := &cobra.Command{
-		Use:   "update <id>",
+		Use:   "update [<id> | -]",
 		Short: `Update issue`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+		Update an issue, or several issues at once.
+
+		To update several issues, select them with '--filter', which takes the flags of
+		'glab issue list', or pass '-' as the ID to read IDs from the standard input.
+		Only labels, milestone, assignees, state, confidentiality, and discussion locking
+		can be changed for several issues. The issues and the changes are shown before
+		they are applied, and a JSON report of the updated and failed issues is printed
+		when the update ends.
+		`),
 		Example: heredoc.Doc(`
 	glab issue update 42 --label ui,ux
 	glab issue update 42 --unlabel working
+	glab issue update --filter '--milestone 1.0 --label bug' --milestone 1.1
+	glab issue list --label stale --output-format ids | glab issue update - --close --yes
 	`),
-		Args: cobra.ExactArgs(1),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if bulkOpts.Filter != "" {
+				return cobra.MaximumNArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var err error
 			var actions []string
@@ -57,6 +75,13 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 			if cmd.Flags().Changed("confidential") && cmd.Flags().Changed("public") {
 				return &cmdutils.FlagError{Err: errors.New("--public and --confidential can't be used together.")}
 			}
+			if cmd.Flags().Changed("close") && cmd.Flags().Changed("reopen") {
+				return &cmdutils.FlagError{Err: errors.New("--close and --reopen can't be used together.")}
+			}
+
+			if bulkOpts.IsBulk(args) {
+				return bulkUpdateRun(f, cmd, bulkOpts, ua, args)
+			}
 
 			apiClient, err := f.HttpClient()
 			if err != nil {
@@ -141,6 +166,14 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 					l.MilestoneID = gitlab.Ptr(0)
 				}
 			}
+			if m, _ := cmd.Flags().GetBool("close"); m {
+				actions = append(actions, "closed")
+				l.StateEvent = gitlab.Ptr("close")
+			}
+			if m, _ := cmd.Flags().GetBool("reopen"); m {
+				actions = append(actions, "reopened")
+				l.StateEvent = gitlab.Ptr("reopen")
+			}
 			if cmd.Flags().Changed("unassign") {
 				l.AssigneeIDs = &[]int{0} // 0 or an empty int[] is the documented way to unassign
 				actions = append(actions, "unassigned all users")
@@ -191,6 +224,9 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 	issueUpdateCmd.Flags().
 		StringSliceP("assignee", "a", []string{}, "Assign users by username. Prefix with '!' or '-' to remove from existing assignees, or '+' to add new. Otherwise, replace existing assignees with these users.")
 	issueUpdateCmd.Flags().Bool("unassign", false, "Unassign all users.")
+	issueUpdateCmd.Flags().Bool("close", false, "Close the issue.")
+	issueUpdateCmd.Flags().Bool("reopen", false, "Reopen the issue.")
+	cmdutils.AddBulkFlags(issueUpdateCmd, bulkOpts, "issues")
 
 	return issueUpdateCmd
 }
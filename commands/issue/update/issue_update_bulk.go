@@ -0,0 +1,180 @@
+package update
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issuable"
+	issuableListCmd "gitlab.com/gitlab-org/cli/commands/issuable/list"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+)
+
+// singleIssueFlags are the flags that can't be used to update several issues at once.
+var singleIssueFlags = []string{"title", "description"}
+
+// bulkUpdateRun updates all issues matched by --filter, or whose IDs are read from standard input.
+func bulkUpdateRun(f *cmdutils.Factory, cmd *cobra.Command, opts *cmdutils.BulkOptions, ua *cmdutils.UserAssignments, args []string) error {
+	for _, name := range singleIssueFlags {
+		if cmd.Flags().Changed(name) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--%s can't be used to update several issues.", name)}
+		}
+	}
+	if err := opts.Validate(f.IO, args); err != nil {
+		return err
+	}
+
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var changes []string
+	l := gitlab.UpdateIssueOptions{}
+
+	if m, _ := cmd.Flags().GetBool("lock-discussion"); m {
+		changes = append(changes, "lock discussion")
+		l.DiscussionLocked = gitlab.Ptr(true)
+	}
+	if m, _ := cmd.Flags().GetBool("unlock-discussion"); m {
+		changes = append(changes, "unlock discussion")
+		l.DiscussionLocked = gitlab.Ptr(false)
+	}
+	if m, _ := cmd.Flags().GetStringSlice("label"); len(m) != 0 {
+		changes = append(changes, fmt.Sprintf("add labels %s", strings.Join(m, " ")))
+		l.AddLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetStringSlice("unlabel"); len(m) != 0 {
+		changes = append(changes, fmt.Sprintf("remove labels %s", strings.Join(m, " ")))
+		l.RemoveLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetBool("public"); m {
+		changes = append(changes, "make public")
+		l.Confidential = gitlab.Ptr(false)
+	}
+	if m, _ := cmd.Flags().GetBool("confidential"); m {
+		changes = append(changes, "make confidential")
+		l.Confidential = gitlab.Ptr(true)
+	}
+	if m, _ := cmd.Flags().GetBool("close"); m {
+		changes = append(changes, "close")
+		l.StateEvent = gitlab.Ptr("close")
+	}
+	if m, _ := cmd.Flags().GetBool("reopen"); m {
+		changes = append(changes, "reopen")
+		l.StateEvent = gitlab.Ptr("reopen")
+	}
+
+	milestone, _ := cmd.Flags().GetString("milestone")
+	hasMilestone := cmd.Flags().Changed("milestone") && milestone != "" && milestone != "0"
+	if cmd.Flags().Changed("milestone") {
+		if hasMilestone {
+			changes = append(changes, fmt.Sprintf("set milestone %q", milestone))
+		} else {
+			changes = append(changes, "unassign milestone")
+			l.MilestoneID = gitlab.Ptr(0)
+		}
+	}
+
+	if cmd.Flags().Changed("unassign") {
+		changes = append(changes, "unassign all users")
+		l.AssigneeIDs = &[]int{0}
+	}
+	var addedAssignees []*gitlab.User
+	if ua != nil {
+		if len(ua.ToReplace) != 0 {
+			l.AssigneeIDs, _, err = ua.UsersFromReplaces(apiClient, nil)
+			if err != nil {
+				return err
+			}
+		} else if len(ua.ToAdd) != 0 {
+			addedAssignees, err = api.UsersByNames(apiClient, ua.ToAdd)
+			if err != nil {
+				return err
+			}
+		}
+		changes = append(changes, ua.BulkChanges()...)
+	}
+
+	if len(changes) == 0 {
+		return &cmdutils.FlagError{Err: fmt.Errorf("no changes to apply. Use flags like '--label' or '--milestone'.")}
+	}
+
+	issues, failed, err := bulkIssues(f, apiClient, opts)
+	if err != nil {
+		return err
+	}
+
+	// milestones are looked up by title in the project of each issue.
+	milestones := map[int]int{}
+	if hasMilestone {
+		for _, issue := range issues {
+			if _, ok := milestones[issue.ProjectID]; ok {
+				continue
+			}
+			milestones[issue.ProjectID], err = cmdutils.BulkMilestoneID(apiClient, issue.ProjectID, milestone)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	items := make([]cmdutils.BulkItem, 0, len(issues))
+	for _, issue := range issues {
+		issueOpts := l
+		if hasMilestone {
+			issueOpts.MilestoneID = gitlab.Ptr(milestones[issue.ProjectID])
+		}
+		if ua != nil && len(ua.ToReplace) == 0 {
+			issueOpts.AssigneeIDs = ua.BulkUserIDs(issueAssignees(issue), addedAssignees)
+		}
+
+		items = append(items, cmdutils.BulkItem{
+			Reference: issueutils.IssueReference(issue),
+			Title:     issue.Title,
+			WebURL:    issue.WebURL,
+			Update: func() error {
+				_, err := api.UpdateIssue(apiClient, issue.ProjectID, issue.IID, &issueOpts)
+				return err
+			},
+		})
+	}
+
+	items = append(items, failed...)
+
+	return cmdutils.RunBulk(f.IO, opts, "issues", items, changes)
+}
+
+// bulkIssues returns all the issues listed by 'issue list' with the flags of --filter, or
+// the issues whose IDs are read from standard input. IDs that can't be fetched are
+// returned as failed items.
+func bulkIssues(f *cmdutils.Factory, apiClient *gitlab.Client, opts *cmdutils.BulkOptions) ([]*gitlab.Issue, []cmdutils.BulkItem, error) {
+	if opts.Filter == "" {
+		ids, err := cmdutils.ReadBulkArgs(f.IO.In)
+		if err != nil {
+			return nil, nil, err
+		}
+		issues, failed := cmdutils.FetchBulkItems(opts, ids, func(id string) (*gitlab.Issue, error) {
+			issue, _, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, id)
+			return issue, err
+		})
+		return issues, failed, nil
+	}
+
+	issues, err := cmdutils.ListMatches[*gitlab.Issue](f, func(f *cmdutils.Factory) *cobra.Command {
+		return issuableListCmd.NewCmdList(f, nil, issuable.TypeIssue)
+	}, opts.Filter, "issues")
+	return issues, nil, err
+}
+
+func issueAssignees(issue *gitlab.Issue) []*gitlab.BasicUser {
+	users := make([]*gitlab.BasicUser, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		users = append(users, &gitlab.BasicUser{ID: assignee.ID, Username: assignee.Username})
+	}
+	return users
+}
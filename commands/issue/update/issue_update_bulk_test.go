@@ -0,0 +1,103 @@
+package update
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, stdin string, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, in, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	in.WriteString(stdin)
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdUpdate(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueUpdateBulkStdin(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "iid": 12, "project_id": 3, "title": "First", "references": {"full": "OWNER/REPO#12"},
+			"assignees": [{"id": 1, "username": "alice"}]}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/13",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 103, "iid": 13, "project_id": 3, "title": "Second", "references": {"full": "OWNER/REPO#13"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 2, "username": "jdoe"}]`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "iid": 12}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/issues/13",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 103, "iid": 13}`))
+
+	output, err := runCommand(t, fakeHTTP, "12\n13\n", "- --assignee +jdoe --confidential --yes")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.Stderr(), "- make confidential\n- assign @jdoe")
+
+	var report cmdutils.BulkReport
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, 2, report.Matched)
+	assert.Len(t, report.Updated, 2)
+	assert.Empty(t, report.Failed)
+}
+
+func TestIssueUpdateBulkDryRun(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 102, "iid": 12, "project_id": 3, "title": "First", "references": {"full": "OWNER/REPO#12"}}]`))
+
+	output, err := runCommand(t, fakeHTTP, "", `--filter "--label stale" --close --dry-run`)
+	require.NoError(t, err)
+
+	assert.Contains(t, output.Stderr(), "OWNER/REPO#12\tFirst")
+	assert.Contains(t, output.Stderr(), "Dry run: nothing was updated.")
+
+	var report cmdutils.BulkReport
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Matched)
+	assert.Contains(t, fakeHTTP.Requests[0].URL.RawQuery, "page=1&per_page=100")
+}
+
+func TestIssueUpdateBulkStdinNotFound(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "iid": 12, "project_id": 3, "title": "First", "references": {"full": "OWNER/REPO#12"}}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/99",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not found"}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "iid": 12}`))
+
+	output, err := runCommand(t, fakeHTTP, "12 99 first", "- --close --concurrency 1 --yes")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	var report cmdutils.BulkReport
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, 1, report.Matched)
+	require.Len(t, report.Updated, 1)
+	assert.Equal(t, "OWNER/REPO#12", report.Updated[0].Reference)
+	require.Len(t, report.Failed, 2)
+	assert.Equal(t, "99", report.Failed[0].Reference)
+	assert.Contains(t, report.Failed[0].Error, "404")
+	assert.Equal(t, "first", report.Failed[1].Reference)
+}
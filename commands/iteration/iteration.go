@@ -0,0 +1,39 @@
+package iteration
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	iterationListCmd "gitlab.com/gitlab-org/cli/commands/iteration/list"
+	iterationViewCmd "gitlab.com/gitlab-org/cli/commands/iteration/view"
+)
+
+func NewCmdIteration(f *cmdutils.Factory) *cobra.Command {
+	iterationCmd := &cobra.Command{
+		Use:   "iteration <command> [flags]",
+		Short: `Work with GitLab iterations.`,
+		Long: heredoc.Doc(`
+			Work with the iterations of a group. Iterations belong to the group of the current
+			repository, unless you use the --group flag. Requires GitLab Premium or Ultimate.
+		`),
+		Example: heredoc.Doc(`
+			glab iteration list --state current
+			glab iteration view 1234
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				An iteration can be supplied as argument by its ID, by the number in its
+				URL, or by its title.
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(iterationCmd, f)
+	iterationCmd.PersistentFlags().StringP("group", "g", "", "Select a group or subgroup. Defaults to the group of the current repository.")
+
+	iterationCmd.AddCommand(iterationListCmd.NewCmdList(f))
+	iterationCmd.AddCommand(iterationViewCmd.NewCmdView(f))
+
+	return iterationCmd
+}
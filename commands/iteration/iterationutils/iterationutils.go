@@ -0,0 +1,100 @@
+package iterationutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+// State returns the name of the state of an iteration, as used by the --state flag.
+func State(iteration *gitlab.GroupIteration) string {
+	switch iteration.State {
+	case api.IterationStateUpcoming:
+		return "upcoming"
+	case api.IterationStateCurrent:
+		return "current"
+	case api.IterationStateClosed:
+		return "closed"
+	}
+	return "unknown"
+}
+
+// ColoredState returns the state of an iteration: current iterations in green, closed ones in gray.
+func ColoredState(c *iostreams.ColorPalette, iteration *gitlab.GroupIteration) string {
+	switch iteration.State {
+	case api.IterationStateCurrent:
+		return c.Green(State(iteration))
+	case api.IterationStateClosed:
+		return c.Gray(State(iteration))
+	}
+	return c.Blue(State(iteration))
+}
+
+// Title returns the title of an iteration. Iterations created by a cadence often have
+// no title, so they're named by their dates, like in the GitLab UI.
+func Title(iteration *gitlab.GroupIteration) string {
+	if iteration.Title != "" {
+		return iteration.Title
+	}
+	return Dates(iteration)
+}
+
+// Dates describes the start and due dates of an iteration.
+func Dates(iteration *gitlab.GroupIteration) string {
+	start, due := "?", "?"
+	if iteration.StartDate != nil {
+		start = iteration.StartDate.String()
+	}
+	if iteration.DueDate != nil {
+		due = iteration.DueDate.String()
+	}
+	return fmt.Sprintf("%s to %s", start, due)
+}
+
+// Find returns an iteration of a group from its ID, its IID, or its title. The iterations
+// of the ancestors of the group are included, because their issues can belong to them.
+func Find(client *gitlab.Client, group, arg string) (*gitlab.GroupIteration, error) {
+	opts := &gitlab.ListGroupIterationsOptions{
+		State:            gitlab.Ptr("all"),
+		IncludeAncestors: gitlab.Ptr(true),
+		ListOptions:      gitlab.ListOptions{Page: 1, PerPage: 100},
+	}
+
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		// the API searches titles, which narrows down the iterations to go through.
+		opts.Search = gitlab.Ptr(arg)
+	}
+
+	var byIID *gitlab.GroupIteration
+	for {
+		iterations, err := api.ListGroupIterations(client, group, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, iteration := range iterations {
+			switch {
+			case id != 0 && iteration.ID == id:
+				return iteration, nil
+			case id != 0 && iteration.IID == id && byIID == nil:
+				byIID = iteration
+			case id == 0 && strings.EqualFold(iteration.Title, arg):
+				return iteration, nil
+			}
+		}
+		if len(iterations) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	if byIID != nil {
+		return byIID, nil
+	}
+	return nil, fmt.Errorf("no iteration %q in group %s.", arg, group)
+}
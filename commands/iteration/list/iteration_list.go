@@ -0,0 +1,112 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ListOptions struct {
+	State            string
+	Search           string
+	IncludeAncestors bool
+	Page             int
+	PerPage          int
+	OutputFormat     string
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &ListOptions{}
+
+	iterationListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List iterations of a group.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab iteration list
+			glab iteration list --state current
+			glab iteration list --group gitlab-org --include-ancestors
+			glab iteration list --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.State {
+			case "opened", "upcoming", "current", "closed", "all":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("--state must be one of: opened, upcoming, current, closed, all.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := flag.GroupOrRepoGroup(cmd, f.BaseRepo)
+			if err != nil {
+				return err
+			}
+
+			l := &gitlab.ListGroupIterationsOptions{
+				State: gitlab.Ptr(opts.State),
+				ListOptions: gitlab.ListOptions{
+					Page:    opts.Page,
+					PerPage: opts.PerPage,
+				},
+			}
+			if opts.Search != "" {
+				l.Search = gitlab.Ptr(opts.Search)
+			}
+			if opts.IncludeAncestors {
+				l.IncludeAncestors = gitlab.Ptr(true)
+			}
+
+			iterations, err := api.ListGroupIterations(apiClient, group, l)
+			if err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				iterationsJSON, _ := json.Marshal(iterations)
+				fmt.Fprintln(f.IO.StdOut, string(iterationsJSON))
+				return nil
+			}
+
+			title := utils.NewListTitle("iteration")
+			title.RepoName = "group " + group
+			title.Page = opts.Page
+			title.CurrentPageTotal = len(iterations)
+
+			fmt.Fprintf(f.IO.StdOut, "%s\n%s\n", title.Describe(), displayIterations(f, iterations))
+			return nil
+		},
+	}
+
+	iterationListCmd.Flags().StringVarP(&opts.State, "state", "s", "opened", "List iterations in this state: opened, upcoming, current, closed, all. 'opened' lists upcoming and current iterations.")
+	iterationListCmd.Flags().StringVar(&opts.Search, "search", "", "List iterations whose title contains this text.")
+	iterationListCmd.Flags().BoolVar(&opts.IncludeAncestors, "include-ancestors", false, "Include the iterations of the parent groups.")
+	iterationListCmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number.")
+	iterationListCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
+	iterationListCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationListCmd
+}
+
+func displayIterations(f *cmdutils.Factory, iterations []*gitlab.GroupIteration) string {
+	c := f.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, iteration := range iterations {
+		table.AddRow(c.Gray(fmt.Sprint(iteration.ID)), iterationutils.Title(iteration), iterationutils.ColoredState(c, iteration), iterationutils.Dates(iteration))
+	}
+	return table.Render()
+}
@@ -0,0 +1,151 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ViewOptions struct {
+	Web          bool
+	OutputFormat string
+}
+
+// Progress is an iteration with its issues, printed by 'iteration view'.
+type Progress struct {
+	Iteration *gitlab.GroupIteration `json:"iteration"`
+	Issues    []*gitlab.Issue        `json:"issues"`
+	Open      int                    `json:"open"`
+	Closed    int                    `json:"closed"`
+	// TimeEstimate and TotalTimeSpent are in seconds.
+	TimeEstimate   int `json:"time_estimate"`
+	TotalTimeSpent int `json:"total_time_spent"`
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	opts := &ViewOptions{}
+
+	iterationViewCmd := &cobra.Command{
+		Use:   "view <iteration> [flags]",
+		Short: `Show an iteration and its issues.`,
+		Long: heredoc.Doc(`
+			Show an iteration: its dates, the progress of its issues, the time tracked on them,
+			and the list of its issues. Issues of all projects of the group are included.
+		`),
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab iteration view 1234
+			glab iteration view "Sprint 42" --group my-group
+			glab iteration view 1234 --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			group, err := flag.GroupOrRepoGroup(cmd, f.BaseRepo)
+			if err != nil {
+				return err
+			}
+
+			iteration, err := iterationutils.Find(apiClient, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			if opts.Web {
+				if f.IO.IsaTTY && f.IO.IsErrTTY {
+					fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(iteration.WebURL))
+				}
+
+				cfg, _ := f.Config()
+				browser, _ := cfg.Get("", "browser")
+				return utils.OpenInBrowser(iteration.WebURL, browser)
+			}
+
+			// issues are listed from the group of the iteration, which can be an ancestor.
+			issues, err := api.ListIterationIssues(apiClient, iteration.GroupID, iteration.ID)
+			if err != nil {
+				return err
+			}
+
+			progress := newProgress(iteration, issues)
+
+			if opts.OutputFormat == "json" {
+				progressJSON, _ := json.Marshal(progress)
+				fmt.Fprintln(f.IO.StdOut, string(progressJSON))
+				return nil
+			}
+
+			err = f.IO.StartPager()
+			if err != nil {
+				return err
+			}
+			defer f.IO.StopPager()
+
+			printProgress(f.IO, progress)
+			return nil
+		},
+	}
+
+	iterationViewCmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the iteration in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	iterationViewCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return iterationViewCmd
+}
+
+func newProgress(iteration *gitlab.GroupIteration, issues []*gitlab.Issue) *Progress {
+	progress := &Progress{Iteration: iteration, Issues: issues}
+	for _, issue := range issues {
+		if issue.State == "closed" {
+			progress.Closed++
+		} else {
+			progress.Open++
+		}
+		if issue.TimeStats != nil {
+			progress.TimeEstimate += issue.TimeStats.TimeEstimate
+			progress.TotalTimeSpent += issue.TimeStats.TotalTimeSpent
+		}
+	}
+	return progress
+}
+
+func printProgress(io *iostreams.IOStreams, progress *Progress) {
+	c := io.Color()
+	iteration := progress.Iteration
+
+	fmt.Fprintf(io.StdOut, "%s %s\n", c.Bold(iterationutils.Title(iteration)), c.Gray(fmt.Sprintf("*iteration:%d", iteration.ID)))
+	fmt.Fprintf(io.StdOut, "%s%s\n", iterationutils.ColoredState(c, iteration), c.Gray(" • "+iterationutils.Dates(iteration)))
+	if iteration.Description != "" {
+		fmt.Fprintf(io.StdOut, "\n%s\n", iteration.Description)
+	}
+
+	fmt.Fprintln(io.StdOut)
+	if total := progress.Open + progress.Closed; total > 0 {
+		fmt.Fprintf(io.StdOut, "%s %d%% complete\n", c.Bold("Progress:"), progress.Closed*100/total)
+	}
+	fmt.Fprintf(io.StdOut, "%s %d open • %d closed\n", c.Bold("Issues:"), progress.Open, progress.Closed)
+	fmt.Fprintf(io.StdOut, "%s %s spent • %s estimated\n", c.Bold("Time tracking:"),
+		utils.FmtTimeTracking(progress.TotalTimeSpent), utils.FmtTimeTracking(progress.TimeEstimate))
+
+	if len(progress.Issues) > 0 {
+		fmt.Fprintf(io.StdOut, "\n%s", issueutils.DisplayIssueList(io, progress.Issues, ""))
+	}
+
+	if iteration.WebURL != "" {
+		fmt.Fprintf(io.StdOut, c.Gray("\nView this iteration on GitLab: %s\n"), iteration.WebURL)
+	}
+}
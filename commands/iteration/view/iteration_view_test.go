@@ -0,0 +1,87 @@
+package view
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdView(factory)
+	cmdutils.EnableRepoOverride(cmd, factory)
+	cmd.Flags().StringP("group", "g", "", "")
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIterationView(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/iterations?include_ancestors=true&page=1&per_page=100&state=all",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1233, "iid": 4, "group_id": 9, "title": "", "state": 3, "start_date": "2024-05-27", "due_date": "2024-06-09"},
+			{"id": 1234, "iid": 5, "group_id": 9, "title": "Sprint 42", "state": 2, "start_date": "2024-06-10", "due_date": "2024-06-23",
+				"web_url": "https://gitlab.com/groups/OWNER/-/iterations/1234"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/9/issues?iteration_id=1234&per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "iid": 1, "title": "First issue", "state": "closed", "created_at": "2024-06-01T10:00:00Z",
+				"time_stats": {"time_estimate": 28800, "total_time_spent": 14400}},
+			{"id": 102, "iid": 2, "title": "Second issue", "state": "opened", "created_at": "2024-06-03T10:00:00Z"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "1234")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "Sprint 42 *iteration:1234\ncurrent • 2024-06-10 to 2024-06-23\n")
+	assert.Contains(t, output.String(), "Progress: 50% complete\n")
+	assert.Contains(t, output.String(), "Issues: 1 open • 1 closed\n")
+	assert.Contains(t, output.String(), "Time tracking: 4h spent • 1d estimated\n")
+	assert.Contains(t, output.String(), "Second issue")
+	assert.Contains(t, output.String(), "View this iteration on GitLab: https://gitlab.com/groups/OWNER/-/iterations/1234")
+}
+
+func TestIterationViewByTitle(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/OWNER/iterations?include_ancestors=true&page=1&per_page=100&search=sprint+42&state=all",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1233, "iid": 4, "group_id": 9, "title": "", "state": 3, "start_date": "2024-05-27", "due_date": "2024-06-09"},
+			{"id": 1234, "iid": 5, "group_id": 9, "title": "Sprint 42", "state": 2, "start_date": "2024-06-10", "due_date": "2024-06-23",
+				"web_url": "https://gitlab.com/groups/OWNER/-/iterations/1234"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/9/issues?iteration_id=1234&per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "iid": 1, "title": "First issue", "state": "closed", "created_at": "2024-06-01T10:00:00Z",
+				"time_stats": {"time_estimate": 28800, "total_time_spent": 14400}},
+			{"id": 102, "iid": 2, "title": "Second issue", "state": "opened", "created_at": "2024-06-03T10:00:00Z"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, `"sprint 42" --output json`)
+	require.NoError(t, err)
+
+	var progress Progress
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &progress))
+	assert.Equal(t, 1234, progress.Iteration.ID)
+	assert.Equal(t, 1, progress.Open)
+	assert.Equal(t, 1, progress.Closed)
+	assert.Len(t, progress.Issues, 2)
+}
@@ -0,0 +1,60 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+func NewCmdClose(f *cmdutils.Factory) *cobra.Command {
+	milestoneCloseCmd := &cobra.Command{
+		Use:   "close <milestone> [flags]",
+		Short: `Close a milestone.`,
+		Long: heredoc.Doc(`
+			Close a milestone. Its open issues and merge requests are not changed:
+			use 'glab milestone rollover' to move them to another milestone first.
+		`),
+		Example: heredoc.Doc(`
+			glab milestone close 1.0
+			glab milestone close "Sprint 42" --group my-group
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := scope.Find(apiClient, args[0])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			if milestone.State == "closed" {
+				fmt.Fprintf(f.IO.StdErr, "%s Milestone %q is already closed.\n", c.WarnIcon(), milestone.Title)
+				return nil
+			}
+
+			_, err = scope.Update(apiClient, milestone.ID, &gitlab.UpdateMilestoneOptions{StateEvent: gitlab.Ptr("close")})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "%s Closed milestone %q in %s.\n", c.RedCheck(), milestone.Title, scope)
+			return nil
+		},
+	}
+
+	return milestoneCloseCmd
+}
@@ -0,0 +1,81 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
+	milestoneCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create a milestone in a project or group.`,
+		Long:    ``,
+		Aliases: []string{"new"},
+		Example: heredoc.Doc(`
+			glab milestone create --title 1.0
+			glab milestone create --title "Sprint 42" --start-date 2024-06-03 --due-date 2024-06-14
+			glab milestone create --title 17.0 --group gitlab-org
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, _ := cmd.Flags().GetString("title")
+			l := &gitlab.CreateMilestoneOptions{
+				Title: gitlab.Ptr(title),
+			}
+
+			if s, _ := cmd.Flags().GetString("description"); s != "" {
+				l.Description = gitlab.Ptr(s)
+			}
+			if s, _ := cmd.Flags().GetString("start-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("start-date", s)
+				if err != nil {
+					return err
+				}
+				l.StartDate = date
+			}
+			if s, _ := cmd.Flags().GetString("due-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("due-date", s)
+				if err != nil {
+					return err
+				}
+				l.DueDate = date
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := scope.Create(apiClient, l)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Created milestone %q in %s.\n", c.GreenCheck(), milestone.Title, scope)
+			if milestone.WebURL != "" {
+				fmt.Fprintln(f.IO.StdOut, milestone.WebURL)
+			}
+			return nil
+		},
+	}
+
+	milestoneCreateCmd.Flags().StringP("title", "t", "", "Title of the milestone.")
+	_ = milestoneCreateCmd.MarkFlagRequired("title")
+	milestoneCreateCmd.Flags().StringP("description", "d", "", "Description of the milestone.")
+	milestoneCreateCmd.Flags().String("start-date", "", "Start date of the milestone, in the YYYY-MM-DD format.")
+	milestoneCreateCmd.Flags().String("due-date", "", "Due date of the milestone, in the YYYY-MM-DD format.")
+
+	return milestoneCreateCmd
+}
@@ -0,0 +1,76 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+)
+
+func NewCmdDelete(f *cmdutils.Factory) *cobra.Command {
+	var forceDelete bool
+
+	milestoneDeleteCmd := &cobra.Command{
+		Use:   "delete <milestone> [flags]",
+		Short: `Delete a milestone.`,
+		Long: heredoc.Doc(`
+			Delete a milestone. Its issues and merge requests are kept, without a milestone.
+			Requires at least the Reporter role.
+		`),
+		Aliases: []string{"del"},
+		Example: heredoc.Doc(`
+			# Delete a milestone (with a confirmation prompt)
+			glab milestone delete 1.0
+
+			# Skip the confirmation prompt
+			glab milestone delete 1.0 --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !forceDelete && !f.IO.PromptEnabled() {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--yes or -y flag is required when not running interactively.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := scope.Find(apiClient, args[0])
+			if err != nil {
+				return err
+			}
+
+			if !forceDelete {
+				err = prompt.Confirm(&forceDelete, fmt.Sprintf("Delete milestone %q of %s?", milestone.Title, scope), false)
+				if err != nil {
+					return cmdutils.WrapError(err, "could not prompt")
+				}
+				if !forceDelete {
+					return cmdutils.CancelError()
+				}
+			}
+
+			if err := scope.Delete(apiClient, milestone.ID); err != nil {
+				return cmdutils.WrapError(err, "failed to delete milestone.")
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Deleted milestone %q from %s.\n", c.RedCheck(), milestone.Title, scope)
+			return nil
+		},
+	}
+
+	milestoneDeleteCmd.Flags().BoolVarP(&forceDelete, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return milestoneDeleteCmd
+}
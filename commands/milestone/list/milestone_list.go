@@ -0,0 +1,108 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ListOptions struct {
+	State        string
+	Search       string
+	Page         int
+	PerPage      int
+	OutputFormat string
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &ListOptions{}
+
+	milestoneListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List milestones of a project or group.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab milestone list
+			glab milestone list --state closed
+			glab milestone list --search 16. --group gitlab-org
+			glab milestone list --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.State != "active" && opts.State != "closed" && opts.State != "all" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--state must be one of: active, closed, all.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			l := &api.ListMilestonesOptions{
+				Page:    opts.Page,
+				PerPage: opts.PerPage,
+			}
+			if opts.State != "all" {
+				l.State = gitlab.Ptr(opts.State)
+			}
+			if opts.Search != "" {
+				l.Search = gitlab.Ptr(opts.Search)
+			}
+
+			milestones, err := scope.List(apiClient, l)
+			if err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				milestonesJSON, _ := json.Marshal(milestones)
+				fmt.Fprintln(f.IO.StdOut, string(milestonesJSON))
+				return nil
+			}
+
+			title := utils.NewListTitle("milestone")
+			title.RepoName = scope.String()
+			title.Page = opts.Page
+			title.CurrentPageTotal = len(milestones)
+
+			fmt.Fprintf(f.IO.StdOut, "%s\n%s\n", title.Describe(), displayMilestones(f, milestones))
+			return nil
+		},
+	}
+
+	milestoneListCmd.Flags().StringVarP(&opts.State, "state", "s", "active", "List milestones in this state: active, closed, all.")
+	milestoneListCmd.Flags().StringVar(&opts.Search, "search", "", "List milestones whose title or description contains this text.")
+	milestoneListCmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number.")
+	milestoneListCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
+	milestoneListCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return milestoneListCmd
+}
+
+func displayMilestones(f *cmdutils.Factory, milestones []*gitlab.Milestone) string {
+	c := f.IO.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, m := range milestones {
+		state := c.Green(m.State)
+		if m.State == "closed" {
+			state = c.Gray(m.State)
+		}
+		table.AddRow(c.Gray(fmt.Sprintf("%%%d", m.IID)), m.Title, state, milestoneutils.Dates(m))
+	}
+	return table.Render()
+}
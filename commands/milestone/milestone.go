@@ -0,0 +1,46 @@
+package milestone
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	milestoneCloseCmd "gitlab.com/gitlab-org/cli/commands/milestone/close"
+	milestoneCreateCmd "gitlab.com/gitlab-org/cli/commands/milestone/create"
+	milestoneDeleteCmd "gitlab.com/gitlab-org/cli/commands/milestone/delete"
+	milestoneListCmd "gitlab.com/gitlab-org/cli/commands/milestone/list"
+	milestoneRolloverCmd "gitlab.com/gitlab-org/cli/commands/milestone/rollover"
+	milestoneUpdateCmd "gitlab.com/gitlab-org/cli/commands/milestone/update"
+	milestoneViewCmd "gitlab.com/gitlab-org/cli/commands/milestone/view"
+)
+
+func NewCmdMilestone(f *cmdutils.Factory) *cobra.Command {
+	milestoneCmd := &cobra.Command{
+		Use:   "milestone <command> [flags]",
+		Short: `Manage project and group milestones.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab milestone list --state active
+			glab milestone view 1.0
+			glab milestone rollover 1.0 1.1
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				A milestone can be supplied as argument by its title, or by the number in its URL.
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(milestoneCmd, f)
+	milestoneCmd.PersistentFlags().StringP("group", "g", "", "Manage the milestones of a group or subgroup. Ignored if a repository argument is set.")
+
+	milestoneCmd.AddCommand(milestoneListCmd.NewCmdList(f))
+	milestoneCmd.AddCommand(milestoneViewCmd.NewCmdView(f))
+	milestoneCmd.AddCommand(milestoneCreateCmd.NewCmdCreate(f))
+	milestoneCmd.AddCommand(milestoneUpdateCmd.NewCmdUpdate(f))
+	milestoneCmd.AddCommand(milestoneCloseCmd.NewCmdClose(f))
+	milestoneCmd.AddCommand(milestoneDeleteCmd.NewCmdDelete(f))
+	milestoneCmd.AddCommand(milestoneRolloverCmd.NewCmdRollover(f))
+
+	return milestoneCmd
+}
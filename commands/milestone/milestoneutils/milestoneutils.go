@@ -0,0 +1,176 @@
+package milestoneutils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/flag"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Scope is the project, or the group, whose milestones a command manages.
+// Group milestones are converted to project milestones, so commands handle both the same way.
+type Scope struct {
+	Group string
+	Repo  glrepo.Interface
+}
+
+// NewScope returns the group of the --group flag or of the GITLAB_GROUP variable,
+// or the current repository.
+func NewScope(f *cmdutils.Factory, cmd *cobra.Command) (*Scope, error) {
+	group, err := flag.GroupOverride(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if group != "" {
+		return &Scope{Group: group}, nil
+	}
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return nil, err
+	}
+	return &Scope{Repo: repo}, nil
+}
+
+func (s *Scope) String() string {
+	if s.Group != "" {
+		return "group " + s.Group
+	}
+	return s.Repo.FullName()
+}
+
+// List returns the milestones that match opts.
+func (s *Scope) List(client *gitlab.Client, opts *api.ListMilestonesOptions) ([]*gitlab.Milestone, error) {
+	if s.Group == "" {
+		return api.ListProjectMilestones(client, s.Repo.FullName(), opts.ListProjectMilestonesOptions())
+	}
+
+	groupMilestones, err := api.ListGroupMilestones(client, s.Group, opts.ListGroupMilestonesOptions())
+	if err != nil {
+		return nil, err
+	}
+	milestones := make([]*gitlab.Milestone, 0, len(groupMilestones))
+	for _, m := range groupMilestones {
+		milestones = append(milestones, s.fromGroupMilestone(client, m))
+	}
+	return milestones, nil
+}
+
+// Find returns a milestone from its IID, which is the number in its URL, or from its title.
+func (s *Scope) Find(client *gitlab.Client, arg string) (*gitlab.Milestone, error) {
+	opts := &api.ListMilestonesOptions{}
+	if iid, err := strconv.Atoi(arg); err == nil {
+		opts.IIDs = []int{iid}
+		milestones, err := s.List(client, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(milestones) == 1 {
+			return milestones[0], nil
+		}
+		// a number can also be the title of a milestone, like "2024".
+		opts.IIDs = nil
+	}
+
+	opts.Title = gitlab.Ptr(arg)
+	milestones, err := s.List(client, opts)
+	if err != nil {
+		return nil, err
+	}
+	if len(milestones) == 0 {
+		return nil, fmt.Errorf("no milestone %q in %s.", arg, s)
+	}
+	return milestones[0], nil
+}
+
+func (s *Scope) Create(client *gitlab.Client, opts *gitlab.CreateMilestoneOptions) (*gitlab.Milestone, error) {
+	if s.Group == "" {
+		return api.CreateProjectMilestone(client, s.Repo.FullName(), opts)
+	}
+
+	m, err := api.CreateGroupMilestone(client, s.Group, (*gitlab.CreateGroupMilestoneOptions)(opts))
+	if err != nil {
+		return nil, err
+	}
+	return s.fromGroupMilestone(client, m), nil
+}
+
+func (s *Scope) Update(client *gitlab.Client, milestoneID int, opts *gitlab.UpdateMilestoneOptions) (*gitlab.Milestone, error) {
+	if s.Group == "" {
+		return api.UpdateProjectMilestone(client, s.Repo.FullName(), milestoneID, opts)
+	}
+
+	m, err := api.UpdateGroupMilestone(client, s.Group, milestoneID, (*gitlab.UpdateGroupMilestoneOptions)(opts))
+	if err != nil {
+		return nil, err
+	}
+	return s.fromGroupMilestone(client, m), nil
+}
+
+func (s *Scope) Delete(client *gitlab.Client, milestoneID int) error {
+	if s.Group == "" {
+		return api.DeleteProjectMilestone(client, s.Repo.FullName(), milestoneID)
+	}
+	return api.DeleteGroupMilestone(client, s.Group, milestoneID)
+}
+
+// Issues returns all issues of a milestone. Issues of a group milestone can belong to several projects.
+func (s *Scope) Issues(client *gitlab.Client, milestoneID int) ([]*gitlab.Issue, error) {
+	if s.Group == "" {
+		return api.ListProjectMilestoneIssues(client, s.Repo.FullName(), milestoneID)
+	}
+	return api.ListGroupMilestoneIssues(client, s.Group, milestoneID)
+}
+
+// MergeRequests returns all merge requests of a milestone.
+func (s *Scope) MergeRequests(client *gitlab.Client, milestoneID int) ([]*gitlab.MergeRequest, error) {
+	if s.Group == "" {
+		return api.ListProjectMilestoneMRs(client, s.Repo.FullName(), milestoneID)
+	}
+	return api.ListGroupMilestoneMRs(client, s.Group, milestoneID)
+}
+
+// fromGroupMilestone converts a group milestone. The API returns the web URL of group
+// milestones, but the client drops it, so it's built from the URL of the instance.
+func (s *Scope) fromGroupMilestone(client *gitlab.Client, m *gitlab.GroupMilestone) *gitlab.Milestone {
+	webURL := ""
+	if client != nil {
+		host := strings.TrimSuffix(client.BaseURL().String(), "api/v4/")
+		webURL = fmt.Sprintf("%sgroups/%s/-/milestones/%d", host, s.Group, m.IID)
+	}
+
+	return &gitlab.Milestone{
+		ID:          m.ID,
+		IID:         m.IID,
+		GroupID:     m.GroupID,
+		Title:       m.Title,
+		Description: m.Description,
+		StartDate:   m.StartDate,
+		DueDate:     m.DueDate,
+		State:       m.State,
+		WebURL:      webURL,
+		UpdatedAt:   m.UpdatedAt,
+		CreatedAt:   m.CreatedAt,
+		Expired:     m.Expired,
+	}
+}
+
+// Dates describes the start and due dates of a milestone.
+func Dates(m *gitlab.Milestone) string {
+	switch {
+	case m.StartDate != nil && m.DueDate != nil:
+		return fmt.Sprintf("%s to %s", m.StartDate, m.DueDate)
+	case m.DueDate != nil:
+		return fmt.Sprintf("due %s", m.DueDate)
+	case m.StartDate != nil:
+		return fmt.Sprintf("starts %s", m.StartDate)
+	}
+	return ""
+}
@@ -0,0 +1,63 @@
+package milestoneutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func testClient(t *testing.T, rt http.RoundTripper) *gitlab.Client {
+	t.Helper()
+
+	factory := cmdtest.InitFactory(nil, rt)
+	_, _ = factory.HttpClient()
+	client, err := factory.HttpClient()
+	require.NoError(t, err)
+	return client
+}
+
+func TestScope_Find(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?iids%5B%5D=2024&per_page=30",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=2024",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "2024"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=missing",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	client := testClient(t, fakeHTTP)
+	scope := &Scope{Repo: glrepo.New("OWNER", "REPO")}
+
+	milestone, err := scope.Find(client, "2024")
+	require.NoError(t, err)
+	assert.Equal(t, 12, milestone.ID)
+
+	_, err = scope.Find(client, "missing")
+	require.EqualError(t, err, `no milestone "missing" in OWNER/REPO.`)
+}
+
+func TestScope_FindGroupMilestone(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/groups/my-group/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 7, "iid": 2, "group_id": 5, "title": "Sprint 42"}]`))
+
+	client := testClient(t, fakeHTTP)
+	scope := &Scope{Group: "my-group"}
+
+	milestone, err := scope.Find(client, "Sprint 42")
+	require.NoError(t, err)
+	assert.Equal(t, 7, milestone.ID)
+	assert.Equal(t, "https://gitlab.com/groups/my-group/-/milestones/2", milestone.WebURL)
+}
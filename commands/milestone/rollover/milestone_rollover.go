@@ -0,0 +1,140 @@
+package rollover
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+)
+
+type RolloverOptions struct {
+	Bulk  cmdutils.BulkOptions
+	Close bool
+}
+
+func NewCmdRollover(f *cmdutils.Factory) *cobra.Command {
+	opts := &RolloverOptions{}
+
+	milestoneRolloverCmd := &cobra.Command{
+		Use:   "rollover <from> <to> [flags]",
+		Short: `Move the open issues and merge requests of a milestone to another milestone.`,
+		Long: heredoc.Doc(`
+			Move all open issues and merge requests of a milestone to another milestone,
+			usually the next one. Closed issues and merged or closed merge requests stay
+			in their milestone.
+
+			The issues and merge requests to move are listed first, and you are asked for
+			confirmation. When it ends, a JSON report of the moved ones, and of the ones
+			that could not be moved, is printed to the standard output.
+		`),
+		Example: heredoc.Doc(`
+			glab milestone rollover 1.0 1.1
+			glab milestone rollover 1.0 1.1 --close
+			glab milestone rollover "Sprint 41" "Sprint 42" --group my-group --yes
+			glab milestone rollover 1.0 1.1 --dry-run
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Bulk.Validate(f.IO, nil); err != nil {
+				return err
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			return rolloverRun(f, apiClient, scope, opts, args[0], args[1])
+		},
+	}
+
+	milestoneRolloverCmd.Flags().BoolVar(&opts.Close, "close", false, "Close the milestone after moving all its open issues and merge requests.")
+	milestoneRolloverCmd.Flags().BoolVar(&opts.Bulk.DryRun, "dry-run", false, "Show the issues and merge requests that would be moved, without moving them.")
+	milestoneRolloverCmd.Flags().BoolVarP(&opts.Bulk.Yes, "yes", "y", false, "Move the issues and merge requests without asking for confirmation.")
+	milestoneRolloverCmd.Flags().IntVar(&opts.Bulk.Concurrency, "concurrency", 4, "Maximum number of issues and merge requests to move at once.")
+
+	return milestoneRolloverCmd
+}
+
+func rolloverRun(f *cmdutils.Factory, apiClient *gitlab.Client, scope *milestoneutils.Scope, opts *RolloverOptions, fromArg, toArg string) error {
+	from, err := scope.Find(apiClient, fromArg)
+	if err != nil {
+		return err
+	}
+	to, err := scope.Find(apiClient, toArg)
+	if err != nil {
+		return err
+	}
+	if from.ID == to.ID {
+		return &cmdutils.FlagError{Err: errors.New("the milestones to move from and to must be different.")}
+	}
+	if to.State == "closed" {
+		return fmt.Errorf("milestone %q is closed. Reopen it with 'glab milestone update %s --reopen'.", to.Title, toArg)
+	}
+
+	issues, err := scope.Issues(apiClient, from.ID)
+	if err != nil {
+		return err
+	}
+	mrs, err := scope.MergeRequests(apiClient, from.ID)
+	if err != nil {
+		return err
+	}
+
+	var items []cmdutils.BulkItem
+	for _, issue := range issues {
+		if issue.State != "opened" {
+			continue
+		}
+		items = append(items, cmdutils.BulkItem{
+			Reference: issueutils.IssueReference(issue),
+			Title:     issue.Title,
+			WebURL:    issue.WebURL,
+			Update: func() error {
+				_, err := api.UpdateIssue(apiClient, issue.ProjectID, issue.IID, &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Ptr(to.ID)})
+				return err
+			},
+		})
+	}
+	for _, mr := range mrs {
+		if mr.State != "opened" && mr.State != "locked" {
+			continue
+		}
+		items = append(items, cmdutils.BulkItem{
+			Reference: mrutils.MRReference(mr),
+			Title:     mr.Title,
+			WebURL:    mr.WebURL,
+			Update: func() error {
+				_, err := api.UpdateMR(apiClient, mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{MilestoneID: gitlab.Ptr(to.ID)})
+				return err
+			},
+		})
+	}
+
+	changes := []string{fmt.Sprintf("move from milestone %q to milestone %q", from.Title, to.Title)}
+	if err := cmdutils.RunBulk(f.IO, &opts.Bulk, "items", items, changes); err != nil {
+		return err
+	}
+
+	if !opts.Close || opts.Bulk.DryRun {
+		return nil
+	}
+	if _, err := scope.Update(apiClient, from.ID, &gitlab.UpdateMilestoneOptions{StateEvent: gitlab.Ptr("close")}); err != nil {
+		return err
+	}
+	fmt.Fprintf(f.IO.StdErr, "%s Closed milestone %q.\n", f.IO.Color().RedCheck(), from.Title)
+	return nil
+}
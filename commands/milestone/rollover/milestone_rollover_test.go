@@ -0,0 +1,120 @@
+package rollover
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdRollover(factory)
+	cmdutils.EnableRepoOverride(cmd, factory)
+	cmd.Flags().StringP("group", "g", "", "")
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestMilestoneRollover(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=1.0",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=1.1",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 13, "iid": 4, "title": "1.1", "state": "active"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/issues?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "iid": 1, "project_id": 3, "state": "closed", "title": "Done", "references": {"full": "OWNER/REPO#1"}},
+			{"id": 102, "iid": 2, "project_id": 3, "state": "opened", "title": "To do", "references": {"full": "OWNER/REPO#2"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/merge_requests?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"iid": 4, "project_id": 3, "state": "merged", "title": "Merged", "references": {"full": "OWNER/REPO!4"}},
+			{"iid": 5, "project_id": 3, "state": "opened", "title": "In review", "references": {"full": "OWNER/REPO!5"}}
+		]`))
+
+	var issueBody, mrBody map[string]any
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/issues/2",
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &issueBody)
+			return httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "iid": 2}`)(req)
+		})
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/merge_requests/5",
+		func(req *http.Request) (*http.Response, error) {
+			data, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(data, &mrBody)
+			return httpmock.NewStringResponse(http.StatusOK, `{"iid": 5}`)(req)
+		})
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/OWNER/REPO/milestones/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 12, "iid": 3, "title": "1.0", "state": "closed"}`))
+
+	output, err := runCommand(t, fakeHTTP, "1.0 1.1 --close --yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{"milestone_id": float64(13)}, issueBody)
+	assert.Equal(t, map[string]any{"milestone_id": float64(13)}, mrBody)
+	assert.Contains(t, output.Stderr(), "- move from milestone \"1.0\" to milestone \"1.1\"")
+	assert.Contains(t, output.Stderr(), "Closed milestone \"1.0\".")
+
+	var report cmdutils.BulkReport
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, 2, report.Matched)
+	require.Len(t, report.Updated, 2)
+	assert.Equal(t, "OWNER/REPO#2", report.Updated[0].Reference)
+	assert.Equal(t, "OWNER/REPO!5", report.Updated[1].Reference)
+}
+
+func TestMilestoneRolloverDryRun(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=1.0",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=1.1",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 13, "iid": 4, "title": "1.1", "state": "active"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/issues?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 102, "iid": 2, "project_id": 3, "state": "opened", "title": "To do"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/merge_requests?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "1.0 1.1 --close --dry-run")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.Stderr(), "#2\tTo do")
+	assert.Contains(t, output.Stderr(), "Dry run: nothing was updated.")
+	assert.NotContains(t, output.Stderr(), "Closed milestone")
+}
+
+func TestMilestoneRolloverSameMilestone(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	fakeHTTP.MatchURL = httpmock.PathAndQuerystring
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?per_page=30&title=1.0",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones?iids%5B%5D=3&per_page=30",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active"}]`))
+
+	_, err := runCommand(t, fakeHTTP, "1.0 3 --yes")
+	require.EqualError(t, err, "the milestones to move from and to must be different.")
+}
@@ -0,0 +1,92 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+)
+
+func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	milestoneUpdateCmd := &cobra.Command{
+		Use:   "update <milestone> [flags]",
+		Short: `Update a milestone.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab milestone update 1.0 --title 1.0.0
+			glab milestone update 3 --due-date 2024-06-28
+			glab milestone update 1.0 --reopen
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := &gitlab.UpdateMilestoneOptions{}
+
+			if cmd.Flags().Changed("title") {
+				title, _ := cmd.Flags().GetString("title")
+				l.Title = gitlab.Ptr(title)
+			}
+			if cmd.Flags().Changed("description") {
+				description, _ := cmd.Flags().GetString("description")
+				l.Description = gitlab.Ptr(description)
+			}
+			if s, _ := cmd.Flags().GetString("start-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("start-date", s)
+				if err != nil {
+					return err
+				}
+				l.StartDate = date
+			}
+			if s, _ := cmd.Flags().GetString("due-date"); s != "" {
+				date, err := cmdutils.ParseDateFlag("due-date", s)
+				if err != nil {
+					return err
+				}
+				l.DueDate = date
+			}
+			if reopen, _ := cmd.Flags().GetBool("reopen"); reopen {
+				l.StateEvent = gitlab.Ptr("activate")
+			}
+
+			if *l == (gitlab.UpdateMilestoneOptions{}) {
+				return &cmdutils.FlagError{Err: errors.New("no changes to apply. Use flags like '--title' or '--due-date'.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := scope.Find(apiClient, args[0])
+			if err != nil {
+				return err
+			}
+
+			milestone, err = scope.Update(apiClient, milestone.ID, l)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Updated milestone %q in %s.\n", c.GreenCheck(), milestone.Title, scope)
+			return nil
+		},
+	}
+
+	milestoneUpdateCmd.Flags().StringP("title", "t", "", "Title of the milestone.")
+	milestoneUpdateCmd.Flags().StringP("description", "d", "", "Description of the milestone.")
+	milestoneUpdateCmd.Flags().String("start-date", "", "Start date of the milestone, in the YYYY-MM-DD format.")
+	milestoneUpdateCmd.Flags().String("due-date", "", "Due date of the milestone, in the YYYY-MM-DD format.")
+	milestoneUpdateCmd.Flags().Bool("reopen", false, "Reopen a closed milestone.")
+
+	return milestoneUpdateCmd
+}
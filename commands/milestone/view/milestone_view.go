@@ -0,0 +1,262 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/milestone/milestoneutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// burndownWidth is the width of the longest bar of the burndown chart.
+const burndownWidth = 40
+
+type ViewOptions struct {
+	Web          bool
+	OutputFormat string
+}
+
+// IssueCounts are the numbers of issues of a milestone, by state.
+type IssueCounts struct {
+	Open   int `json:"open"`
+	Closed int `json:"closed"`
+}
+
+// MRCounts are the numbers of merge requests of a milestone, by state.
+type MRCounts struct {
+	Open   int `json:"open"`
+	Merged int `json:"merged"`
+	Closed int `json:"closed"`
+}
+
+// BurndownDay is the number of open issues of a milestone at the end of a day.
+type BurndownDay struct {
+	Date string `json:"date"`
+	Open int    `json:"open"`
+}
+
+// Progress is the progress of a milestone, printed by 'milestone view'.
+type Progress struct {
+	Milestone     *gitlab.Milestone `json:"milestone"`
+	Issues        IssueCounts       `json:"issues"`
+	MergeRequests MRCounts          `json:"merge_requests"`
+	// TimeEstimate and TotalTimeSpent are in seconds.
+	TimeEstimate   int           `json:"time_estimate"`
+	TotalTimeSpent int           `json:"total_time_spent"`
+	Burndown       []BurndownDay `json:"burndown"`
+}
+
+func NewCmdView(f *cmdutils.Factory) *cobra.Command {
+	opts := &ViewOptions{}
+
+	milestoneViewCmd := &cobra.Command{
+		Use:   "view <milestone> [flags]",
+		Short: `Show the progress of a milestone.`,
+		Long: heredoc.Doc(`
+			Show the progress of a milestone: its open and closed issues and merge requests,
+			the time tracked on them, and a burndown of its open issues by day.
+
+			The burndown starts on the start date of the milestone, or the day it was created,
+			and ends on its due date, or today. It counts the issues of the milestone that were
+			open at the end of each day.
+		`),
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			glab milestone view 1.0
+			glab milestone view 3 --web
+			glab milestone view "Sprint 42" --group my-group --output json
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			scope, err := milestoneutils.NewScope(f, cmd)
+			if err != nil {
+				return err
+			}
+
+			milestone, err := scope.Find(apiClient, args[0])
+			if err != nil {
+				return err
+			}
+
+			if opts.Web {
+				if f.IO.IsaTTY && f.IO.IsErrTTY {
+					fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(milestone.WebURL))
+				}
+
+				cfg, _ := f.Config()
+				host := ""
+				if scope.Repo != nil {
+					host = scope.Repo.RepoHost()
+				}
+				browser, _ := cfg.Get(host, "browser")
+				return utils.OpenInBrowser(milestone.WebURL, browser)
+			}
+
+			issues, err := scope.Issues(apiClient, milestone.ID)
+			if err != nil {
+				return err
+			}
+			mrs, err := scope.MergeRequests(apiClient, milestone.ID)
+			if err != nil {
+				return err
+			}
+
+			progress := newProgress(milestone, issues, mrs, time.Now())
+
+			if opts.OutputFormat == "json" {
+				progressJSON, _ := json.Marshal(progress)
+				fmt.Fprintln(f.IO.StdOut, string(progressJSON))
+				return nil
+			}
+
+			err = f.IO.StartPager()
+			if err != nil {
+				return err
+			}
+			defer f.IO.StopPager()
+
+			printProgress(f.IO, progress)
+			return nil
+		},
+	}
+
+	milestoneViewCmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open the milestone in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	milestoneViewCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return milestoneViewCmd
+}
+
+func newProgress(milestone *gitlab.Milestone, issues []*gitlab.Issue, mrs []*gitlab.MergeRequest, now time.Time) *Progress {
+	progress := &Progress{Milestone: milestone}
+
+	for _, issue := range issues {
+		if issue.State == "closed" {
+			progress.Issues.Closed++
+		} else {
+			progress.Issues.Open++
+		}
+		if issue.TimeStats != nil {
+			progress.TimeEstimate += issue.TimeStats.TimeEstimate
+			progress.TotalTimeSpent += issue.TimeStats.TotalTimeSpent
+		}
+	}
+
+	for _, mr := range mrs {
+		switch mr.State {
+		case "merged":
+			progress.MergeRequests.Merged++
+		case "closed":
+			progress.MergeRequests.Closed++
+		default:
+			progress.MergeRequests.Open++
+		}
+		if mr.TimeStats != nil {
+			progress.TimeEstimate += mr.TimeStats.TimeEstimate
+			progress.TotalTimeSpent += mr.TimeStats.TotalTimeSpent
+		}
+	}
+
+	progress.Burndown = burndown(milestone, issues, now)
+	return progress
+}
+
+// burndown counts the open issues of a milestone at the end of each day, from its start
+// to its due date, or to now if it's due later.
+func burndown(milestone *gitlab.Milestone, issues []*gitlab.Issue, now time.Time) []BurndownDay {
+	var start time.Time
+	switch {
+	case milestone.StartDate != nil:
+		start = time.Time(*milestone.StartDate)
+	case milestone.CreatedAt != nil:
+		start = *milestone.CreatedAt
+	default:
+		return []BurndownDay{}
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	end := now.UTC()
+	if milestone.DueDate != nil && time.Time(*milestone.DueDate).Before(end) {
+		end = time.Time(*milestone.DueDate)
+	}
+
+	days := []BurndownDay{}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		endOfDay := day.AddDate(0, 0, 1)
+
+		open := 0
+		for _, issue := range issues {
+			if issue.CreatedAt != nil && !issue.CreatedAt.Before(endOfDay) {
+				continue
+			}
+			if issue.ClosedAt != nil && issue.ClosedAt.Before(endOfDay) {
+				continue
+			}
+			open++
+		}
+
+		days = append(days, BurndownDay{Date: day.Format(time.DateOnly), Open: open})
+	}
+	return days
+}
+
+func printProgress(io *iostreams.IOStreams, progress *Progress) {
+	c := io.Color()
+	m := progress.Milestone
+
+	state := c.Green(m.State)
+	if m.State == "closed" {
+		state = c.Gray(m.State)
+	}
+	fmt.Fprintf(io.StdOut, "%s %s\n", c.Bold(m.Title), c.Gray(fmt.Sprintf("%%%d", m.IID)))
+	fmt.Fprint(io.StdOut, state)
+	if dates := milestoneutils.Dates(m); dates != "" {
+		fmt.Fprintf(io.StdOut, c.Gray(" • %s"), dates)
+	}
+	fmt.Fprintln(io.StdOut)
+	if m.Description != "" {
+		fmt.Fprintf(io.StdOut, "\n%s\n", m.Description)
+	}
+
+	fmt.Fprintln(io.StdOut)
+	if total := progress.Issues.Open + progress.Issues.Closed; total > 0 {
+		fmt.Fprintf(io.StdOut, "%s %d%% complete\n", c.Bold("Progress:"), progress.Issues.Closed*100/total)
+	}
+	fmt.Fprintf(io.StdOut, "%s %d open • %d closed\n", c.Bold("Issues:"), progress.Issues.Open, progress.Issues.Closed)
+	fmt.Fprintf(io.StdOut, "%s %d open • %d merged • %d closed\n", c.Bold("Merge requests:"),
+		progress.MergeRequests.Open, progress.MergeRequests.Merged, progress.MergeRequests.Closed)
+	fmt.Fprintf(io.StdOut, "%s %s spent • %s estimated\n", c.Bold("Time tracking:"),
+		utils.FmtTimeTracking(progress.TotalTimeSpent), utils.FmtTimeTracking(progress.TimeEstimate))
+
+	if len(progress.Burndown) > 0 {
+		fmt.Fprintf(io.StdOut, "\n%s\n", c.Bold("Burndown of open issues:"))
+
+		highest := 0
+		for _, day := range progress.Burndown {
+			highest = max(highest, day.Open)
+		}
+		for _, day := range progress.Burndown {
+			width := 0
+			if highest > 0 {
+				width = day.Open * burndownWidth / highest
+			}
+			fmt.Fprintf(io.StdOut, "%s %s %d\n", c.Gray(day.Date), c.Blue(strings.Repeat("█", width)), day.Open)
+		}
+	}
+
+	if m.WebURL != "" {
+		fmt.Fprintf(io.StdOut, c.Gray("\nView this milestone on GitLab: %s\n"), m.WebURL)
+	}
+}
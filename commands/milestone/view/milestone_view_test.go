@@ -0,0 +1,129 @@
+package view
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdView(factory)
+	cmdutils.EnableRepoOverride(cmd, factory)
+	cmd.Flags().StringP("group", "g", "", "")
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestMilestoneView(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active",
+			"start_date": "2024-06-03", "due_date": "2024-06-05", "web_url": "https://gitlab.com/OWNER/REPO/-/milestones/3"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "iid": 1, "state": "closed", "created_at": "2024-06-01T10:00:00Z", "closed_at": "2024-06-04T10:00:00Z",
+				"time_stats": {"time_estimate": 28800, "total_time_spent": 14400}},
+			{"id": 102, "iid": 2, "state": "opened", "created_at": "2024-06-03T10:00:00Z",
+				"time_stats": {"time_estimate": 3600, "total_time_spent": 0}},
+			{"id": 103, "iid": 3, "state": "opened", "created_at": "2024-06-05T10:00:00Z"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"iid": 4, "state": "merged", "time_stats": {"time_estimate": 0, "total_time_spent": 1800}},
+			{"iid": 5, "state": "opened"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "1.0")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), "1.0 %3\nactive • 2024-06-03 to 2024-06-05\n")
+	assert.Contains(t, output.String(), "Progress: 33% complete\n")
+	assert.Contains(t, output.String(), "Issues: 2 open • 1 closed\n")
+	assert.Contains(t, output.String(), "Merge requests: 1 open • 1 merged • 0 closed\n")
+	assert.Contains(t, output.String(), "Time tracking: 4h 30m spent • 1d 1h estimated\n")
+	assert.Contains(t, output.String(), "2024-06-05 ████████████████████████████████████████ 2\n")
+	assert.Contains(t, output.String(), "View this milestone on GitLab: https://gitlab.com/OWNER/REPO/-/milestones/3")
+}
+
+func TestMilestoneViewJSON(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 12, "iid": 3, "title": "1.0", "state": "active",
+			"start_date": "2024-06-03", "due_date": "2024-06-05", "web_url": "https://gitlab.com/OWNER/REPO/-/milestones/3"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/issues",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "iid": 1, "state": "closed", "created_at": "2024-06-01T10:00:00Z", "closed_at": "2024-06-04T10:00:00Z",
+				"time_stats": {"time_estimate": 28800, "total_time_spent": 14400}},
+			{"id": 102, "iid": 2, "state": "opened", "created_at": "2024-06-03T10:00:00Z",
+				"time_stats": {"time_estimate": 3600, "total_time_spent": 0}},
+			{"id": 103, "iid": 3, "state": "opened", "created_at": "2024-06-05T10:00:00Z"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones/12/merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"iid": 4, "state": "merged", "time_stats": {"time_estimate": 0, "total_time_spent": 1800}},
+			{"iid": 5, "state": "opened"}
+		]`))
+
+	output, err := runCommand(t, fakeHTTP, "1.0 --output json")
+	require.NoError(t, err)
+
+	var progress Progress
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &progress))
+	assert.Equal(t, IssueCounts{Open: 2, Closed: 1}, progress.Issues)
+	assert.Equal(t, MRCounts{Open: 1, Merged: 1}, progress.MergeRequests)
+	assert.Equal(t, 32400, progress.TimeEstimate)
+	assert.Equal(t, 16200, progress.TotalTimeSpent)
+	assert.Equal(t, []BurndownDay{
+		{Date: "2024-06-03", Open: 2},
+		{Date: "2024-06-04", Open: 1},
+		{Date: "2024-06-05", Open: 2},
+	}, progress.Burndown)
+}
+
+func Test_burndown(t *testing.T) {
+	createdAt := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	closedAt := time.Date(2024, 6, 2, 18, 0, 0, 0, time.UTC)
+	issues := []*gitlab.Issue{
+		{CreatedAt: &createdAt, ClosedAt: &closedAt},
+		{CreatedAt: &createdAt},
+	}
+
+	t.Run("without a due date", func(t *testing.T) {
+		milestone := &gitlab.Milestone{CreatedAt: &createdAt}
+		now := time.Date(2024, 6, 3, 12, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, []BurndownDay{
+			{Date: "2024-06-01", Open: 2},
+			{Date: "2024-06-02", Open: 1},
+			{Date: "2024-06-03", Open: 1},
+		}, burndown(milestone, issues, now))
+	})
+
+	t.Run("due later", func(t *testing.T) {
+		dueDate := gitlab.ISOTime(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC))
+		milestone := &gitlab.Milestone{CreatedAt: &createdAt, DueDate: &dueDate}
+		now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, []BurndownDay{{Date: "2024-06-01", Open: 2}}, burndown(milestone, issues, now))
+	})
+}
@@ -2,6 +2,7 @@ package checkout
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -17,8 +18,12 @@ type mrCheckoutConfig struct {
 	branch   string
 	track    bool
 	upstream string
+	worktree string
 }
 
+// defaultWorktree is the value of --worktree when no path is given.
+const defaultWorktree = "default"
+
 var mrCheckoutCfg mrCheckoutConfig
 
 func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
@@ -32,6 +37,10 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 			$ glab mr checkout 12 --branch todo-fix
 			$ glab mr checkout new-feature --set-upstream-to=upstream/main
 
+			# Check out in a new worktree, next to the current one
+			$ glab mr checkout 12 --worktree
+			$ glab mr checkout 12 --worktree=../review-12
+
 			# Uses the checked-out branch
 			$ glab mr checkout
 		`),
@@ -108,6 +117,10 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 				return err
 			}
 
+			if mrCheckoutCfg.worktree != "" {
+				return checkoutWorktree(f, mr, upstream)
+			}
+
 			// Check out branch
 			if err := git.CheckoutBranch(mrCheckoutCfg.branch); err != nil {
 				return err
@@ -126,5 +139,37 @@ func NewCmdCheckout(f *cmdutils.Factory) *cobra.Command {
 	mrCheckoutCmd.Flags().BoolVarP(&mrCheckoutCfg.track, "track", "t", true, "Set checked out branch to track the remote branch.")
 	_ = mrCheckoutCmd.Flags().MarkDeprecated("track", "Now enabled by default")
 	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.upstream, "set-upstream-to", "u", "", "Set tracking of checked-out branch to [REMOTE/]BRANCH.")
+	mrCheckoutCmd.Flags().StringVarP(&mrCheckoutCfg.worktree, "worktree", "w", "", "Check out the merge request in a new Git worktree at `path`, instead of the current working tree. Defaults to '../<repo>-mr-<id>'.")
+	mrCheckoutCmd.Flags().Lookup("worktree").NoOptDefVal = defaultWorktree
 	return mrCheckoutCmd
 }
+
+// checkoutWorktree creates a worktree for the branch of mr, and registers it
+// so 'glab mr worktree prune' can remove it once mr is merged or closed.
+func checkoutWorktree(f *cmdutils.Factory, mr *gitlab.MergeRequest, upstream string) error {
+	path := mrCheckoutCfg.worktree
+	if path == defaultWorktree {
+		topLevel, err := git.ToplevelDir()
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(filepath.Dir(topLevel), fmt.Sprintf("%s-mr-%d", filepath.Base(topLevel), mr.IID))
+	}
+
+	if err := git.AddWorktree(path, mrCheckoutCfg.branch); err != nil {
+		return err
+	}
+
+	if err := mrutils.RegisterMRWorktree(mrCheckoutCfg.branch, mr); err != nil {
+		return err
+	}
+
+	if upstream != "" {
+		if err := git.RunCmd([]string{"branch", "--set-upstream-to", upstream, mrCheckoutCfg.branch}); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(f.IO.StdErr, "Checked out !%d in %s\n", mr.IID, path)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"gitlab.com/gitlab-org/cli/pkg/git"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/pkg/httpmock"
 	"gitlab.com/gitlab-org/cli/test"
@@ -262,3 +263,48 @@ func TestMrCheckout(t *testing.T) {
 		})
 	}
 }
+
+func TestMrCheckoutWorktree(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/123",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 123,
+			"iid": 123,
+			"project_id": 3,
+			"source_project_id": 3,
+			"allow_collaboration": false,
+			"state": "opened",
+			"source_branch": "feat-new-mr",
+			"web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/123"
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/3",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 3,
+			"ssh_url_to_repo": "git@gitlab.com:OWNER/REPO.git"
+		}`))
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+	for range 5 {
+		cs.Stub("")
+	}
+
+	output, err := runCommand(fakeHTTP, "main", false, "123 --worktree=../review-123")
+	require.NoError(t, err)
+	assert.Equal(t, "Checked out !123 in ../review-123\n", output.Stderr())
+
+	expectedShellouts := []string{
+		"git fetch git@gitlab.com:OWNER/REPO.git refs/heads/feat-new-mr:feat-new-mr",
+		"git config branch.feat-new-mr.remote git@gitlab.com:OWNER/REPO.git",
+		"git config branch.feat-new-mr.merge refs/heads/feat-new-mr",
+		"git worktree add ../review-123 feat-new-mr",
+		"git config branch.feat-new-mr.glab-mr-url https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+	}
+
+	assert.Equal(t, len(expectedShellouts), cs.Count)
+	for idx, expectedShellout := range expectedShellouts {
+		assert.Equal(t, expectedShellout, strings.Join(cs.Calls[idx].Args, " "))
+	}
+}
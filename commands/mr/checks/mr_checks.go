@@ -0,0 +1,395 @@
+package checks
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+const (
+	statusPass = "pass"
+	statusFail = "fail"
+)
+
+// mergeableStatus is the detailed merge status GitLab reports when nothing blocks the merge.
+const mergeableStatus = "mergeable"
+
+// detailedMergeStatuses describes the values of `detailed_merge_status`.
+// https://docs.gitlab.com/ee/api/merge_requests.html#merge-status
+var detailedMergeStatuses = map[string]string{
+	"approvals_syncing":          "The merge request's approvals are syncing.",
+	"blocked_status":             "Blocked by another merge request.",
+	"checking":                   "Git is testing if a valid merge is possible.",
+	"ci_must_pass":               "A CI/CD pipeline must succeed before merge.",
+	"ci_still_running":           "A CI/CD pipeline is still running.",
+	"commits_status":             "The source branch should exist, and contain commits.",
+	"conflict":                   "Conflicts exist between the source and target branches.",
+	"discussions_not_resolved":   "All discussions must be resolved before merge.",
+	"draft_status":               "The merge request is a draft.",
+	"external_status_checks":     "All status checks must pass before merge.",
+	"jira_association_missing":   "The title or description must reference a Jira issue.",
+	"locked_lfs_files":           "Locked LFS files must be unlocked by their owners.",
+	"locked_paths":               "Locked paths must be unlocked by their owners.",
+	"mergeable":                  "The branch can merge cleanly into the target branch.",
+	"merge_request_blocked":      "Blocked by another merge request.",
+	"merge_time":                 "The merge request cannot be merged until the specified time.",
+	"need_rebase":                "The merge request must be rebased.",
+	"not_approved":               "Approval is required before merge.",
+	"not_open":                   "The merge request must be open before merge.",
+	"requested_changes":          "A reviewer requested changes to the merge request.",
+	"security_policy_violations": "All security policies must be satisfied.",
+	"status_checks_must_pass":    "All status checks must pass before merge.",
+	"unchecked":                  "Git has not yet tested if a valid merge is possible.",
+}
+
+type ChecksOpts struct {
+	OutputFormat string
+
+	IO *iostreams.IOStreams
+}
+
+// Check is a single mergeability condition of a merge request.
+type Check struct {
+	Name    string  `json:"name"`
+	Status  string  `json:"status"`
+	Details string  `json:"details"`
+	Items   []Check `json:"items,omitempty"`
+}
+
+// Report summarizes all mergeability conditions of a merge request.
+type Report struct {
+	IID                 int     `json:"iid"`
+	WebURL              string  `json:"web_url"`
+	DetailedMergeStatus string  `json:"detailed_merge_status"`
+	Mergeable           bool    `json:"mergeable"`
+	Checks              []Check `json:"checks"`
+}
+
+func NewCmdChecks(f *cmdutils.Factory) *cobra.Command {
+	opts := &ChecksOpts{
+		IO: f.IO,
+	}
+
+	mrChecksCmd := &cobra.Command{
+		Use:   "checks [<id> | <branch>] [flags]",
+		Short: `Show whether a merge request can be merged, and what blocks it.`,
+		Long: heredoc.Doc(`
+			Show every mergeability condition of a merge request: the merge status
+			reported by GitLab, draft state, conflicts, whether a rebase is needed,
+			unresolved threads, the jobs of the head pipeline, and approval rules.
+
+			Each condition is marked as passed or failed. The command exits with a
+			non-zero status if the merge request cannot be merged.
+		`),
+		Example: heredoc.Doc(`
+			glab mr checks 123
+			glab mr checks feature-branch --output json
+
+			# Use as a gate in scripts
+			glab mr checks 123 && glab mr merge 123 --yes
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			mr, repo, err := mrutils.MRFromArgsWithOpts(f, args, &gitlab.GetMergeRequestsOptions{
+				IncludeDivergedCommitsCount: gitlab.Ptr(true),
+				IncludeRebaseInProgress:     gitlab.Ptr(true),
+			}, "any")
+			if err != nil {
+				return err
+			}
+
+			var jobs []*gitlab.Job
+			if mr.HeadPipeline != nil {
+				jobs, err = api.GetPipelineJobs(apiClient, mr.HeadPipeline.ID, repo.FullName())
+				if err != nil {
+					return err
+				}
+			}
+
+			discussions, err := api.ListMRDiscussions(apiClient, repo.FullName(), mr.IID)
+			if err != nil {
+				return err
+			}
+
+			// Approval rules are not available on every GitLab tier. If the instance does not
+			// have them, continue without approval checks, like `mr view` does.
+			approvals, err := api.GetMRApprovalState(apiClient, repo.FullName(), mr.IID)
+			if err != nil && !api.Is404(err) {
+				return fmt.Errorf("could not get the approval state: %w", err)
+			}
+
+			report := buildReport(mr, jobs, discussions, approvals)
+
+			if opts.OutputFormat == "json" {
+				out, err := json.Marshal(report)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(opts.IO.StdOut, string(out))
+			} else {
+				printReport(opts.IO, report)
+			}
+
+			if !report.Mergeable {
+				return cmdutils.SilentError
+			}
+			return nil
+		},
+	}
+
+	mrChecksCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return mrChecksCmd
+}
+
+func buildReport(mr *gitlab.MergeRequest, jobs []*gitlab.Job, discussions []*gitlab.Discussion, approvals *gitlab.MergeRequestApprovalState) *Report {
+	report := &Report{
+		IID:                 mr.IID,
+		WebURL:              mr.WebURL,
+		DetailedMergeStatus: mr.DetailedMergeStatus,
+	}
+
+	report.Checks = append(report.Checks,
+		mergeStatusCheck(mr),
+		stateCheck(mr),
+		draftCheck(mr),
+		conflictCheck(mr),
+		rebaseCheck(mr),
+		threadsCheck(discussions),
+		pipelineCheck(mr.HeadPipeline, jobs),
+	)
+	if approvals != nil {
+		report.Checks = append(report.Checks, approvalChecks(approvals)...)
+	}
+
+	report.Mergeable = true
+	for _, check := range report.Checks {
+		if check.Status != statusPass {
+			report.Mergeable = false
+		}
+	}
+
+	return report
+}
+
+func passOrFail(pass bool) string {
+	if pass {
+		return statusPass
+	}
+	return statusFail
+}
+
+func mergeStatusCheck(mr *gitlab.MergeRequest) Check {
+	status := mr.DetailedMergeStatus
+	if status == "" {
+		// Instances older than GitLab 15.6 only report `merge_status`.
+		return Check{
+			Name:    "Merge status",
+			Status:  passOrFail(mr.MergeStatus == "can_be_merged"),
+			Details: mr.MergeStatus,
+		}
+	}
+
+	details := status
+	if description, ok := detailedMergeStatuses[status]; ok {
+		details = fmt.Sprintf("%s: %s", status, description)
+	}
+
+	return Check{
+		Name:    "Merge status",
+		Status:  passOrFail(status == mergeableStatus),
+		Details: details,
+	}
+}
+
+func stateCheck(mr *gitlab.MergeRequest) Check {
+	return Check{
+		Name:    "State",
+		Status:  passOrFail(mr.State == "opened"),
+		Details: mr.State,
+	}
+}
+
+func draftCheck(mr *gitlab.MergeRequest) Check {
+	if mr.Draft {
+		return Check{Name: "Draft", Status: statusFail, Details: "Merge request is a draft."}
+	}
+	return Check{Name: "Draft", Status: statusPass, Details: "Merge request is ready."}
+}
+
+func conflictCheck(mr *gitlab.MergeRequest) Check {
+	if mr.HasConflicts {
+		return Check{Name: "Conflicts", Status: statusFail, Details: "Merge conflicts exist."}
+	}
+	return Check{Name: "Conflicts", Status: statusPass, Details: "No merge conflicts."}
+}
+
+func rebaseCheck(mr *gitlab.MergeRequest) Check {
+	switch {
+	case mr.RebaseInProgress:
+		return Check{Name: "Rebase", Status: statusFail, Details: "Rebase in progress."}
+	case mr.DetailedMergeStatus == "need_rebase":
+		return Check{
+			Name:    "Rebase",
+			Status:  statusFail,
+			Details: fmt.Sprintf("Rebase needed, %d commits behind the target branch.", mr.DivergedCommitsCount),
+		}
+	case mr.DivergedCommitsCount > 0:
+		return Check{
+			Name:    "Rebase",
+			Status:  statusPass,
+			Details: fmt.Sprintf("Not needed, %d commits behind the target branch.", mr.DivergedCommitsCount),
+		}
+	default:
+		return Check{Name: "Rebase", Status: statusPass, Details: "Not needed."}
+	}
+}
+
+func threadsCheck(discussions []*gitlab.Discussion) Check {
+	check := Check{Name: "Threads", Status: statusPass}
+
+	for _, discussion := range discussions {
+		if len(discussion.Notes) == 0 {
+			continue
+		}
+		note := discussion.Notes[0]
+		if !note.Resolvable || note.Resolved {
+			continue
+		}
+		check.Status = statusFail
+		check.Items = append(check.Items, Check{
+			Name:    discussion.ID,
+			Status:  statusFail,
+			Details: fmt.Sprintf("Started by @%s.", note.Author.Username),
+		})
+	}
+
+	if len(check.Items) == 0 {
+		check.Details = "All threads resolved."
+	} else {
+		check.Details = fmt.Sprintf("%d unresolved threads.", len(check.Items))
+	}
+
+	return check
+}
+
+func jobPassed(job *gitlab.Job) bool {
+	switch job.Status {
+	case "success", "skipped", "manual":
+		return true
+	case "failed":
+		return job.AllowFailure
+	default:
+		return false
+	}
+}
+
+func pipelineCheck(pipeline *gitlab.Pipeline, jobs []*gitlab.Job) Check {
+	if pipeline == nil {
+		return Check{Name: "Pipeline", Status: statusPass, Details: "No pipeline."}
+	}
+
+	check := Check{
+		Name:    "Pipeline",
+		Status:  passOrFail(pipeline.Status == "success" || pipeline.Status == "skipped"),
+		Details: fmt.Sprintf("%s (#%d)", pipeline.Status, pipeline.ID),
+	}
+
+	// Jobs are listed most recent first. Keep the list in stage order, as shown in the UI.
+	sorted := make([]*gitlab.Job, len(jobs))
+	copy(sorted, jobs)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	for _, job := range sorted {
+		details := job.Status
+		if job.Status == "failed" && job.AllowFailure {
+			details += " (allowed to fail)"
+		}
+		check.Items = append(check.Items, Check{
+			Name:    fmt.Sprintf("%s/%s", job.Stage, job.Name),
+			Status:  passOrFail(jobPassed(job)),
+			Details: details,
+		})
+	}
+
+	return check
+}
+
+func approvalChecks(state *gitlab.MergeRequestApprovalState) []Check {
+	var checks []Check
+
+	for _, rule := range state.Rules {
+		approvedBy := map[string]bool{}
+		for _, user := range rule.ApprovedBy {
+			approvedBy[user.Username] = true
+		}
+
+		check := Check{
+			Name:    fmt.Sprintf("Approval rule %q", rule.Name),
+			Status:  passOrFail(rule.Approved),
+			Details: fmt.Sprintf("%d/%d approvals required.", len(rule.ApprovedBy), rule.ApprovalsRequired),
+		}
+
+		for _, user := range rule.ApprovedBy {
+			check.Items = append(check.Items, Check{Name: "@" + user.Username, Status: statusPass, Details: "approved"})
+		}
+		for _, user := range rule.EligibleApprovers {
+			if approvedBy[user.Username] {
+				continue
+			}
+			check.Items = append(check.Items, Check{Name: "@" + user.Username, Status: statusFail, Details: "not approved"})
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+func statusIcon(c *iostreams.ColorPalette, status string) string {
+	if status == statusPass {
+		return c.GreenCheck()
+	}
+	return c.FailedIcon()
+}
+
+func printReport(io *iostreams.IOStreams, report *Report) {
+	c := io.Color()
+
+	fmt.Fprintf(io.StdOut, "Checks for merge request !%d:\n", report.IID)
+	for _, check := range report.Checks {
+		fmt.Fprintf(io.StdOut, "%s %s: %s\n", statusIcon(c, check.Status), c.Bold(check.Name), check.Details)
+		for _, item := range check.Items {
+			fmt.Fprintf(io.StdOut, "    %s %s: %s\n", statusIcon(c, item.Status), item.Name, item.Details)
+		}
+	}
+
+	fmt.Fprintln(io.StdOut)
+	if report.Mergeable {
+		fmt.Fprintf(io.StdOut, "%s Merge request !%d can be merged.\n", c.GreenCheck(), report.IID)
+	} else {
+		var failed []string
+		for _, check := range report.Checks {
+			if check.Status != statusPass {
+				failed = append(failed, check.Name)
+			}
+		}
+		fmt.Fprintf(io.StdOut, "%s Merge request !%d cannot be merged. Failed checks: %s.\n",
+			c.FailedIcon(), report.IID, strings.Join(failed, ", "))
+	}
+}
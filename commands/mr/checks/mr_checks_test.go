@@ -0,0 +1,174 @@
+package checks
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdChecks(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestMrChecksMergeable(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 123,
+			"iid": 123,
+			"state": "opened",
+			"draft": false,
+			"has_conflicts": false,
+			"detailed_merge_status": "mergeable",
+			"web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/123",
+			"head_pipeline": {"id": 456, "status": "success"}
+		}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/pipelines/456/jobs`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 2, "name": "test", "stage": "test", "status": "failed", "allow_failure": true},
+			{"id": 1, "name": "build", "stage": "build", "status": "success"}
+		]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/discussions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": "abc", "notes": [{"resolvable": true, "resolved": true, "author": {"username": "foo"}}]},
+			{"id": "def", "notes": [{"resolvable": false, "author": {"username": "bar"}}]}
+		]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/approval_state`,
+		httpmock.NewFileResponse(http.StatusOK, "./testdata/approvalState.json"))
+
+	output, err := runCommand(fakeHTTP, "123")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Checks for merge request !123:
+		✓ Merge status: mergeable: The branch can merge cleanly into the target branch.
+		✓ State: opened
+		✓ Draft: Merge request is ready.
+		✓ Conflicts: No merge conflicts.
+		✓ Rebase: Not needed.
+		✓ Threads: All threads resolved.
+		✓ Pipeline: success (#456)
+		    ✓ build/build: success
+		    ✓ test/test: failed (allowed to fail)
+		✓ Approval rule "All Members": 1/1 approvals required.
+		    ✓ @foo_reviewer: approved
+		    x @approver_1: not approved
+		    x @approver_2: not approved
+
+		✓ Merge request !123 can be merged.
+	`), output.String())
+	assert.Empty(t, output.Stderr())
+}
+
+func TestMrChecksBlocked(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 123,
+			"iid": 123,
+			"state": "opened",
+			"draft": true,
+			"has_conflicts": true,
+			"diverged_commits_count": 3,
+			"detailed_merge_status": "need_rebase",
+			"web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/123"
+		}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/discussions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": "abc", "notes": [{"resolvable": true, "resolved": false, "author": {"username": "foo"}}]}
+		]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/approval_state`,
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not Found"}`))
+
+	output, err := runCommand(fakeHTTP, "123 --output json")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(output.OutBuf.Bytes(), &report))
+
+	assert.False(t, report.Mergeable)
+	assert.Equal(t, "need_rebase", report.DetailedMergeStatus)
+
+	statuses := map[string]string{}
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	assert.Equal(t, map[string]string{
+		"Merge status": statusFail,
+		"State":        statusPass,
+		"Draft":        statusFail,
+		"Conflicts":    statusFail,
+		"Rebase":       statusFail,
+		"Threads":      statusFail,
+		"Pipeline":     statusPass,
+	}, statuses)
+}
+
+func TestMrChecksApprovalStateError(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "state": "opened"}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/discussions`,
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/approval_state`,
+		httpmock.NewStringResponse(http.StatusUnauthorized, `{"message": "401 Unauthorized"}`))
+
+	_, err := runCommand(fakeHTTP, "123")
+	require.ErrorContains(t, err, "could not get the approval state: ")
+}
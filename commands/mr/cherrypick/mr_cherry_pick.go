@@ -0,0 +1,68 @@
+package cherrypick
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/commit/commitutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+)
+
+func NewCmdCherryPick(f *cmdutils.Factory) *cobra.Command {
+	opts := &commitutils.ApplyOptions{
+		Operation: commitutils.CherryPick,
+		IO:        f.IO,
+	}
+
+	mrCherryPickCmd := &cobra.Command{
+		Use:   "cherry-pick [<id> | <branch>] --target <branch> [flags]",
+		Short: `Cherry-pick a merged merge request onto another branch.`,
+		Long: heredoc.Doc(`
+			Cherry-pick the changes of a merged merge request onto another branch, through the API.
+
+			The merge commit or squash commit of the merge request is cherry-picked. If the
+			merge request was merged without one, each of its commits is cherry-picked in order.
+			Several commits are first applied to a temporary branch, which is deleted afterwards,
+			so a conflict leaves the target branch unchanged.
+
+			Use --create-mr to cherry-pick onto a new branch, and open a merge request into the
+			target branch, with the labels of the original merge request and a reference to it.
+		`),
+		Example: heredoc.Doc(`
+			glab mr cherry-pick 123 --target stable-16-0
+			glab mr cherry-pick 123 --target stable-16-0 --create-mr
+
+			# Check for conflicts without changing anything
+			glab mr cherry-pick 123 --target stable-16-0 --dry-run
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+			if err != nil {
+				return err
+			}
+
+			opts.Commits, err = commitutils.MRCommits(apiClient, repo, mr, opts.Operation)
+			if err != nil {
+				return err
+			}
+			opts.SourceMR = mr
+
+			return commitutils.Apply(apiClient, repo, opts)
+		},
+	}
+
+	mrCherryPickCmd.Flags().StringVarP(&opts.TargetBranch, "target", "t", "", "Branch to cherry-pick onto.")
+	mrCherryPickCmd.Flags().BoolVar(&opts.CreateMR, "create-mr", false, "Cherry-pick onto a new branch, and create a merge request into the target branch.")
+	mrCherryPickCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only check whether the changes apply without conflicts.")
+	_ = mrCherryPickCmd.MarkFlagRequired("target")
+	mrCherryPickCmd.MarkFlagsMutuallyExclusive("create-mr", "dry-run")
+
+	return mrCherryPickCmd
+}
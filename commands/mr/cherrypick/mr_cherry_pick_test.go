@@ -0,0 +1,134 @@
+package cherrypick
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdCherryPick(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+// jsonBody records the JSON body of a request before responding.
+func jsonBody(body *map[string]any, resp httpmock.Responder) httpmock.Responder {
+	return func(req *http.Request) (*http.Response, error) {
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, body); err != nil {
+			return nil, err
+		}
+		return resp(req)
+	}
+}
+
+func TestMrCherryPickCreateMR(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 123,
+			"iid": 123,
+			"title": "Fix crash",
+			"state": "merged",
+			"labels": ["bug", "backport"],
+			"merge_commit_sha": "1a2b3c4d5e6f",
+			"references": {"full": "OWNER/REPO!123"}
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/1a2b3c4d5e6f`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": "1a2b3c4d5e6f", "title": "Merge branch 'fix-crash' into 'main'"}`))
+
+	var branchBody, pickBody, mrBody map[string]any
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/branches`,
+		jsonBody(&branchBody, httpmock.NewStringResponse(http.StatusCreated, `{"name": "cherry-pick-1a2b3c4d-stable"}`)))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/1a2b3c4d5e6f/cherry_pick`,
+		jsonBody(&pickBody, httpmock.NewStringResponse(http.StatusCreated, `{"id": "9f8e7d6c5b4a"}`)))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/merge_requests`,
+		jsonBody(&mrBody, httpmock.NewStringResponse(http.StatusCreated, `{"iid": 124, "web_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/124"}`)))
+
+	output, err := runCommand(fakeHTTP, "123 --target stable --create-mr")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ Created branch cherry-pick-1a2b3c4d-stable from stable.
+		✓ Cherry-picked 1a2b3c4d onto cherry-pick-1a2b3c4d-stable as 9f8e7d6c.
+		✓ Created merge request !124.
+		https://gitlab.com/OWNER/REPO/-/merge_requests/124
+	`), output.String())
+
+	assert.Equal(t, map[string]any{"branch": "cherry-pick-1a2b3c4d-stable", "ref": "stable"}, branchBody)
+	assert.Equal(t, map[string]any{"branch": "cherry-pick-1a2b3c4d-stable"}, pickBody)
+	assert.Equal(t, `Cherry-pick "Fix crash" into stable`, mrBody["title"])
+	assert.Equal(t, "Cherry-picked from OWNER/REPO!123.\n\n- 1a2b3c4d5e6f Merge branch 'fix-crash' into 'main'\n", mrBody["description"])
+	assert.Equal(t, "bug,backport", mrBody["labels"])
+	assert.Equal(t, "cherry-pick-1a2b3c4d-stable", mrBody["source_branch"])
+	assert.Equal(t, "stable", mrBody["target_branch"])
+}
+
+func TestMrCherryPickNotMerged(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "state": "opened"}`))
+
+	_, err := runCommand(fakeHTTP, "123 --target stable")
+	require.Error(t, err)
+	assert.Equal(t, "merge request !123 is opened. Only merged merge requests can be cherry-picked.", err.Error())
+}
+
+func TestMrCherryPickRequiresTarget(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(fakeHTTP, "123")
+	require.Error(t, err)
+	assert.Equal(t, `required flag(s) "target" not set`, err.Error())
+}
@@ -1,6 +1,7 @@
 package create
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -32,6 +33,7 @@ import (
 type CreateOpts struct {
 	Title                 string   `json:"title,omitempty"`
 	Description           string   `json:"description,omitempty"`
+	Template              string   `json:"template,omitempty"`
 	SourceBranch          string   `json:"source_branch,omitempty"`
 	TargetBranch          string   `json:"target_branch,omitempty"`
 	TargetTrackingBranch  string   `json:"target_tracking_branch,omitempty"`
@@ -62,6 +64,9 @@ type CreateOpts struct {
 	Web           bool `json:"-"`
 	Recover       bool `json:"-"`
 	Signoff       bool `json:"-"`
+	DryRun        bool `json:"-"`
+
+	OutputFormat string `json:"-"`
 
 	IO       *iostreams.IOStreams             `json:"-"`
 	Branch   func() (string, error)           `json:"-"`
@@ -89,9 +94,19 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	}
 
 	mrCreateCmd := &cobra.Command{
-		Use:     "create",
-		Short:   `Create a new merge request.`,
-		Long:    ``,
+		Use:   "create",
+		Short: `Create a new merge request.`,
+		Long: heredoc.Doc(`
+			Create a new merge request.
+
+			Use --template to fill the description from a template in '.gitlab/merge_request_templates'.
+			These placeholders are replaced:
+
+			- %{source_branch}, %{target_branch}: the branches of the merge request.
+			- %{all_commits}: the messages of all commits in the merge request.
+			- %{first_commit}: the message of the first commit.
+			- %{linked_issues}: the related issue, or the issue the source branch is named after.
+		`),
 		Aliases: []string{"new"},
 		Example: heredoc.Doc(`
 			glab mr new
@@ -99,6 +114,8 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			glab mr create -f --draft --label RFC
 			glab mr create --fill --web
 			glab mr create --fill --fill-commit-body --yes
+			glab mr create --title "Fix crash" --template Default --dry-run
+			glab mr create --title "Fix crash" --template Default --yes --output json
 		`),
 		Args: cobra.ExactArgs(0),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -120,7 +137,7 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 			opts.Lab = f.HttpClient
 
 			hasTitle := cmd.Flags().Changed("title")
-			hasDescription := cmd.Flags().Changed("description")
+			hasDescription := cmd.Flags().Changed("description") || cmd.Flags().Changed("template")
 
 			// disable interactive mode if title and description are explicitly defined
 			opts.IsInteractive = !(hasTitle && hasDescription)
@@ -188,6 +205,12 @@ func NewCmdCreate(f *cmdutils.Factory) *cobra.Command {
 	mrCreateCmd.Flags().BoolVar(&opts.Recover, "recover", false, "Save the options to a file if the merge request creation fails. If the file exists, the options are loaded from the recovery file. (EXPERIMENTAL.)")
 	mrCreateCmd.Flags().BoolVar(&opts.Signoff, "signoff", false, "Append a DCO signoff to the merge request description.")
 
+	mrCreateCmd.Flags().StringVar(&opts.Template, "template", "", "Use a template from '.gitlab/merge_request_templates' as the description, filling its %{variables} from git.")
+	mrCreateCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the merge request that would be created, without creating it or pushing.")
+	mrCreateCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("dry-run", "web")
+
 	mrCreateCmd.Flags().StringVarP(&opts.MRCreateTargetProject, "target-project", "", "", "Add target project by id, OWNER/REPO, or GROUP/NAMESPACE/REPO.")
 	_ = mrCreateCmd.Flags().MarkHidden("target-project")
 	_ = mrCreateCmd.Flags().MarkDeprecated("target-project", "Use --repo instead.")
@@ -325,8 +348,6 @@ func createRun(opts *CreateOpts) error {
 			mrCreateOpts.Labels = (*gitlab.LabelOptions)(&issue.Labels)
 		}
 
-		opts.Description += fmt.Sprintf("\n\nCloses #%d", issue.IID)
-
 		if opts.Title == "" {
 			opts.Title = fmt.Sprintf("Resolve \"%s\"", issue.Title)
 		}
@@ -343,15 +364,25 @@ func createRun(opts *CreateOpts) error {
 				Ref:    &opts.TargetBranch,
 			}
 
-			_, err = api.CreateBranch(labClient, baseRepo.FullName(), branchOpts)
-			if err != nil {
-				for branchErr, branchCount := err, 1; branchErr != nil; branchCount++ {
-					sourceBranch = fmt.Sprintf("%d-%s-%d", issue.IID, strings.ReplaceAll(strings.ToLower(issue.Title), " ", "-"), branchCount)
-					_, branchErr = api.CreateBranch(labClient, baseRepo.FullName(), branchOpts)
+			if !opts.DryRun {
+				_, err = api.CreateBranch(labClient, baseRepo.FullName(), branchOpts)
+				if err != nil {
+					for branchErr, branchCount := err, 1; branchErr != nil; branchCount++ {
+						sourceBranch = fmt.Sprintf("%d-%s-%d", issue.IID, strings.ReplaceAll(strings.ToLower(issue.Title), " ", "-"), branchCount)
+						_, branchErr = api.CreateBranch(labClient, baseRepo.FullName(), branchOpts)
+					}
 				}
 			}
 			opts.SourceBranch = sourceBranch
 		}
+
+		if opts.Template != "" {
+			if err := applyTemplate(opts, fmt.Sprintf("#%d", issue.IID)); err != nil {
+				return err
+			}
+		}
+
+		opts.Description += fmt.Sprintf("\n\nCloses #%d", issue.IID)
 	} else {
 		opts.TargetTrackingBranch = fmt.Sprintf("%s/%s", baseRepoRemote.Name, opts.TargetBranch)
 		if opts.SourceBranch == opts.TargetBranch && glrepo.IsSame(baseRepo, headRepo) {
@@ -359,6 +390,12 @@ func createRun(opts *CreateOpts) error {
 			return cmdutils.SilentError
 		}
 
+		if opts.Template != "" {
+			if err := applyTemplate(opts, issueFromBranch(opts.SourceBranch)); err != nil {
+				return err
+			}
+		}
+
 		if opts.Autofill {
 			if err = mrBodyAndTitle(opts); err != nil {
 				return err
@@ -496,7 +533,7 @@ func createRun(opts *CreateOpts) error {
 		mrCreateOpts.TargetProjectID = &opts.TargetProject.ID
 	}
 
-	if opts.CreateSourceBranch {
+	if opts.CreateSourceBranch && !opts.DryRun {
 		lb := &gitlab.CreateBranchOptions{
 			Branch: &opts.SourceBranch,
 			Ref:    &opts.TargetBranch,
@@ -513,7 +550,7 @@ func createRun(opts *CreateOpts) error {
 	var action cmdutils.Action
 
 	// submit without prompting for non interactive mode
-	if !opts.IsInteractive || opts.Yes {
+	if !opts.IsInteractive || opts.Yes || opts.DryRun {
 		action = cmdutils.SubmitAction
 	}
 
@@ -613,6 +650,10 @@ func createRun(opts *CreateOpts) error {
 		return nil
 	}
 
+	if opts.DryRun {
+		return printDryRun(opts, mrCreateOpts)
+	}
+
 	if err := handlePush(opts, headRepoRemote); err != nil {
 		return err
 	}
@@ -636,6 +677,12 @@ func createRun(opts *CreateOpts) error {
 			return err
 		}
 
+		if opts.OutputFormat == "json" {
+			mrJSON, _ := json.Marshal(mr)
+			fmt.Fprintln(out, string(mrJSON))
+			return nil
+		}
+
 		fmt.Fprintln(out, mrutils.DisplayMR(c, mr, opts.IO.IsaTTY))
 		return nil
 	}
@@ -697,6 +744,89 @@ func mrBodyAndTitle(opts *CreateOpts) error {
 	return nil
 }
 
+var branchIssueRE = regexp.MustCompile(`^(\d+)-`)
+
+// issueFromBranch returns the reference of the issue a branch is named after,
+// like GitLab does for branches created from an issue, such as 123-fix-crash.
+func issueFromBranch(branch string) string {
+	if m := branchIssueRE.FindStringSubmatch(branch); m != nil {
+		return "#" + m[1]
+	}
+	return ""
+}
+
+// applyTemplate renders the template selected with --template as the description.
+func applyTemplate(opts *CreateOpts, linkedIssues string) error {
+	tmpl, err := cmdutils.FindGitLabTemplate(cmdutils.MergeRequestTemplate, opts.Template)
+	if err != nil {
+		return err
+	}
+
+	vars := map[string]string{
+		"source_branch": opts.SourceBranch,
+		"target_branch": opts.TargetBranch,
+		"linked_issues": linkedIssues,
+		"all_commits":   "",
+		"first_commit":  "",
+	}
+
+	// Branches created for a related issue do not exist locally and have no commits yet.
+	if opts.TargetTrackingBranch != "" {
+		commits, err := git.Commits(opts.TargetTrackingBranch, opts.SourceBranch)
+		if err != nil {
+			return fmt.Errorf("failed to get commits: %w", err)
+		}
+		if len(commits) > 0 {
+			if vars["all_commits"], err = mrBody(commits, true); err != nil {
+				return err
+			}
+			// Commits are listed from the most recent.
+			first := commits[len(commits)-1]
+			body, err := git.CommitBody(first.Sha)
+			if err != nil {
+				return err
+			}
+			vars["first_commit"] = strings.TrimSpace(first.Title + "\n\n" + body)
+		}
+	}
+
+	opts.Description = cmdutils.RenderGitLabTemplate(tmpl, vars)
+	return nil
+}
+
+// printDryRun prints the merge request that would be created.
+func printDryRun(opts *CreateOpts, mrCreateOpts *gitlab.CreateMergeRequestOptions) error {
+	out := opts.IO.StdOut
+
+	if opts.OutputFormat == "json" {
+		optsJSON, err := json.Marshal(mrCreateOpts)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(optsJSON))
+		return nil
+	}
+
+	c := opts.IO.Color()
+	fmt.Fprintf(out, "%s %s\n", c.Bold("Title:"), opts.Title)
+	fmt.Fprintf(out, "%s %s\n", c.Bold("Source branch:"), opts.SourceBranch)
+	fmt.Fprintf(out, "%s %s\n", c.Bold("Target branch:"), opts.TargetBranch)
+	if mrCreateOpts.Labels != nil && len(*mrCreateOpts.Labels) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Labels:"), strings.Join(*mrCreateOpts.Labels, ", "))
+	}
+	if len(opts.Assignees) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Assignees:"), strings.Join(opts.Assignees, ", "))
+	}
+	if len(opts.Reviewers) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Reviewers:"), strings.Join(opts.Reviewers, ", "))
+	}
+	if opts.MilestoneFlag != "" {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Milestone:"), opts.MilestoneFlag)
+	}
+	fmt.Fprintf(out, "\n%s\n", opts.Description)
+	return nil
+}
+
 func handlePush(opts *CreateOpts, remote *glrepo.Remote) error {
 	if opts.ShouldPush {
 		sourceRemote := remote
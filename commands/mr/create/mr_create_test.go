@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -650,3 +652,102 @@ func Test_MRCreate_With_Recover_Integration(t *testing.T) {
 	assert.Contains(t, newOutput.Stderr(), "\nCreating merge request for feat-new-mr into master in OWNER/REPO\n\n")
 	assert.Contains(t, newOutput.String(), "https://gitlab.com/OWNER/REPO/-/merge_requests/12")
 }
+
+func TestNewCmdCreate_TemplateDryRun(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/projects/OWNER/REPO",
+		httpmock.NewStringResponse(http.StatusOK, `
+			{
+				"id": 1,
+				"description": null,
+				"default_branch": "main",
+				"web_url": "http://gitlab.com/OWNER/REPO",
+				"name": "OWNER",
+				"path": "REPO",
+				"merge_requests_enabled": true,
+				"path_with_namespace": "OWNER/REPO"
+			}
+		`),
+	)
+
+	tmplDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmplDir, ".gitlab", "merge_request_templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmplDir, ".gitlab", "merge_request_templates", "Release.md"), []byte(heredoc.Doc(`
+		Merges %{source_branch} into %{target_branch}.
+
+		%{all_commits}
+		First: %{first_commit}
+
+		Closes %{linked_issues}
+	`)), 0o644))
+
+	oldToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return tmplDir, nil }
+	t.Cleanup(func() { git.ToplevelDir = oldToplevelDir })
+
+	cs, csTeardown := test.InitCmdStubber()
+	defer csTeardown()
+
+	// git -c log.ShowSignature=false log --pretty=format:%H,%s --cherry upstream/main...42-fix-crash
+	cs.Stub(heredoc.Doc(`
+			deadb00f,commit msg 2
+			deadbeef,commit msg 1
+		`))
+	cs.Stub("")            // git show -s --pretty=format:%b deadbeef
+	cs.Stub("commit body") // git show -s --pretty=format:%b deadb00f
+	cs.Stub("")            // git show -s --pretty=format:%b deadbeef
+
+	cli := "--title mr-title --template Release --target-branch main --dry-run"
+
+	output, err := runCommand(fakeHTTP, "42-fix-crash", true, cli, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Title: mr-title
+		Source branch: 42-fix-crash
+		Target branch: main
+
+		Merges 42-fix-crash into main.
+
+		- commit msg 1  
+
+		- commit msg 2  
+		commit body
+
+		First: commit msg 1
+
+		Closes #42
+	`), output.String())
+	assert.Empty(t, output.Stderr())
+}
+
+func TestNewCmdCreate_TemplateNotFound(t *testing.T) {
+	fakeHTTP := httpmock.New()
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/projects/OWNER/REPO",
+		httpmock.NewStringResponse(http.StatusOK, `
+			{
+				"id": 1,
+				"default_branch": "main",
+				"merge_requests_enabled": true,
+				"path_with_namespace": "OWNER/REPO"
+			}
+		`),
+	)
+
+	oldToplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return t.TempDir(), nil }
+	t.Cleanup(func() { git.ToplevelDir = oldToplevelDir })
+
+	_, err := runCommand(fakeHTTP, "feat", true, "--title mr-title --template Missing --target-branch main --dry-run", false)
+	assert.EqualError(t, err, `template "Missing" not found: no templates in .gitlab/merge_request_templates.`)
+}
+
+func TestNewCmdCreate_TemplateAndDescription(t *testing.T) {
+	fakeHTTP := httpmock.New()
+
+	_, err := runCommand(fakeHTTP, "feat", true, "--title mr-title --template Default --description body", false)
+	assert.EqualError(t, err, "if any flags in the group [template description] are set none of the others can be; [description template] were all set")
+}
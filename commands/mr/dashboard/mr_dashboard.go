@@ -0,0 +1,531 @@
+package dashboard
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	mrCheckoutCmd "gitlab.com/gitlab-org/cli/commands/mr/checkout"
+	mrDiffCmd "gitlab.com/gitlab-org/cli/commands/mr/diff"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+const (
+	roleAuthor   = "author"
+	roleAssignee = "assignee"
+	roleReviewer = "reviewer"
+)
+
+// maxConcurrentRequests bounds the number of merge requests whose details are fetched at once.
+const maxConcurrentRequests = 5
+
+type DashboardOpts struct {
+	Group           string
+	PerPage         int
+	RefreshInterval time.Duration
+
+	factory   *cmdutils.Factory
+	apiClient *gitlab.Client
+}
+
+// dashboardMR is a merge request shown on the dashboard, with the details
+// that the list endpoints do not return.
+type dashboardMR struct {
+	mr                *gitlab.MergeRequest
+	roles             []string
+	pipelineStatus    string
+	approvalsGiven    int
+	approvalsRequired int
+	unresolvedThreads int
+}
+
+func NewCmdDashboard(f *cmdutils.Factory) *cobra.Command {
+	opts := &DashboardOpts{
+		factory: f,
+	}
+
+	mrDashboardCmd := &cobra.Command{
+		Use:   "dashboard [flags]",
+		Short: `Interactive dashboard of your merge requests.`,
+		Long: heredoc.Doc(`
+			Open an interactive dashboard of open merge requests where you are the
+			author, an assignee, or a reviewer, across all projects or a single group.
+
+			For each merge request, the dashboard shows the status of the head pipeline,
+			the approvals given and required, and the number of unresolved threads.
+			The dashboard refreshes periodically.
+
+			Key bindings:
+
+			- Up/Down, j/k: select a merge request.
+			- Enter, d: view the diff.
+			- c: check out the merge request branch. Only for the current repository.
+			- a: approve.
+			- m: merge.
+			- o: open in the browser.
+			- r: refresh now.
+			- q, Esc: quit.
+		`),
+		Example: heredoc.Doc(`
+			glab mr dashboard
+			glab mr dashboard --group gitlab-org --refresh 1m
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !f.IO.IsOutputTTY() {
+				return fmt.Errorf("the dashboard requires an interactive terminal.")
+			}
+			if opts.RefreshInterval < 5*time.Second {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--refresh must be at least 5s.")}
+			}
+
+			var err error
+			opts.apiClient, err = f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			user, err := api.CurrentUser(opts.apiClient)
+			if err != nil {
+				return err
+			}
+
+			return drawDashboard(opts, user)
+		},
+	}
+
+	mrDashboardCmd.Flags().StringVarP(&opts.Group, "group", "g", "", "Only show merge requests of projects in this group.")
+	mrDashboardCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", api.DefaultListLimit, "Maximum number of merge requests to fetch for each role.")
+	mrDashboardCmd.Flags().DurationVar(&opts.RefreshInterval, "refresh", 30*time.Second, "Time between automatic refreshes.")
+
+	return mrDashboardCmd
+}
+
+// fetchDashboard lists the open merge requests of the user for every role, and fetches their details.
+func fetchDashboard(opts *DashboardOpts, user *gitlab.User) ([]*dashboardMR, error) {
+	queries := []struct {
+		role     string
+		scope    string
+		reviewer *string
+	}{
+		{role: roleAuthor, scope: "created_by_me"},
+		{role: roleAssignee, scope: "assigned_to_me"},
+		{role: roleReviewer, scope: "all", reviewer: gitlab.Ptr(user.Username)},
+	}
+
+	byID := map[int]*dashboardMR{}
+	var items []*dashboardMR
+	for _, q := range queries {
+		var mrs []*gitlab.MergeRequest
+		var err error
+		listOpts := gitlab.ListOptions{PerPage: opts.PerPage}
+		if opts.Group != "" {
+			mrs, err = api.ListGroupMRs(opts.apiClient, opts.Group, &gitlab.ListGroupMergeRequestsOptions{
+				ListOptions:      listOpts,
+				State:            gitlab.Ptr("opened"),
+				Scope:            gitlab.Ptr(q.scope),
+				ReviewerUsername: q.reviewer,
+			})
+		} else {
+			mrs, err = api.ListAllMRs(opts.apiClient, &gitlab.ListMergeRequestsOptions{
+				ListOptions:      listOpts,
+				State:            gitlab.Ptr("opened"),
+				Scope:            gitlab.Ptr(q.scope),
+				ReviewerUsername: q.reviewer,
+			})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("listing merge requests as %s: %w", q.role, err)
+		}
+
+		for _, mr := range mrs {
+			if item, ok := byID[mr.ID]; ok {
+				item.roles = append(item.roles, q.role)
+				continue
+			}
+			item := &dashboardMR{mr: mr, roles: []string{q.role}}
+			byID[mr.ID] = item
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i].mr.UpdatedAt, items[j].mr.UpdatedAt
+		return a != nil && (b == nil || a.After(*b))
+	})
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	sem := make(chan struct{}, maxConcurrentRequests)
+	for _, item := range items {
+		wg.Add(1)
+		go func(item *dashboardMR) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := fetchDetails(opts.apiClient, item); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return items, nil
+}
+
+func fetchDetails(client *gitlab.Client, item *dashboardMR) error {
+	// The list endpoints do not return the head pipeline.
+	mr, err := api.GetMR(client, item.mr.ProjectID, item.mr.IID, &gitlab.GetMergeRequestsOptions{})
+	if err != nil {
+		return err
+	}
+	item.mr = mr
+	if mr.HeadPipeline != nil {
+		item.pipelineStatus = mr.HeadPipeline.Status
+	}
+
+	discussions, err := api.ListMRDiscussions(client, mr.ProjectID, mr.IID)
+	if err != nil {
+		return err
+	}
+	item.unresolvedThreads = countUnresolvedThreads(discussions)
+
+	// Approvals are not available on every instance. Show the merge request without them.
+	if approvals, err := api.GetMRApprovals(client, mr.ProjectID, mr.IID); err == nil {
+		item.approvalsGiven = len(approvals.ApprovedBy)
+		item.approvalsRequired = approvals.ApprovalsRequired
+	}
+
+	return nil
+}
+
+func countUnresolvedThreads(discussions []*gitlab.Discussion) int {
+	count := 0
+	for _, d := range discussions {
+		if len(d.Notes) > 0 && d.Notes[0].Resolvable && !d.Notes[0].Resolved {
+			count++
+		}
+	}
+	return count
+}
+
+// projectPath returns the path of the project of a merge request, from its full reference.
+func projectPath(mr *gitlab.MergeRequest) string {
+	if mr.References == nil {
+		return ""
+	}
+	path, _, _ := strings.Cut(mr.References.Full, "!")
+	return path
+}
+
+// reference returns the full reference of a merge request, like "group/project!123".
+func reference(mr *gitlab.MergeRequest) string {
+	if mr.References == nil {
+		return fmt.Sprintf("!%d", mr.IID)
+	}
+	return mr.References.Full
+}
+
+var pipelineStatusColors = map[string]string{
+	"success":  "green",
+	"failed":   "red",
+	"canceled": "gray",
+	"skipped":  "gray",
+	"running":  "blue",
+	"pending":  "yellow",
+	"manual":   "yellow",
+}
+
+var dashboardHeader = []string{"MR", "TITLE", "ROLE", "PIPELINE", "APPROVALS", "THREADS", "UPDATED"}
+
+// dashboardCells returns the table cells of a merge request, with tview color tags.
+func dashboardCells(item *dashboardMR) []string {
+	mr := item.mr
+
+	title := tview.Escape(mr.Title)
+	if mr.Draft {
+		title = "[gray]Draft:[-] " + title
+	}
+
+	pipeline := "[gray]none[-]"
+	if item.pipelineStatus != "" {
+		color, ok := pipelineStatusColors[item.pipelineStatus]
+		if !ok {
+			color = "white"
+		}
+		pipeline = fmt.Sprintf("[%s]%s[-]", color, item.pipelineStatus)
+	}
+
+	approvals := fmt.Sprintf("%d", item.approvalsGiven)
+	if item.approvalsRequired > 0 {
+		approvals = fmt.Sprintf("%d/%d", item.approvalsGiven, item.approvalsRequired)
+		if item.approvalsGiven >= item.approvalsRequired {
+			approvals = "[green]" + approvals + "[-]"
+		}
+	}
+
+	threads := "[green]0[-]"
+	if item.unresolvedThreads > 0 {
+		threads = fmt.Sprintf("[yellow]%d[-]", item.unresolvedThreads)
+	}
+
+	updated := ""
+	if mr.UpdatedAt != nil {
+		updated = utils.TimeToPrettyTimeAgo(*mr.UpdatedAt)
+	}
+
+	return []string{
+		"[green]" + tview.Escape(reference(mr)) + "[-]",
+		title,
+		strings.Join(item.roles, ", "),
+		pipeline,
+		approvals,
+		threads,
+		"[gray]" + updated + "[-]",
+	}
+}
+
+func drawDashboard(opts *DashboardOpts, user *gitlab.User) error {
+	app := tview.NewApplication()
+	defer recoverPanic(app)
+
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.SetBackgroundColor(tcell.ColorDefault)
+
+	status := tview.NewTextView().SetDynamicColors(true)
+	status.SetBackgroundColor(tcell.ColorDefault)
+
+	scope := "all projects"
+	if opts.Group != "" {
+		scope = "group " + opts.Group
+	}
+
+	root := tview.NewPages()
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(status, 1, 0, false)
+	layout.
+		SetBackgroundColor(tcell.ColorDefault).
+		SetBorderPadding(0, 0, 1, 1).
+		SetBorder(true).
+		SetTitle(fmt.Sprintf(" Merge requests of @%s • %s ", user.Username, scope))
+	root.AddPage("dashboard", layout, true, true)
+
+	var (
+		mu        sync.Mutex
+		items     []*dashboardMR
+		refreshCh = make(chan struct{}, 1)
+	)
+
+	const help = "[gray]enter/d[-] diff  [gray]c[-] checkout  [gray]a[-] approve  [gray]m[-] merge  [gray]o[-] browser  [gray]r[-] refresh  [gray]q[-] quit"
+	setStatus := func(message string) {
+		status.SetText(message + "  " + help)
+	}
+
+	render := func(newItems []*dashboardMR) {
+		mu.Lock()
+		items = newItems
+		mu.Unlock()
+
+		row, _ := table.GetSelection()
+		table.Clear()
+		for col, h := range dashboardHeader {
+			table.SetCell(0, col, tview.NewTableCell(h).
+				SetAttributes(tcell.AttrBold).
+				SetSelectable(false))
+		}
+		for i, item := range newItems {
+			for col, text := range dashboardCells(item) {
+				cell := tview.NewTableCell(text)
+				if col == 1 {
+					cell.SetExpansion(1).SetMaxWidth(60)
+				}
+				table.SetCell(i+1, col, cell)
+			}
+		}
+		if row < 1 {
+			row = 1
+		}
+		if row > len(newItems) {
+			row = len(newItems)
+		}
+		table.Select(row, 0)
+	}
+
+	selected := func() *dashboardMR {
+		mu.Lock()
+		defer mu.Unlock()
+		row, _ := table.GetSelection()
+		if row < 1 || row > len(items) {
+			return nil
+		}
+		return items[row-1]
+	}
+
+	requestRefresh := func() {
+		select {
+		case refreshCh <- struct{}{}:
+		default:
+		}
+	}
+
+	go func() {
+		defer recoverPanic(app)
+		for {
+			app.QueueUpdateDraw(func() { setStatus("[yellow]Refreshing…[-]") })
+			newItems, err := fetchDashboard(opts, user)
+			app.QueueUpdateDraw(func() {
+				if err != nil {
+					setStatus(fmt.Sprintf("[red]Failed to refresh: %s[-]", tview.Escape(err.Error())))
+					return
+				}
+				render(newItems)
+				setStatus(fmt.Sprintf("[gray]%d merge requests, updated %s.[-]", len(newItems), time.Now().Format("15:04:05")))
+			})
+			select {
+			case <-refreshCh:
+			case <-time.After(opts.RefreshInterval):
+			}
+		}
+	}()
+
+	confirm := func(question string, action func() error, done string) {
+		modal := tview.NewModal().
+			SetBackgroundColor(tcell.ColorDefault).
+			SetText(question).
+			AddButtons([]string{"✘ No", "✔ Yes"}).
+			SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+				root.RemovePage("yesno")
+				app.SetFocus(table)
+				if buttonLabel != "✔ Yes" {
+					return
+				}
+				if err := action(); err != nil {
+					setStatus(fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error())))
+					return
+				}
+				setStatus("[green]" + done + "[-]")
+				requestRefresh()
+			})
+		root.AddPage("yesno", modal, false, true)
+		app.SetFocus(modal)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+			app.Stop()
+			return nil
+		}
+		if event.Rune() == 'r' {
+			requestRefresh()
+			return nil
+		}
+
+		item := selected()
+		if item == nil {
+			return event
+		}
+		mr := item.mr
+
+		switch {
+		case event.Key() == tcell.KeyEnter || event.Rune() == 'd':
+			app.Suspend(func() {
+				runSubcommand(opts.factory, projectPath(mr), mr.IID, func(f *cmdutils.Factory) *cobra.Command {
+					return mrDiffCmd.NewCmdDiff(f, nil)
+				})
+			})
+			return nil
+		case event.Rune() == 'c':
+			baseRepo, err := opts.factory.BaseRepo()
+			mrRepo, _ := glrepo.FromFullName(projectPath(mr))
+			if err != nil || mrRepo == nil || !glrepo.IsSame(baseRepo, mrRepo) {
+				setStatus("[yellow]Checkout is only available for merge requests of the current repository.[-]")
+				return nil
+			}
+			app.Suspend(func() {
+				runSubcommand(opts.factory, projectPath(mr), mr.IID, mrCheckoutCmd.NewCmdCheckout)
+			})
+			return nil
+		case event.Rune() == 'a':
+			confirm(fmt.Sprintf("Approve %s?", reference(mr)), func() error {
+				_, err := api.ApproveMR(opts.apiClient, mr.ProjectID, mr.IID, &gitlab.ApproveMergeRequestOptions{})
+				return err
+			}, fmt.Sprintf("Approved %s.", reference(mr)))
+			return nil
+		case event.Rune() == 'm':
+			confirm(fmt.Sprintf("Merge %s?", reference(mr)), func() error {
+				_, _, err := api.MergeMR(opts.apiClient, mr.ProjectID, mr.IID, &gitlab.AcceptMergeRequestOptions{})
+				return err
+			}, fmt.Sprintf("Merged %s.", reference(mr)))
+			return nil
+		case event.Rune() == 'o':
+			browser := ""
+			if cfg, err := opts.factory.Config(); err == nil {
+				browser, _ = cfg.Get(opts.apiClient.BaseURL().Host, "browser")
+			}
+			if err := utils.OpenInBrowser(mr.WebURL, browser); err != nil {
+				setStatus(fmt.Sprintf("[red]%s[-]", tview.Escape(err.Error())))
+			}
+			return nil
+		}
+		return event
+	})
+
+	screen, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	return app.SetScreen(screen).SetRoot(root, true).SetFocus(table).Run()
+}
+
+// runSubcommand runs an `mr` subcommand against the project of a merge request, then waits for
+// the user to return to the dashboard.
+func runSubcommand(f *cmdutils.Factory, project string, iid int, newCmd func(*cmdutils.Factory) *cobra.Command) {
+	subFactory := *f
+	subFactory.BaseRepo = func() (glrepo.Interface, error) {
+		return glrepo.FromFullName(project)
+	}
+
+	cmd := newCmd(&subFactory)
+	cmd.SetArgs([]string{strconv.Itoa(iid)})
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(f.IO.StdErr, err)
+	}
+
+	fmt.Fprintln(f.IO.StdOut, "\nPress <Enter> to return to the dashboard.")
+	_, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+}
+
+func recoverPanic(app *tview.Application) {
+	if r := recover(); r != nil {
+		app.Stop()
+		log.Fatalf("%s\n%s\n", r, string(debug.Stack()))
+	}
+}
@@ -0,0 +1,114 @@
+package dashboard
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+func TestFetchDashboard(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/merge_requests?per_page=30&scope=created_by_me&state=opened",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1, "iid": 10, "project_id": 100, "updated_at": "2024-01-01T00:00:00Z"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/merge_requests?per_page=30&scope=assigned_to_me&state=opened",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 1, "iid": 10, "project_id": 100, "updated_at": "2024-01-01T00:00:00Z"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/merge_requests?per_page=30&reviewer_username=monalisa&scope=all&state=opened",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 2, "iid": 20, "project_id": 200, "updated_at": "2024-02-01T00:00:00Z"}
+		]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/100/merge_requests/10",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 1, "iid": 10, "project_id": 100, "title": "Add feature",
+			"references": {"full": "group/app!10"},
+			"head_pipeline": {"id": 5, "status": "success"}
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/100/merge_requests/10/discussions?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": "a", "notes": [{"resolvable": true, "resolved": false}]},
+			{"id": "b", "notes": [{"resolvable": true, "resolved": true}]},
+			{"id": "c", "notes": [{"resolvable": false}]}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/100/merge_requests/10/approvals",
+		httpmock.NewStringResponse(http.StatusOK, `{"approvals_required": 2, "approved_by": [{"user": {"username": "foo"}}]}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/200/merge_requests/20",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"id": 2, "iid": 20, "project_id": 200, "title": "Fix bug",
+			"references": {"full": "group/lib!20"}
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/200/merge_requests/20/discussions?per_page=100",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/200/merge_requests/20/approvals",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+
+	// httpmock requires a quick test run before it will work, see ./commands/ci/artifact/artifact_test.go
+	_, _ = api.TestClient(&http.Client{Transport: fakeHTTP}, "", "gitlab.com", false)
+	client, err := api.TestClient(&http.Client{Transport: fakeHTTP}, "", "gitlab.com", false)
+	require.NoError(t, err)
+
+	opts := &DashboardOpts{PerPage: api.DefaultListLimit, apiClient: client.Lab()}
+	items, err := fetchDashboard(opts, &gitlab.User{Username: "monalisa"})
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+
+	// Most recently updated first.
+	assert.Equal(t, "group/lib!20", reference(items[0].mr))
+	assert.Equal(t, []string{roleReviewer}, items[0].roles)
+	assert.Empty(t, items[0].pipelineStatus)
+	assert.Equal(t, 0, items[0].approvalsRequired)
+
+	assert.Equal(t, "group/app!10", reference(items[1].mr))
+	assert.Equal(t, []string{roleAuthor, roleAssignee}, items[1].roles)
+	assert.Equal(t, "success", items[1].pipelineStatus)
+	assert.Equal(t, 1, items[1].approvalsGiven)
+	assert.Equal(t, 2, items[1].approvalsRequired)
+	assert.Equal(t, 1, items[1].unresolvedThreads)
+}
+
+func TestDashboardCells(t *testing.T) {
+	item := &dashboardMR{
+		mr: &gitlab.MergeRequest{
+			IID:        10,
+			Title:      "Add [feature]",
+			Draft:      true,
+			References: &gitlab.IssueReferences{Full: "group/app!10"},
+		},
+		roles:             []string{roleAuthor, roleReviewer},
+		pipelineStatus:    "failed",
+		approvalsGiven:    2,
+		approvalsRequired: 2,
+		unresolvedThreads: 3,
+	}
+
+	assert.Equal(t, []string{
+		"[green]group/app!10[-]",
+		"[gray]Draft:[-] Add [feature[]",
+		"author, reviewer",
+		"[red]failed[-]",
+		"[green]2/2[-]",
+		"[yellow]3[-]",
+		"[gray][-]",
+	}, dashboardCells(item))
+}
+
+func TestProjectPath(t *testing.T) {
+	assert.Equal(t, "group/sub/app", projectPath(&gitlab.MergeRequest{
+		References: &gitlab.IssueReferences{Full: "group/sub/app!10"},
+	}))
+	assert.Empty(t, projectPath(&gitlab.MergeRequest{IID: 10}))
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	"syscall"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 
+	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
 
@@ -27,6 +29,13 @@ type DiffOptions struct {
 
 	Args     []string
 	UseColor string
+
+	SideBySide   bool
+	WordDiff     bool
+	NameOnly     bool
+	Stat         bool
+	Paths        []string
+	SinceVersion int
 }
 
 func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Command {
@@ -46,6 +55,15 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 			$ glab mr diff
 
 			$ glab mr diff 123 --color=never
+
+			# Show the old and new versions next to each other, highlighting changed words
+			$ glab mr diff 123 --side-by-side --word-diff
+
+			# Summarize the changes to Go files
+			$ glab mr diff 123 --stat --path '*.go'
+
+			# Show only what changed since the second version of the merge request
+			$ glab mr diff 123 --since-version 2
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -65,6 +83,16 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 				opts.UseColor = "never"
 			}
 
+			for _, pattern := range opts.Paths {
+				if _, err := path.Match(pattern, ""); err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --path pattern %q: %w", pattern, err)}
+				}
+			}
+
+			if cmd.Flags().Changed("since-version") && opts.SinceVersion < 1 {
+				return &cmdutils.FlagError{Err: errors.New("--since-version must be a version number of 1 or higher.")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -73,6 +101,13 @@ func NewCmdDiff(f *cmdutils.Factory, runF func(*DiffOptions) error) *cobra.Comma
 	}
 
 	cmd.Flags().StringVar(&opts.UseColor, "color", "auto", "Use color in diff output: always, never, auto.")
+	cmd.Flags().BoolVar(&opts.SideBySide, "side-by-side", false, "Show the old and new versions of each change next to each other.")
+	cmd.Flags().BoolVar(&opts.WordDiff, "word-diff", false, "Highlight changed words within changed lines.")
+	cmd.Flags().BoolVar(&opts.NameOnly, "name-only", false, "Show only the names of changed files.")
+	cmd.Flags().BoolVar(&opts.Stat, "stat", false, "Show the number of changed lines per file.")
+	cmd.Flags().StringSliceVar(&opts.Paths, "path", nil, "Show only files matching a glob pattern. Patterns without '/' match file names in any directory. Multiple patterns can be comma-separated or the flag repeated.")
+	cmd.Flags().IntVar(&opts.SinceVersion, "since-version", 0, "Show only the changes made since this version of the merge request diff. Versions are numbered from 1, the oldest.")
+	cmd.MarkFlagsMutuallyExclusive("side-by-side", "name-only", "stat")
 
 	return cmd
 }
@@ -87,25 +122,68 @@ func diffRun(opts *DiffOptions) error {
 		return err
 	}
 
-	diffs, _, err := apiClient.MergeRequests.GetMergeRequestDiffVersions(baseRepo.FullName(), mr.IID, &gitlab.GetMergeRequestDiffVersionsOptions{})
+	versions, err := api.ListMRDiffVersions(apiClient, baseRepo.FullName(), mr.IID)
 	if err != nil {
 		return fmt.Errorf("could not find merge request diffs: %w", err)
 	}
-	if len(diffs) == 0 {
+	if len(versions) == 0 {
 		return fmt.Errorf("no merge request diffs found")
 	}
 
-	diffOut := &bytes.Buffer{}
-
 	// diff versions are returned by the API in order of most recent first
-	diff := diffs[0]
+	latest := versions[0]
 
-	// the diffs are not included in the GetMergeRequestDiffVersions so we query for the diff version
-	diffVersion, _, err := apiClient.MergeRequests.GetSingleMergeRequestDiffVersion(baseRepo.FullName(), mr.IID, diff.ID, &gitlab.GetSingleMergeRequestDiffVersionOptions{})
-	if err != nil {
-		return fmt.Errorf("could not find merge request diff: %w", err)
+	var diffs []*gitlab.Diff
+	if opts.SinceVersion > 0 {
+		if opts.SinceVersion >= len(versions) {
+			return fmt.Errorf("version %d is not an earlier version of merge request !%d, which has %d versions.", opts.SinceVersion, mr.IID, len(versions))
+		}
+		since := versions[len(versions)-opts.SinceVersion]
+
+		// compare the head commits of both versions directly, not from their merge base
+		compare, _, err := apiClient.Repositories.Compare(baseRepo.FullName(), &gitlab.CompareOptions{
+			From:     gitlab.Ptr(since.HeadCommitSHA),
+			To:       gitlab.Ptr(latest.HeadCommitSHA),
+			Straight: gitlab.Ptr(true),
+		})
+		if err != nil {
+			return fmt.Errorf("could not compare merge request versions: %w", err)
+		}
+		diffs = compare.Diffs
+	} else {
+		// the diffs are not included in the GetMergeRequestDiffVersions so we query for the diff version
+		diffVersion, _, err := apiClient.MergeRequests.GetSingleMergeRequestDiffVersion(baseRepo.FullName(), mr.IID, latest.ID, &gitlab.GetSingleMergeRequestDiffVersionOptions{})
+		if err != nil {
+			return fmt.Errorf("could not find merge request diff: %w", err)
+		}
+		diffs = diffVersion.Diffs
 	}
-	for _, diffLine := range diffVersion.Diffs {
+
+	diffs = filterDiffs(diffs, opts.Paths)
+
+	if opts.NameOnly || opts.Stat || opts.SideBySide || opts.WordDiff {
+		err = opts.IO.StartPager()
+		if err != nil {
+			return err
+		}
+		defer opts.IO.StopPager()
+
+		useColor := opts.UseColor != "never"
+		switch {
+		case opts.NameOnly:
+			renderNameOnly(opts.IO.StdOut, diffs)
+		case opts.Stat:
+			renderStat(opts.IO.StdOut, diffs, opts.IO.TerminalWidth(), useColor)
+		case opts.SideBySide:
+			renderSideBySide(opts.IO.StdOut, diffs, opts.IO.TerminalWidth(), opts.WordDiff, useColor)
+		default:
+			renderWordDiff(opts.IO.StdOut, diffs, useColor)
+		}
+		return nil
+	}
+
+	diffOut := &bytes.Buffer{}
+	for _, diffLine := range diffs {
 		// output the unified diff header
 		diffOut.WriteString("--- " + diffLine.OldPath + "\n")
 		diffOut.WriteString("+++ " + diffLine.NewPath + "\n")
@@ -170,6 +248,35 @@ func isRemovalLine(dl string) bool {
 	return strings.HasPrefix(dl, "-")
 }
 
+// filterDiffs keeps the file diffs whose old or new path matches any of the patterns.
+// Patterns without a slash are matched against the file name only.
+func filterDiffs(diffs []*gitlab.Diff, patterns []string) []*gitlab.Diff {
+	if len(patterns) == 0 {
+		return diffs
+	}
+
+	var filtered []*gitlab.Diff
+	for _, d := range diffs {
+		if matchesAny(d.NewPath, patterns) || matchesAny(d.OldPath, patterns) {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
+func matchesAny(filePath string, patterns []string) bool {
+	for _, pattern := range patterns {
+		name := filePath
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(filePath)
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
 func validColorFlag(c string) bool {
 	return c == "auto" || c == "always" || c == "never"
 }
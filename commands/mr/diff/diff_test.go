@@ -59,6 +59,18 @@ func Test_NewCmdDiff(t *testing.T) {
 			isTTY:   true,
 			wantErr: "argument required when using the --repo flag.",
 		},
+		{
+			name:    "multiple output formats",
+			args:    "--stat --side-by-side",
+			isTTY:   true,
+			wantErr: "if any flags in the group [side-by-side name-only stat] are set none of the others can be; [side-by-side stat] were all set",
+		},
+		{
+			name:    "invalid --since-version argument",
+			args:    "--since-version 0",
+			isTTY:   true,
+			wantErr: "--since-version must be a version number of 1 or higher.",
+		},
 		{
 			name:    "invalid --color argument",
 			args:    "--color doublerainbow",
@@ -246,7 +258,7 @@ func TestMRDiff_tty(t *testing.T) {
 }
 
 func DiffTest(fakeHTTP *httpmock.Mocker) string {
-	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions`,
+	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions?per_page=100`,
 		httpmock.NewStringResponse(http.StatusOK, `[{
   "id": 110,
   "head_commit_sha": "33e2ee8579fda5bc36accc9c6fbd0b4fefda9e30",
@@ -352,6 +364,53 @@ func TestMRDiff_no_diffs_found(t *testing.T) {
 }
 
 func EmptyDiffsTest(fakeHTTP *httpmock.Mocker) {
-	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions`,
+	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions?per_page=100`,
 		httpmock.NewStringResponse(http.StatusOK, `[]`))
 }
+
+func TestMRDiff_since_version(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathAndQuerystring,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "state": "opened"}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/merge_requests/123/versions?per_page=100`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 112, "head_commit_sha": "ccc"},
+			{"id": 111, "head_commit_sha": "bbb"},
+			{"id": 110, "head_commit_sha": "aaa"}
+		]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `https://gitlab.com/api/v4/projects/OWNER%2FREPO/repository/compare?from=bbb&straight=true&to=ccc`,
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"diffs": [
+				{"old_path": "a.go", "new_path": "a.go", "diff": "@@ -1 +1 @@\n-old\n+new\n"},
+				{"old_path": "b.md", "new_path": "b.md", "diff": "@@ -1 +1,2 @@\n x\n+y\n"}
+			]
+		}`))
+
+	output, err := runCommand(fakeHTTP, nil, false, "123 --since-version 2 --name-only --path '*.go'")
+	require.NoError(t, err)
+
+	assert.Equal(t, "a.go\n", output.String())
+	assert.Empty(t, output.Stderr())
+}
+
+func TestMRDiff_since_version_out_of_range(t *testing.T) {
+	fakeHTTP := &httpmock.Mocker{
+		MatchURL: httpmock.PathOnly,
+	}
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "state": "opened"}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 111, "head_commit_sha": "bbb"}, {"id": 110, "head_commit_sha": "aaa"}]`))
+
+	_, err := runCommand(fakeHTTP, nil, false, "123 --since-version 2")
+	require.EqualError(t, err, "version 2 is not an earlier version of merge request !123, which has 2 versions.")
+}
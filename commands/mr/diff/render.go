@@ -0,0 +1,463 @@
+package diff
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+const (
+	ansiReset     = "\x1b[m"
+	ansiHeader    = "\x1b[1;38m"
+	ansiHunk      = "\x1b[36m"
+	ansiAdded     = "\x1b[32m"
+	ansiRemoved   = "\x1b[31m"
+	ansiWordAdded = "\x1b[1;7;32m"
+	ansiWordRemov = "\x1b[1;7;31m"
+)
+
+type lineKind int
+
+const (
+	lineContext lineKind = iota
+	lineRemoved
+	lineAdded
+	lineMeta
+)
+
+type diffLine struct {
+	kind  lineKind
+	text  string
+	oldNo int
+	newNo int
+}
+
+type hunk struct {
+	header string
+	lines  []diffLine
+}
+
+// segment is a part of a line that is either unchanged or changed
+// when compared word by word with its counterpart.
+type segment struct {
+	text    string
+	changed bool
+}
+
+var (
+	hunkHeaderRE = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+	wordRE       = regexp.MustCompile(`\w+|\s+|[^\w\s]`)
+)
+
+// maxWordDiffCells limits the size of the word-level comparison table,
+// so very long lines don't slow down rendering.
+const maxWordDiffCells = 250000
+
+// parseHunks splits the diff of a single file into hunks.
+// Anything before the first hunk header, like file headers, is ignored.
+func parseHunks(diff string) []hunk {
+	var hunks []hunk
+	var oldNo, newNo int
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		if m := hunkHeaderRE.FindStringSubmatch(line); m != nil {
+			oldNo, _ = strconv.Atoi(m[1])
+			newNo, _ = strconv.Atoi(m[2])
+			hunks = append(hunks, hunk{header: line})
+			continue
+		}
+		if len(hunks) == 0 {
+			continue
+		}
+
+		h := &hunks[len(hunks)-1]
+		switch {
+		case strings.HasPrefix(line, "+"):
+			h.lines = append(h.lines, diffLine{kind: lineAdded, text: line[1:], newNo: newNo})
+			newNo++
+		case strings.HasPrefix(line, "-"):
+			h.lines = append(h.lines, diffLine{kind: lineRemoved, text: line[1:], oldNo: oldNo})
+			oldNo++
+		case strings.HasPrefix(line, `\`):
+			h.lines = append(h.lines, diffLine{kind: lineMeta, text: line})
+		default:
+			h.lines = append(h.lines, diffLine{kind: lineContext, text: strings.TrimPrefix(line, " "), oldNo: oldNo, newNo: newNo})
+			oldNo++
+			newNo++
+		}
+	}
+
+	return hunks
+}
+
+// changeBlock returns the run of removed lines starting at lines[i],
+// the run of added lines directly following it, and the index after both runs.
+func changeBlock(lines []diffLine, i int) (removed, added []diffLine, next int) {
+	for i < len(lines) && lines[i].kind == lineRemoved {
+		removed = append(removed, lines[i])
+		i++
+	}
+	for i < len(lines) && lines[i].kind == lineAdded {
+		added = append(added, lines[i])
+		i++
+	}
+	return removed, added, i
+}
+
+// wordDiff compares two lines word by word and marks the words that differ.
+func wordDiff(oldLine, newLine string) ([]segment, []segment) {
+	oldWords := wordRE.FindAllString(oldLine, -1)
+	newWords := wordRE.FindAllString(newLine, -1)
+
+	if len(oldWords)*len(newWords) > maxWordDiffCells {
+		return []segment{{text: oldLine, changed: true}}, []segment{{text: newLine, changed: true}}
+	}
+
+	var oldSegs, newSegs []segment
+	for _, edit := range text.Diff(oldWords, newWords) {
+		if edit.Op != text.DiffAdded {
+			oldSegs = appendSegment(oldSegs, edit.Text, edit.Op == text.DiffRemoved)
+		}
+		if edit.Op != text.DiffRemoved {
+			newSegs = appendSegment(newSegs, edit.Text, edit.Op == text.DiffAdded)
+		}
+	}
+
+	return oldSegs, newSegs
+}
+
+func appendSegment(segs []segment, text string, changed bool) []segment {
+	if n := len(segs); n > 0 && segs[n-1].changed == changed {
+		segs[n-1].text += text
+		return segs
+	}
+	return append(segs, segment{text: text, changed: changed})
+}
+
+// lineSegments splits paired removed and added lines into segments.
+// Without word diff, or without a counterpart, a line is a single unchanged segment.
+func lineSegments(removed, added []diffLine, wordLevel bool) ([][]segment, [][]segment) {
+	oldSegs := make([][]segment, len(removed))
+	newSegs := make([][]segment, len(added))
+
+	for i := range removed {
+		oldSegs[i] = []segment{{text: removed[i].text}}
+	}
+	for i := range added {
+		newSegs[i] = []segment{{text: added[i].text}}
+	}
+
+	if wordLevel {
+		for i := 0; i < len(removed) && i < len(added); i++ {
+			oldSegs[i], newSegs[i] = wordDiff(removed[i].text, added[i].text)
+		}
+	}
+
+	return oldSegs, newSegs
+}
+
+// formatSegments renders segments with the given base style,
+// highlighting changed words. Without color, changed words are
+// wrapped in the markers used by `git diff --word-diff=plain`.
+func formatSegments(segs []segment, kind lineKind, useColor bool) string {
+	var b strings.Builder
+
+	base, word, open, closing := ansiRemoved, ansiWordRemov, "[-", "-]"
+	if kind == lineAdded {
+		base, word, open, closing = ansiAdded, ansiWordAdded, "{+", "+}"
+	}
+
+	for _, s := range segs {
+		switch {
+		case !s.changed:
+			b.WriteString(s.text)
+		case useColor:
+			b.WriteString(word + s.text + ansiReset + base)
+		default:
+			b.WriteString(open + s.text + closing)
+		}
+	}
+
+	return b.String()
+}
+
+func writeFileHeader(out io.Writer, d *gitlab.Diff, useColor bool) {
+	if useColor {
+		fmt.Fprintf(out, "%s--- %s%s\n", ansiHeader, d.OldPath, ansiReset)
+		fmt.Fprintf(out, "%s+++ %s%s\n", ansiHeader, d.NewPath, ansiReset)
+		return
+	}
+	fmt.Fprintf(out, "--- %s\n", d.OldPath)
+	fmt.Fprintf(out, "+++ %s\n", d.NewPath)
+}
+
+func writeHunkHeader(out io.Writer, header string, useColor bool) {
+	if useColor {
+		fmt.Fprintf(out, "%s%s%s\n", ansiHunk, header, ansiReset)
+		return
+	}
+	fmt.Fprintln(out, header)
+}
+
+// renderWordDiff writes a unified diff with word-level highlighting of changed lines.
+func renderWordDiff(out io.Writer, diffs []*gitlab.Diff, useColor bool) {
+	for _, d := range diffs {
+		writeFileHeader(out, d, useColor)
+
+		for _, h := range parseHunks(d.Diff) {
+			writeHunkHeader(out, h.header, useColor)
+
+			for i := 0; i < len(h.lines); {
+				line := h.lines[i]
+				if line.kind != lineRemoved && line.kind != lineAdded {
+					if line.kind == lineMeta {
+						fmt.Fprintln(out, line.text)
+					} else {
+						fmt.Fprintln(out, " "+line.text)
+					}
+					i++
+					continue
+				}
+
+				removed, added, next := changeBlock(h.lines, i)
+				oldSegs, newSegs := lineSegments(removed, added, true)
+				for _, segs := range oldSegs {
+					writeChangedLine(out, "-", formatSegments(segs, lineRemoved, useColor), ansiRemoved, useColor)
+				}
+				for _, segs := range newSegs {
+					writeChangedLine(out, "+", formatSegments(segs, lineAdded, useColor), ansiAdded, useColor)
+				}
+				i = next
+			}
+		}
+	}
+}
+
+func writeChangedLine(out io.Writer, prefix, text, style string, useColor bool) {
+	if useColor {
+		fmt.Fprintf(out, "%s%s%s%s\n", style, prefix, text, ansiReset)
+		return
+	}
+	fmt.Fprintf(out, "%s%s\n", prefix, text)
+}
+
+// sideBySideColumn is the content of one half of a side-by-side row.
+type sideBySideColumn struct {
+	kind   lineKind
+	number int
+	segs   []segment
+}
+
+// renderSideBySide writes the diffs as two columns that fit into the given width,
+// with the old version on the left, and the new version on the right.
+func renderSideBySide(out io.Writer, diffs []*gitlab.Diff, width int, wordLevel, useColor bool) {
+	const separator = " │ "
+	const gutter = 7 // line number, marker and spaces: "%4d %c "
+
+	colWidth := (width - runewidth.StringWidth(separator)) / 2
+	if colWidth < gutter+10 {
+		colWidth = gutter + 10
+	}
+
+	for _, d := range diffs {
+		writeFileHeader(out, d, useColor)
+
+		for _, h := range parseHunks(d.Diff) {
+			writeHunkHeader(out, h.header, useColor)
+
+			for i := 0; i < len(h.lines); {
+				line := h.lines[i]
+				switch line.kind {
+				case lineMeta:
+					fmt.Fprintln(out, line.text)
+					i++
+					continue
+				case lineContext:
+					segs := []segment{{text: line.text}}
+					left := formatColumn(sideBySideColumn{lineContext, line.oldNo, segs}, colWidth, useColor)
+					right := formatColumn(sideBySideColumn{lineContext, line.newNo, segs}, colWidth, useColor)
+					fmt.Fprintln(out, strings.TrimRight(left+separator+right, " "))
+					i++
+					continue
+				}
+
+				removed, added, next := changeBlock(h.lines, i)
+				oldSegs, newSegs := lineSegments(removed, added, wordLevel)
+				for row := 0; row < len(removed) || row < len(added); row++ {
+					var left, right sideBySideColumn
+					if row < len(removed) {
+						left = sideBySideColumn{lineRemoved, removed[row].oldNo, oldSegs[row]}
+					}
+					if row < len(added) {
+						right = sideBySideColumn{lineAdded, added[row].newNo, newSegs[row]}
+					}
+					line := formatColumn(left, colWidth, useColor) + separator + formatColumn(right, colWidth, useColor)
+					fmt.Fprintln(out, strings.TrimRight(line, " "))
+				}
+				i = next
+			}
+		}
+	}
+}
+
+// formatColumn renders one side of a row, truncated or padded to exactly width cells.
+func formatColumn(col sideBySideColumn, width int, useColor bool) string {
+	if col.segs == nil {
+		return strings.Repeat(" ", width)
+	}
+
+	marker, style, word := ' ', "", ""
+	switch col.kind {
+	case lineRemoved:
+		marker, style, word = '-', ansiRemoved, ansiWordRemov
+	case lineAdded:
+		marker, style, word = '+', ansiAdded, ansiWordAdded
+	}
+
+	segs := col.segs
+	if !useColor && col.kind != lineContext {
+		// without color, changed words are marked inline, and count towards the width
+		segs = []segment{{text: formatSegments(segs, col.kind, false)}}
+	}
+
+	var b strings.Builder
+	gutter := fmt.Sprintf("%4d %c ", col.number, marker)
+	if useColor && style != "" {
+		b.WriteString(style)
+	}
+	b.WriteString(gutter)
+
+	remaining := width - len(gutter)
+	for _, s := range segs {
+		if remaining <= 0 {
+			break
+		}
+		text := strings.ReplaceAll(s.text, "\t", "    ")
+		if runewidth.StringWidth(text) > remaining {
+			text = runewidth.Truncate(text, remaining, "…")
+		}
+		remaining -= runewidth.StringWidth(text)
+
+		if s.changed {
+			b.WriteString(word + text + ansiReset + style)
+		} else {
+			b.WriteString(text)
+		}
+	}
+	if useColor && style != "" {
+		b.WriteString(ansiReset)
+	}
+	if remaining > 0 {
+		b.WriteString(strings.Repeat(" ", remaining))
+	}
+
+	return b.String()
+}
+
+// diffStat counts the added and removed lines of a file diff.
+func diffStat(d *gitlab.Diff) (added, removed int) {
+	for _, h := range parseHunks(d.Diff) {
+		for _, l := range h.lines {
+			switch l.kind {
+			case lineAdded:
+				added++
+			case lineRemoved:
+				removed++
+			}
+		}
+	}
+	return added, removed
+}
+
+func diffPath(d *gitlab.Diff) string {
+	if d.DeletedFile {
+		return d.OldPath
+	}
+	return d.NewPath
+}
+
+// renderNameOnly writes the path of each changed file.
+func renderNameOnly(out io.Writer, diffs []*gitlab.Diff) {
+	for _, d := range diffs {
+		fmt.Fprintln(out, diffPath(d))
+	}
+}
+
+// renderStat writes a summary of changed lines per file, like `git diff --stat`.
+func renderStat(out io.Writer, diffs []*gitlab.Diff, width int, useColor bool) {
+	type fileStat struct {
+		name           string
+		added, removed int
+	}
+
+	stats := make([]fileStat, 0, len(diffs))
+	nameWidth, maxChanges := 0, 0
+	totalAdded, totalRemoved := 0, 0
+	for _, d := range diffs {
+		added, removed := diffStat(d)
+		name := diffPath(d)
+		if d.RenamedFile {
+			name = d.OldPath + " => " + d.NewPath
+		}
+		stats = append(stats, fileStat{name, added, removed})
+
+		nameWidth = max(nameWidth, runewidth.StringWidth(name))
+		maxChanges = max(maxChanges, added+removed)
+		totalAdded += added
+		totalRemoved += removed
+	}
+
+	countWidth := len(strconv.Itoa(maxChanges))
+	barWidth := width - nameWidth - countWidth - 5
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	for _, s := range stats {
+		added, removed := s.added, s.removed
+		if maxChanges > barWidth {
+			added = scaleChanges(added, maxChanges, barWidth)
+			removed = scaleChanges(removed, maxChanges, barWidth)
+		}
+
+		plus, minus := strings.Repeat("+", added), strings.Repeat("-", removed)
+		if useColor {
+			plus = ansiAdded + plus + ansiReset
+			minus = ansiRemoved + minus + ansiReset
+		}
+		fmt.Fprintf(out, " %s | %*d %s%s\n",
+			runewidth.FillRight(s.name, nameWidth), countWidth, s.added+s.removed, plus, minus)
+	}
+
+	summary := fmt.Sprintf(" %d %s changed", len(stats), pluralize(len(stats), "file", "files"))
+	if totalAdded > 0 || totalRemoved == 0 {
+		summary += fmt.Sprintf(", %d %s(+)", totalAdded, pluralize(totalAdded, "insertion", "insertions"))
+	}
+	if totalRemoved > 0 || totalAdded == 0 {
+		summary += fmt.Sprintf(", %d %s(-)", totalRemoved, pluralize(totalRemoved, "deletion", "deletions"))
+	}
+	fmt.Fprintln(out, summary)
+}
+
+// scaleChanges scales a number of changes to the width of the bar,
+// keeping at least one character for any change.
+func scaleChanges(changes, maxChanges, width int) int {
+	if changes == 0 {
+		return 0
+	}
+	return max(1, changes*width/maxChanges)
+}
+
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,139 @@
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+var testDiffs = []*gitlab.Diff{
+	{
+		OldPath: "main.go",
+		NewPath: "main.go",
+		Diff: heredoc.Doc(`
+			@@ -1,4 +1,4 @@
+			 package main
+			-func hello() { return "world" }
+			+func hello() { return "GitLab" }
+
+			-// end
+		`),
+	},
+	{
+		OldPath: "docs/README.md",
+		NewPath: "docs/README.md",
+		NewFile: true,
+		Diff: heredoc.Doc(`
+			@@ -0,0 +1,2 @@
+			+# Title
+			+Text
+		`),
+	},
+}
+
+func Test_parseHunks(t *testing.T) {
+	hunks := parseHunks(testDiffs[0].Diff)
+
+	assert.Len(t, hunks, 1)
+	assert.Equal(t, "@@ -1,4 +1,4 @@", hunks[0].header)
+	assert.Equal(t, []diffLine{
+		{kind: lineContext, text: "package main", oldNo: 1, newNo: 1},
+		{kind: lineRemoved, text: `func hello() { return "world" }`, oldNo: 2},
+		{kind: lineAdded, text: `func hello() { return "GitLab" }`, newNo: 2},
+		{kind: lineContext, text: "", oldNo: 3, newNo: 3},
+		{kind: lineRemoved, text: "// end", oldNo: 4},
+	}, hunks[0].lines)
+}
+
+func Test_wordDiff(t *testing.T) {
+	oldSegs, newSegs := wordDiff("the quick brown fox", "the slow brown fox jumps")
+
+	assert.Equal(t, []segment{
+		{text: "the "},
+		{text: "quick", changed: true},
+		{text: " brown fox"},
+	}, oldSegs)
+	assert.Equal(t, []segment{
+		{text: "the "},
+		{text: "slow", changed: true},
+		{text: " brown fox"},
+		{text: " jumps", changed: true},
+	}, newSegs)
+}
+
+func Test_renderWordDiff(t *testing.T) {
+	out := &bytes.Buffer{}
+	renderWordDiff(out, testDiffs[:1], false)
+
+	assert.Equal(t, "--- main.go\n"+
+		"+++ main.go\n"+
+		"@@ -1,4 +1,4 @@\n"+
+		" package main\n"+
+		"-func hello() { return \"[-world-]\" }\n"+
+		"+func hello() { return \"{+GitLab+}\" }\n"+
+		" \n"+
+		"-// end\n", out.String())
+}
+
+func Test_renderSideBySide(t *testing.T) {
+	out := &bytes.Buffer{}
+	renderSideBySide(out, testDiffs, 63, false, false)
+
+	assert.Equal(t, heredoc.Doc(`
+		--- main.go
+		+++ main.go
+		@@ -1,4 +1,4 @@
+		   1   package main            │    1   package main
+		   2 - func hello() { return … │    2 + func hello() { return …
+		   3                           │    3
+		   4 - // end                  │
+		--- docs/README.md
+		+++ docs/README.md
+		@@ -0,0 +1,2 @@
+		                               │    1 + # Title
+		                               │    2 + Text
+	`), out.String())
+}
+
+func Test_renderStat(t *testing.T) {
+	out := &bytes.Buffer{}
+	renderStat(out, testDiffs, 80, false)
+
+	assert.Equal(t, " main.go        | 3 +--\n"+
+		" docs/README.md | 2 ++\n"+
+		" 2 files changed, 3 insertions(+), 2 deletions(-)\n", out.String())
+}
+
+func Test_renderNameOnly(t *testing.T) {
+	out := &bytes.Buffer{}
+	renderNameOnly(out, testDiffs)
+
+	assert.Equal(t, "main.go\ndocs/README.md\n", out.String())
+}
+
+func Test_filterDiffs(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []string
+	}{
+		{name: "no patterns", patterns: nil, want: []string{"main.go", "docs/README.md"}},
+		{name: "file name pattern", patterns: []string{"*.md"}, want: []string{"docs/README.md"}},
+		{name: "path pattern", patterns: []string{"docs/*"}, want: []string{"docs/README.md"}},
+		{name: "path pattern only matches full path", patterns: []string{"*/main.go"}, want: nil},
+		{name: "multiple patterns", patterns: []string{"*.go", "*.md"}, want: []string{"main.go", "docs/README.md"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, d := range filterDiffs(testDiffs, tc.patterns) {
+				got = append(got, d.NewPath)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
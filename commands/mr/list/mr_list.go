@@ -12,6 +12,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/filter/filterutils"
 	"gitlab.com/gitlab-org/cli/commands/flag"
 	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
 	"gitlab.com/gitlab-org/cli/pkg/utils"
@@ -79,6 +80,8 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error) *cobra.
 			glab mr list -M --per-page 10
 			glab mr list --draft
 			glab mr list --not-draft
+			glab mr list --reviewer=@me --not-draft --save-filter review
+			glab mr list --filter review
 		`),
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -86,6 +89,11 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error) *cobra.
 			opts.BaseRepo = f.BaseRepo
 			opts.HTTPClient = f.HttpClient
 
+			err := filterutils.Process(f, cmd, "mr")
+			if err != nil {
+				return err
+			}
+
 			if len(opts.Labels) != 0 && len(opts.NotLabels) != 0 {
 				return cmdutils.FlagError{
 					Err: errors.New("flags --label and --not-label are mutually exclusive."),
@@ -153,6 +161,7 @@ func NewCmdList(f *cmdutils.Factory, runE func(opts *ListOptions) error) *cobra.
 	_ = mrListCmd.Flags().MarkDeprecated("mine", "use --assignee=@me.")
 	mrListCmd.PersistentFlags().StringP("group", "g", "", "Select a group/subgroup. This option is ignored if a repo argument is set.")
 	mrListCmd.MarkFlagsMutuallyExclusive("draft", "not-draft")
+	filterutils.AddFlags(mrListCmd)
 
 	return mrListCmd
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
@@ -647,3 +648,54 @@ func TestMergeRequestList_GroupWithAssigneeAndReviewer(t *testing.T) {
 	// 2 for users lookup, 2 for merge requests (assignee and reviewer)
 	assert.Len(t, requests, 4)
 }
+
+func TestMergeRequestList_SavedFilter(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	// placeholders are replaced in the current run, and saved as they are.
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "title": "1.0", "start_date": "2000-01-01", "due_date": "2999-12-31"}]`))
+
+	gotOpts := &ListOptions{}
+	output, err := runCommand(fakeHTTP, false, "--reviewer @me --not-draft --label bug --milestone current-milestone --search @me --save-filter review --global-filter", func(opts *ListOptions) error {
+		gotOpts = opts
+		return nil
+	}, "")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Saved filter \"review\".\n", output.Stderr())
+	assert.Equal(t, "1.0", gotOpts.Milestone)
+	// the list resolves '@me' in the flags of users itself.
+	assert.Equal(t, []string{"@me"}, gotOpts.Reviewer)
+	// other flags are used as they are.
+	assert.Equal(t, "@me", gotOpts.Search)
+
+	filters, err := config.ReadFilters(false)
+	require.NoError(t, err)
+	saved, _ := filters.Get("mr", "review")
+	assert.Equal(t, "@me", saved["reviewer"])
+	assert.Equal(t, "current-milestone", saved["milestone"])
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 4, "title": "1.0", "start_date": "2000-01-01", "due_date": "2999-12-31"}]`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/user",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 1, "username": "me"}`))
+
+	gotOpts = &ListOptions{}
+	_, err = runCommand(fakeHTTP, false, "--filter review --label docs", func(opts *ListOptions) error {
+		gotOpts = opts
+		return nil
+	}, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"me"}, gotOpts.Reviewer)
+	assert.Equal(t, "1.0", gotOpts.Milestone)
+	assert.Equal(t, []string{"docs"}, gotOpts.Labels)
+	assert.True(t, gotOpts.NotDraft)
+
+	_, err = runCommand(fakeHTTP, false, "--filter unknown", nil, "")
+	assert.EqualError(t, err, `no saved mr filter "unknown". Use 'glab filter list' to see the saved filters.`)
+}
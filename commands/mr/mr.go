@@ -6,8 +6,11 @@ import (
 	mrApproveCmd "gitlab.com/gitlab-org/cli/commands/mr/approve"
 	mrApproversCmd "gitlab.com/gitlab-org/cli/commands/mr/approvers"
 	mrCheckoutCmd "gitlab.com/gitlab-org/cli/commands/mr/checkout"
+	mrChecksCmd "gitlab.com/gitlab-org/cli/commands/mr/checks"
+	mrCherryPickCmd "gitlab.com/gitlab-org/cli/commands/mr/cherrypick"
 	mrCloseCmd "gitlab.com/gitlab-org/cli/commands/mr/close"
 	mrCreateCmd "gitlab.com/gitlab-org/cli/commands/mr/create"
+	mrDashboardCmd "gitlab.com/gitlab-org/cli/commands/mr/dashboard"
 	mrDeleteCmd "gitlab.com/gitlab-org/cli/commands/mr/delete"
 	mrDiffCmd "gitlab.com/gitlab-org/cli/commands/mr/diff"
 	mrForCmd "gitlab.com/gitlab-org/cli/commands/mr/for"
@@ -15,14 +18,19 @@ import (
 	mrListCmd "gitlab.com/gitlab-org/cli/commands/mr/list"
 	mrMergeCmd "gitlab.com/gitlab-org/cli/commands/mr/merge"
 	mrNoteCmd "gitlab.com/gitlab-org/cli/commands/mr/note"
+	mrRangeDiffCmd "gitlab.com/gitlab-org/cli/commands/mr/rangediff"
 	mrRebaseCmd "gitlab.com/gitlab-org/cli/commands/mr/rebase"
 	mrReopenCmd "gitlab.com/gitlab-org/cli/commands/mr/reopen"
+	mrRevertCmd "gitlab.com/gitlab-org/cli/commands/mr/revert"
 	mrRevokeCmd "gitlab.com/gitlab-org/cli/commands/mr/revoke"
 	mrSubscribeCmd "gitlab.com/gitlab-org/cli/commands/mr/subscribe"
+	mrTimeCmd "gitlab.com/gitlab-org/cli/commands/mr/time"
 	mrTodoCmd "gitlab.com/gitlab-org/cli/commands/mr/todo"
 	mrUnsubscribeCmd "gitlab.com/gitlab-org/cli/commands/mr/unsubscribe"
 	mrUpdateCmd "gitlab.com/gitlab-org/cli/commands/mr/update"
+	mrVersionsCmd "gitlab.com/gitlab-org/cli/commands/mr/versions"
 	mrViewCmd "gitlab.com/gitlab-org/cli/commands/mr/view"
+	mrWorktreeCmd "gitlab.com/gitlab-org/cli/commands/mr/worktree"
 
 	"github.com/spf13/cobra"
 )
@@ -50,9 +58,12 @@ func NewCmdMR(f *cmdutils.Factory) *cobra.Command {
 
 	mrCmd.AddCommand(mrApproveCmd.NewCmdApprove(f))
 	mrCmd.AddCommand(mrApproversCmd.NewCmdApprovers(f))
+	mrCmd.AddCommand(mrChecksCmd.NewCmdChecks(f))
 	mrCmd.AddCommand(mrCheckoutCmd.NewCmdCheckout(f))
+	mrCmd.AddCommand(mrCherryPickCmd.NewCmdCherryPick(f))
 	mrCmd.AddCommand(mrCloseCmd.NewCmdClose(f))
 	mrCmd.AddCommand(mrCreateCmd.NewCmdCreate(f))
+	mrCmd.AddCommand(mrDashboardCmd.NewCmdDashboard(f))
 	mrCmd.AddCommand(mrDeleteCmd.NewCmdDelete(f))
 	mrCmd.AddCommand(mrDiffCmd.NewCmdDiff(f, nil))
 	mrCmd.AddCommand(mrForCmd.NewCmdFor(f))
@@ -60,14 +71,19 @@ func NewCmdMR(f *cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrListCmd.NewCmdList(f, nil))
 	mrCmd.AddCommand(mrMergeCmd.NewCmdMerge(f))
 	mrCmd.AddCommand(mrNoteCmd.NewCmdNote(f))
+	mrCmd.AddCommand(mrRangeDiffCmd.NewCmdRangeDiff(f))
 	mrCmd.AddCommand(mrRebaseCmd.NewCmdRebase(f))
 	mrCmd.AddCommand(mrReopenCmd.NewCmdReopen(f))
+	mrCmd.AddCommand(mrRevertCmd.NewCmdRevert(f))
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
+	mrCmd.AddCommand(mrTimeCmd.NewCmdTime(f))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
 	mrCmd.AddCommand(mrUpdateCmd.NewCmdUpdate(f))
+	mrCmd.AddCommand(mrVersionsCmd.NewCmdVersions(f))
 	mrCmd.AddCommand(mrViewCmd.NewCmdView(f))
+	mrCmd.AddCommand(mrWorktreeCmd.NewCmdWorktree(f))
 
 	return mrCmd
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/cli/api"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
 	"gitlab.com/gitlab-org/cli/pkg/prompt"
 	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
 	"golang.org/x/sync/errgroup"
@@ -111,6 +113,15 @@ func MRState(c *iostreams.ColorPalette, m *gitlab.MergeRequest) string {
 	}
 }
 
+// MRReference returns the full reference of a merge request, like group/project!1,
+// or !1 when the API did not return it.
+func MRReference(mr *gitlab.MergeRequest) string {
+	if mr.References != nil && mr.References.Full != "" {
+		return mr.References.Full
+	}
+	return fmt.Sprintf("!%d", mr.IID)
+}
+
 func DisplayAllMRs(streams *iostreams.IOStreams, mrs []*gitlab.MergeRequest) string {
 	c := streams.Color()
 	table := tableprinter.NewTablePrinter()
@@ -395,3 +406,70 @@ func PrintMRApprovalState(ios *iostreams.IOStreams, mrApprovals *gitlab.MergeReq
 		fmt.Fprintln(ios.StdOut, table)
 	}
 }
+
+// ParseMRURL returns the path of the project and the IID of a merge request
+// from its web URL, like the ones stored in stack files.
+func ParseMRURL(webURL string) (string, int, error) {
+	u, err := url.Parse(webURL)
+	if err != nil {
+		return "", 0, err
+	}
+
+	project, iid, found := strings.Cut(strings.Trim(u.Path, "/"), "/-/merge_requests/")
+	if !found {
+		return "", 0, fmt.Errorf("%q is not the URL of a merge request.", webURL)
+	}
+
+	n, err := strconv.Atoi(strings.Trim(iid, "/"))
+	if err != nil {
+		return "", 0, fmt.Errorf("%q is not the URL of a merge request.", webURL)
+	}
+
+	return project, n, nil
+}
+
+// worktreeMRKey is the Git configuration key of a branch that records the merge
+// request checked out in its worktree by `glab mr checkout --worktree`.
+const worktreeMRKey = "glab-mr-url"
+
+// MRWorktree is a worktree created for a merge request.
+type MRWorktree struct {
+	git.Worktree
+	// Project is the path of the project of the merge request.
+	Project string
+	IID     int
+}
+
+// RegisterMRWorktree records that the worktree of branch was created for mr.
+func RegisterMRWorktree(branch string, mr *gitlab.MergeRequest) error {
+	return git.RunCmd([]string{"config", fmt.Sprintf("branch.%s.%s", branch, worktreeMRKey), mr.WebURL})
+}
+
+// MRWorktrees returns the worktrees created for merge requests.
+func MRWorktrees() ([]MRWorktree, error) {
+	worktrees, err := git.ListWorktrees()
+	if err != nil {
+		return nil, err
+	}
+
+	var mrWorktrees []MRWorktree
+	for _, wt := range worktrees {
+		if wt.Branch == "" {
+			continue
+		}
+
+		webURL, err := git.Config(fmt.Sprintf("branch.%s.%s", wt.Branch, worktreeMRKey))
+		if err != nil || webURL == "" {
+			continue
+		}
+
+		project, iid, err := ParseMRURL(webURL)
+		if err != nil {
+			continue
+		}
+
+		mrWorktrees = append(mrWorktrees, MRWorktree{Worktree: wt, Project: project, IID: iid})
+	}
+
+	return mrWorktrees, nil
+}
@@ -849,3 +849,21 @@ User One	aaa	👍
 		})
 	}
 }
+
+func TestParseMRURL(t *testing.T) {
+	project, iid, err := ParseMRURL("https://gitlab.com/group/sub/project/-/merge_requests/12")
+	assert.NoError(t, err)
+	assert.Equal(t, "group/sub/project", project)
+	assert.Equal(t, 12, iid)
+
+	_, _, err = ParseMRURL("https://gitlab.com/group/project/-/issues/12")
+	assert.EqualError(t, err, `"https://gitlab.com/group/project/-/issues/12" is not the URL of a merge request.`)
+}
+
+func TestMRReference(t *testing.T) {
+	assert.Equal(t, "!1", MRReference(&gitlab.MergeRequest{IID: 1}))
+	assert.Equal(t, "group/project!1", MRReference(&gitlab.MergeRequest{
+		IID:        1,
+		References: &gitlab.IssueReferences{Full: "group/project!1"},
+	}))
+}
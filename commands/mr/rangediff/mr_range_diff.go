@@ -0,0 +1,309 @@
+package rangediff
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+// maxPatchDiffCells bounds the size of the table used to compare two patches.
+const maxPatchDiffCells = 4000000
+
+// Local git helpers, replaced in tests.
+var (
+	commitExists   = git.CommitExists
+	localRangeDiff = git.RangeDiff
+)
+
+type RangeDiffOpts struct {
+	OldVersion int
+	NewVersion int
+
+	IO *iostreams.IOStreams
+}
+
+func NewCmdRangeDiff(f *cmdutils.Factory) *cobra.Command {
+	opts := &RangeDiffOpts{
+		IO: f.IO,
+	}
+
+	mrRangeDiffCmd := &cobra.Command{
+		Use:   "range-diff <old-version> <new-version> [<id> | <branch>]",
+		Short: `Compare the commits of two diff versions of a merge request.`,
+		Long: heredoc.Doc(`
+			Compare the commits of two diff versions of a merge request, like
+			'git range-diff'. Use it to review what changed after a rebase or force push.
+
+			Versions are numbered from the oldest, starting at 1, as shown by 'glab mr versions'.
+
+			When the commits of both versions are available in the local repository,
+			'git range-diff' is used. Otherwise, commits are paired by title and their
+			patches are compared through the API.
+		`),
+		Example: heredoc.Doc(`
+			glab mr range-diff 1 2 123
+			glab mr range-diff 2 3 feature-branch
+		`),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if opts.OldVersion, err = parseVersion(args[0]); err != nil {
+				return err
+			}
+			if opts.NewVersion, err = parseVersion(args[1]); err != nil {
+				return err
+			}
+			if opts.OldVersion == opts.NewVersion {
+				return &cmdutils.FlagError{Err: fmt.Errorf("the two versions must be different.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			mr, repo, err := mrutils.MRFromArgs(f, args[2:], "any")
+			if err != nil {
+				return err
+			}
+
+			versions, err := api.ListMRDiffVersions(apiClient, repo.FullName(), mr.IID)
+			if err != nil {
+				return err
+			}
+
+			// The API returns the most recent version first.
+			for _, n := range []int{opts.OldVersion, opts.NewVersion} {
+				if n > len(versions) {
+					return fmt.Errorf("version %d does not exist. Merge request !%d has %d versions.", n, mr.IID, len(versions))
+				}
+			}
+			oldVersion := versions[len(versions)-opts.OldVersion]
+			newVersion := versions[len(versions)-opts.NewVersion]
+
+			if localCommitsAvailable(oldVersion, newVersion) {
+				out, err := localRangeDiff(
+					oldVersion.BaseCommitSHA+".."+oldVersion.HeadCommitSHA,
+					newVersion.BaseCommitSHA+".."+newVersion.HeadCommitSHA,
+					opts.IO.ColorEnabled(),
+				)
+				if err != nil {
+					return err
+				}
+				fmt.Fprint(opts.IO.StdOut, out)
+				return nil
+			}
+
+			oldVersion, err = api.GetMRDiffVersion(apiClient, repo.FullName(), mr.IID, oldVersion.ID)
+			if err != nil {
+				return err
+			}
+			newVersion, err = api.GetMRDiffVersion(apiClient, repo.FullName(), mr.IID, newVersion.ID)
+			if err != nil {
+				return err
+			}
+
+			patch := func(sha string) (string, error) {
+				diffs, err := api.GetCommitDiff(apiClient, repo.FullName(), sha)
+				if err != nil {
+					return "", err
+				}
+				return normalizePatch(diffs), nil
+			}
+
+			return printRangeDiff(opts.IO, oldestFirst(oldVersion.Commits), oldestFirst(newVersion.Commits), patch)
+		},
+	}
+
+	return mrRangeDiffCmd
+}
+
+func parseVersion(arg string) (int, error) {
+	n, err := strconv.Atoi(arg)
+	if err != nil || n < 1 {
+		return 0, &cmdutils.FlagError{Err: fmt.Errorf("invalid version %q. Versions are numbered from 1.", arg)}
+	}
+	return n, nil
+}
+
+func localCommitsAvailable(versions ...*gitlab.MergeRequestDiffVersion) bool {
+	for _, v := range versions {
+		if !commitExists(v.BaseCommitSHA) || !commitExists(v.HeadCommitSHA) {
+			return false
+		}
+	}
+	return true
+}
+
+// oldestFirst returns the commits of a diff version, which the API lists most recent first, in commit order.
+func oldestFirst(commits []*gitlab.Commit) []*gitlab.Commit {
+	ordered := make([]*gitlab.Commit, len(commits))
+	for i, c := range commits {
+		ordered[len(commits)-1-i] = c
+	}
+	return ordered
+}
+
+var hunkRangeRE = regexp.MustCompile(`^@@ -\d+(,\d+)? \+\d+(,\d+)? @@`)
+
+// normalizePatch renders the diffs of a commit without line numbers, so that
+// the same change applied at a different position compares as equal.
+func normalizePatch(diffs []*gitlab.Diff) string {
+	var b strings.Builder
+	for _, d := range diffs {
+		fmt.Fprintf(&b, "## %s\n", d.NewPath)
+		for _, line := range strings.Split(strings.TrimSuffix(d.Diff, "\n"), "\n") {
+			b.WriteString(hunkRangeRE.ReplaceAllString(line, "@@"))
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// pairing is one line of range-diff output: a commit of the old version, the new version, or both.
+type pairing struct {
+	oldIndex int
+	old      *gitlab.Commit
+	newIndex int
+	new      *gitlab.Commit
+}
+
+// pairCommits matches the commits of both versions by title. Unmatched old commits
+// are listed before the first new commit that follows them, like 'git range-diff' does.
+func pairCommits(oldCommits, newCommits []*gitlab.Commit) []pairing {
+	matched := make([]bool, len(oldCommits))
+	newToOld := make([]int, len(newCommits))
+	for i, nc := range newCommits {
+		newToOld[i] = -1
+		for j, oc := range oldCommits {
+			if !matched[j] && oc.Title == nc.Title {
+				matched[j] = true
+				newToOld[i] = j
+				break
+			}
+		}
+	}
+
+	var pairs []pairing
+	nextOld := 0
+	flushOld := func(upTo int) {
+		for ; nextOld < upTo; nextOld++ {
+			if !matched[nextOld] {
+				pairs = append(pairs, pairing{oldIndex: nextOld + 1, old: oldCommits[nextOld]})
+			}
+		}
+	}
+
+	for i, nc := range newCommits {
+		p := pairing{newIndex: i + 1, new: nc}
+		if j := newToOld[i]; j >= 0 {
+			flushOld(j)
+			p.oldIndex = j + 1
+			p.old = oldCommits[j]
+		}
+		pairs = append(pairs, p)
+	}
+	flushOld(len(oldCommits))
+
+	return pairs
+}
+
+func printRangeDiff(ios *iostreams.IOStreams, oldCommits, newCommits []*gitlab.Commit, patch func(sha string) (string, error)) error {
+	c := ios.Color()
+	width := len(strconv.Itoa(max(len(oldCommits), len(newCommits))))
+
+	side := func(index int, commit *gitlab.Commit) string {
+		if commit == nil {
+			return fmt.Sprintf("%*s:  %s", width, "-", strings.Repeat("-", 7))
+		}
+		return fmt.Sprintf("%*d:  %s", width, index, shortSHA(commit.ID))
+	}
+
+	for _, p := range pairCommits(oldCommits, newCommits) {
+		var marker, title string
+		var interdiff []string
+
+		switch {
+		case p.new == nil:
+			marker, title = c.Red("<"), p.old.Title
+		case p.old == nil:
+			marker, title = c.Green(">"), p.new.Title
+		case p.old.ID == p.new.ID:
+			marker, title = "=", p.new.Title
+		default:
+			title = p.new.Title
+			oldPatch, err := patch(p.old.ID)
+			if err != nil {
+				return err
+			}
+			newPatch, err := patch(p.new.ID)
+			if err != nil {
+				return err
+			}
+			if oldPatch == newPatch {
+				marker = "="
+			} else {
+				marker = c.Yellow("!")
+				interdiff = lineDiff(oldPatch, newPatch)
+			}
+		}
+
+		fmt.Fprintf(ios.StdOut, "%s %s %s %s\n", side(p.oldIndex, p.old), marker, side(p.newIndex, p.new), title)
+		writeInterdiff(ios.StdOut, c, interdiff)
+	}
+
+	return nil
+}
+
+func writeInterdiff(w io.Writer, c *iostreams.ColorPalette, lines []string) {
+	for _, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			line = c.Red(line)
+		case strings.HasPrefix(line, "+"):
+			line = c.Green(line)
+		}
+		fmt.Fprintf(w, "    %s\n", line)
+	}
+}
+
+// lineDiff returns the lines that differ between two patches, prefixed with "-" or "+".
+func lineDiff(oldText, newText string) []string {
+	a := strings.Split(strings.TrimSuffix(oldText, "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(newText, "\n"), "\n")
+
+	if len(a)*len(b) > maxPatchDiffCells {
+		return []string{"(patches are too large to compare)"}
+	}
+
+	var out []string
+	for _, edit := range text.Diff(a, b) {
+		switch edit.Op {
+		case text.DiffRemoved:
+			out = append(out, "-"+edit.Text)
+		case text.DiffAdded:
+			out = append(out, "+"+edit.Text)
+		}
+	}
+	return out
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,182 @@
+package rangediff
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdRangeDiff(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func stubLocalGit(t *testing.T, available bool, output string) *[]string {
+	t.Helper()
+
+	var ranges []string
+	origExists, origRangeDiff := commitExists, localRangeDiff
+	t.Cleanup(func() {
+		commitExists, localRangeDiff = origExists, origRangeDiff
+	})
+
+	commitExists = func(string) bool { return available }
+	localRangeDiff = func(oldRange, newRange string, _ bool) (string, error) {
+		ranges = append(ranges, oldRange, newRange)
+		return output, nil
+	}
+	return &ranges
+}
+
+func TestMrRangeDiffLocal(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 12, "head_commit_sha": "d2d2d2d2", "base_commit_sha": "b0b0b0b0"},
+			{"id": 11, "head_commit_sha": "c1c1c1c1", "base_commit_sha": "a0a0a0a0"}
+		]`))
+
+	ranges := stubLocalGit(t, true, "1:  c1c1c1c = 1:  d2d2d2d Add foo\n")
+
+	output, err := runCommand(fakeHTTP, "1 2 123")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a0a0a0a0..c1c1c1c1", "b0b0b0b0..d2d2d2d2"}, *ranges)
+	assert.Equal(t, "1:  c1c1c1c = 1:  d2d2d2d Add foo\n", output.String())
+}
+
+func TestMrRangeDiffAPI(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 12, "head_commit_sha": "d2d2d2d2", "base_commit_sha": "b0b0b0b0"},
+			{"id": 11, "head_commit_sha": "c1c1c1c1", "base_commit_sha": "a0a0a0a0"}
+		]`))
+
+	stubLocalGit(t, false, "")
+
+	// Commits are listed most recent first.
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions/11`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 11, "commits": [
+			{"id": "c1c1c1c1", "title": "Remove bar"},
+			{"id": "b1b1b1b1", "title": "Fix typo"},
+			{"id": "a1a1a1a1", "title": "Add foo"}
+		]}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions/12`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 12, "commits": [
+			{"id": "d2d2d2d2", "title": "Add baz"},
+			{"id": "c2c2c2c2", "title": "Remove bar"},
+			{"id": "a2a2a2a2", "title": "Add foo"}
+		]}`))
+
+	// The rebased "Add foo" applies at another position, but its patch is unchanged.
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/a1a1a1a1/diff`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"new_path": "foo.go", "diff": "@@ -1,1 +1,2 @@\n package foo\n+var Foo = 1\n"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/a2a2a2a2/diff`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"new_path": "foo.go", "diff": "@@ -3,1 +3,2 @@\n package foo\n+var Foo = 1\n"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/c1c1c1c1/diff`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"new_path": "bar.go", "diff": "@@ -1,2 +1,1 @@\n package bar\n-var Bar = 1\n"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/commits/c2c2c2c2/diff`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"new_path": "bar.go", "diff": "@@ -1,2 +1,1 @@\n package bar\n-var Bar = 2\n"}]`))
+
+	output, err := runCommand(fakeHTTP, "1 2 123")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		1:  a1a1a1a = 1:  a2a2a2a Add foo
+		2:  b1b1b1b < -:  ------- Fix typo
+		3:  c1c1c1c ! 2:  c2c2c2c Remove bar
+		    --var Bar = 1
+		    +-var Bar = 2
+		-:  ------- > 3:  d2d2d2d Add baz
+	`), output.String())
+}
+
+func TestMrRangeDiffInvalidVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{name: "not a number", args: "one 2 123", wantErr: `invalid version "one". Versions are numbered from 1.`},
+		{name: "zero", args: "0 2 123", wantErr: `invalid version "0". Versions are numbered from 1.`},
+		{name: "same version", args: "2 2 123", wantErr: "the two versions must be different."},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runCommand(fakeHTTP, tc.args)
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr, err.Error())
+		})
+	}
+}
+
+func TestMrRangeDiffVersionOutOfRange(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 12, "head_commit_sha": "d2d2d2d2", "base_commit_sha": "b0b0b0b0"},
+			{"id": 11, "head_commit_sha": "c1c1c1c1", "base_commit_sha": "a0a0a0a0"}
+		]`))
+
+	_, err := runCommand(fakeHTTP, "1 3 123")
+	require.Error(t, err)
+	assert.Equal(t, "version 3 does not exist. Merge request !123 has 2 versions.", err.Error())
+}
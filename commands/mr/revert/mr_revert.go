@@ -0,0 +1,69 @@
+package revert
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/commit/commitutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+)
+
+func NewCmdRevert(f *cmdutils.Factory) *cobra.Command {
+	opts := &commitutils.ApplyOptions{
+		Operation: commitutils.Revert,
+		IO:        f.IO,
+	}
+
+	mrRevertCmd := &cobra.Command{
+		Use:   "revert [<id> | <branch>] [flags]",
+		Short: `Revert a merged merge request.`,
+		Long: heredoc.Doc(`
+			Revert the changes of a merged merge request, through the API.
+
+			The merge commit or squash commit of the merge request is reverted. If the merge
+			request was merged without one, each of its commits is reverted, most recent first.
+			Several commits are first applied to a temporary branch, which is deleted afterwards,
+			so a conflict leaves the target branch unchanged.
+
+			By default, the changes are reverted on the target branch of the merge request.
+			Use --create-mr to revert them on a new branch, and open a merge request with the
+			labels of the original merge request and a reference to it.
+		`),
+		Example: heredoc.Doc(`
+			glab mr revert 123
+			glab mr revert 123 --create-mr
+			glab mr revert 123 --target stable-16-0 --dry-run
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+			if err != nil {
+				return err
+			}
+
+			opts.Commits, err = commitutils.MRCommits(apiClient, repo, mr, opts.Operation)
+			if err != nil {
+				return err
+			}
+			opts.SourceMR = mr
+			if opts.TargetBranch == "" {
+				opts.TargetBranch = mr.TargetBranch
+			}
+
+			return commitutils.Apply(apiClient, repo, opts)
+		},
+	}
+
+	mrRevertCmd.Flags().StringVarP(&opts.TargetBranch, "target", "t", "", "Branch to revert the changes on. Defaults to the target branch of the merge request.")
+	mrRevertCmd.Flags().BoolVar(&opts.CreateMR, "create-mr", false, "Revert on a new branch, and create a merge request into the target branch.")
+	mrRevertCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Only check whether the changes can be reverted without conflicts.")
+	mrRevertCmd.MarkFlagsMutuallyExclusive("create-mr", "dry-run")
+
+	return mrRevertCmd
+}
@@ -0,0 +1,152 @@
+package revert
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdRevert(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestMrRevertFastForwarded(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "title": "Add feature", "state": "merged", "target_branch": "main"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/commits`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": "bbbbbbbbbbbb", "title": "Second"}, {"id": "aaaaaaaaaaaa", "title": "First"}]`))
+
+	// The commits are reverted on a temporary branch first, most recent first.
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, `/api/v4/projects/OWNER/REPO/repository/branches`,
+		`{"branch": "glab-tmp-revert-bbbbbbbb-main", "ref": "main"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"name": "glab-tmp-revert-bbbbbbbb-main", "commit": {"id": "eeeeeeeeeeee"}}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, `/api/v4/projects/OWNER/REPO/repository/commits/bbbbbbbbbbbb/revert`,
+		`{"branch": "glab-tmp-revert-bbbbbbbb-main"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "ffffffffffff"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, `/api/v4/projects/OWNER/REPO/repository/commits/aaaaaaaaaaaa/revert`,
+		`{"branch": "glab-tmp-revert-bbbbbbbb-main"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "999999999999"}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, `/projects/OWNER/REPO/repository/branches/glab-tmp-revert-bbbbbbbb-main`,
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/repository/branches/main`,
+		httpmock.NewStringResponse(http.StatusOK, `{"name": "main", "commit": {"id": "eeeeeeeeeeee"}}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, `/api/v4/projects/OWNER/REPO/repository/commits/bbbbbbbbbbbb/revert`,
+		`{"branch": "main"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "cccccccccccc"}`))
+	fakeHTTP.RegisterResponderWithBody(http.MethodPost, `/api/v4/projects/OWNER/REPO/repository/commits/aaaaaaaaaaaa/revert`,
+		`{"branch": "main"}`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "dddddddddddd"}`))
+
+	output, err := runCommand(fakeHTTP, "123")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ Reverted bbbbbbbb onto main as cccccccc.
+		✓ Reverted aaaaaaaa onto main as dddddddd.
+	`), output.String())
+}
+
+func TestMrRevertFastForwardedConflict(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "title": "Add feature", "state": "merged", "target_branch": "main"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/commits`,
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": "bbbbbbbbbbbb", "title": "Second"}, {"id": "aaaaaaaaaaaa", "title": "First"}]`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/branches`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"name": "glab-tmp-revert-bbbbbbbb-main", "commit": {"id": "eeeeeeeeeeee"}}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/bbbbbbbbbbbb/revert`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "ffffffffffff"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/aaaaaaaaaaaa/revert`,
+		httpmock.NewStringResponse(http.StatusBadRequest, `{"message": "Sorry, we cannot revert this commit automatically."}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, `/projects/OWNER/REPO/repository/branches/glab-tmp-revert-bbbbbbbb-main`,
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(fakeHTTP, "123")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	assert.Empty(t, output.String())
+	assert.Equal(t, heredoc.Doc(`
+		x Could not revert aaaaaaaa onto main: Sorry, we cannot revert this commit automatically.
+		No commits were applied to main.
+	`), output.Stderr())
+}
+
+func TestMrRevertDryRunConflict(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123, "title": "Add feature", "state": "merged", "target_branch": "main"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/commits`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": "bbbbbbbbbbbb", "title": "Third"},
+			{"id": "aaaaaaaaaaaa", "title": "Second"},
+			{"id": "999999999999", "title": "First"}
+		]`))
+
+	// Each commit is checked on top of the ones before it, on a temporary branch.
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/branches`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"name": "glab-tmp-revert-bbbbbbbb-stable", "commit": {"id": "eeeeeeeeeeee"}}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/bbbbbbbbbbbb/revert`,
+		httpmock.NewStringResponse(http.StatusCreated, `{"id": "ffffffffffff"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, `/projects/OWNER/REPO/repository/commits/aaaaaaaaaaaa/revert`,
+		httpmock.NewStringResponse(http.StatusBadRequest, `{
+			"message": "Sorry, we cannot revert this commit automatically.",
+			"error_code": "conflict"
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodDelete, `/projects/OWNER/REPO/repository/branches/glab-tmp-revert-bbbbbbbb-stable`,
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(fakeHTTP, "123 --target stable --dry-run")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	assert.Equal(t, heredoc.Doc(`
+		✓ bbbbbbbb can be reverted onto stable.
+		x aaaaaaaa cannot be reverted onto stable: Sorry, we cannot revert this commit automatically.
+		- 99999999 was not checked, as it comes after a conflict.
+	`), output.String())
+}
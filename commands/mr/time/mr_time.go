@@ -0,0 +1,33 @@
+package time
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	timeEstimateCmd "gitlab.com/gitlab-org/cli/commands/timetracking/estimate"
+	timeReportCmd "gitlab.com/gitlab-org/cli/commands/timetracking/report"
+	timeResetCmd "gitlab.com/gitlab-org/cli/commands/timetracking/reset"
+	timeSpendCmd "gitlab.com/gitlab-org/cli/commands/timetracking/spend"
+)
+
+func NewCmdTime(f *cmdutils.Factory) *cobra.Command {
+	mrTimeCmd := &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: `Track the time estimate and the time spent of a merge request.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab mr time estimate 12 2d
+			glab mr time spend 12 1h 30m --summary "Review"
+			glab mr time report --by user --output csv
+		`),
+	}
+
+	mrTimeCmd.AddCommand(timeEstimateCmd.NewCmdEstimate(f, timetracking.KindMR))
+	mrTimeCmd.AddCommand(timeSpendCmd.NewCmdSpend(f, timetracking.KindMR))
+	mrTimeCmd.AddCommand(timeResetCmd.NewCmdReset(f, timetracking.KindMR))
+	mrTimeCmd.AddCommand(timeReportCmd.NewCmdReport(f, timetracking.KindMR))
+
+	return mrTimeCmd
+}
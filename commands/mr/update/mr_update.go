@@ -15,16 +15,33 @@ import (
 )
 
 func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
+	bulkOpts := &cmdutils.BulkOptions{}
+
 	mrUpdateCmd := &cobra.Command{
-		Use:   "update [<id> | <branch>]",
+		Use:   "update [<id> | <branch> | -]",
 		Short: `Update a merge request.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+		Update a merge request, or several merge requests at once.
+
+		To update several merge requests, select them with '--filter', which takes the
+		flags of 'glab mr list', or pass '-' as the ID to read IDs from the standard input.
+		Only labels, milestone, assignees, reviewers, state, and discussion locking can be
+		changed for several merge requests. The merge requests and the changes are shown
+		before they are applied, and a JSON report of the updated and failed merge requests
+		is printed when the update ends.
+		`),
 		Example: heredoc.Doc(`
 	$ glab mr update 23 --ready
 	$ glab mr update 23 --draft
 
 	# Updates the merge request for the current branch
 	$ glab mr update --draft
+
+	# Moves the open merge requests of a milestone to the next one
+	$ glab mr update --filter '--milestone 1.0' --milestone 1.1
+
+	# Adds a reviewer to merge requests read from the standard input
+	$ echo "12 15 18" | glab mr update - --reviewer +jdoe --yes
 	`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -72,6 +89,14 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 				}
 			}
 
+			if cmd.Flags().Changed("close") && cmd.Flags().Changed("reopen") {
+				return &cmdutils.FlagError{Err: errors.New("--close and --reopen can't be used together.")}
+			}
+
+			if bulkOpts.IsBulk(args) {
+				return bulkUpdateRun(f, cmd, bulkOpts, ua, ur, args)
+			}
+
 			apiClient, err := f.HttpClient()
 			if err != nil {
 				return err
@@ -180,6 +205,14 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 					l.MilestoneID = gitlab.Ptr(0)
 				}
 			}
+			if m, _ := cmd.Flags().GetBool("close"); m {
+				actions = append(actions, "closed")
+				l.StateEvent = gitlab.Ptr("close")
+			}
+			if m, _ := cmd.Flags().GetBool("reopen"); m {
+				actions = append(actions, "reopened")
+				l.StateEvent = gitlab.Ptr("reopen")
+			}
 			if cmd.Flags().Changed("unassign") {
 				l.AssigneeIDs = &[]int{0} // 0 or an empty int[] is the documented way to unassign
 				actions = append(actions, "unassigned all users")
@@ -269,6 +302,9 @@ func NewCmdUpdate(f *cmdutils.Factory) *cobra.Command {
 	mrUpdateCmd.Flags().BoolP("remove-source-branch", "", false, "Toggles the removal of the source branch on merge.")
 	mrUpdateCmd.Flags().StringP("milestone", "m", "", "Title of the milestone to assign. Set to \"\" or 0 to unassign.")
 	mrUpdateCmd.Flags().String("target-branch", "", "Set target branch.")
+	mrUpdateCmd.Flags().Bool("close", false, "Close the merge request.")
+	mrUpdateCmd.Flags().Bool("reopen", false, "Reopen the merge request.")
+	cmdutils.AddBulkFlags(mrUpdateCmd, bulkOpts, "merge requests")
 
 	return mrUpdateCmd
 }
@@ -0,0 +1,181 @@
+package update
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	mrListCmd "gitlab.com/gitlab-org/cli/commands/mr/list"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+)
+
+// singleMRFlags are the flags that can't be used to update several merge requests at once.
+var singleMRFlags = []string{"title", "description", "draft", "wip", "ready", "target-branch", "squash-before-merge", "remove-source-branch"}
+
+// bulkUpdateRun updates all merge requests matched by --filter, or whose IDs are read from standard input.
+func bulkUpdateRun(f *cmdutils.Factory, cmd *cobra.Command, opts *cmdutils.BulkOptions, ua, ur *cmdutils.UserAssignments, args []string) error {
+	for _, name := range singleMRFlags {
+		if cmd.Flags().Changed(name) {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--%s can't be used to update several merge requests.", name)}
+		}
+	}
+	if err := opts.Validate(f.IO, args); err != nil {
+		return err
+	}
+
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	var changes []string
+	l := gitlab.UpdateMergeRequestOptions{}
+
+	if m, _ := cmd.Flags().GetBool("lock-discussion"); m {
+		changes = append(changes, "lock discussion")
+		l.DiscussionLocked = gitlab.Ptr(true)
+	}
+	if m, _ := cmd.Flags().GetBool("unlock-discussion"); m {
+		changes = append(changes, "unlock discussion")
+		l.DiscussionLocked = gitlab.Ptr(false)
+	}
+	if m, _ := cmd.Flags().GetStringSlice("label"); len(m) != 0 {
+		changes = append(changes, fmt.Sprintf("add labels %s", strings.Join(m, " ")))
+		l.AddLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetStringSlice("unlabel"); len(m) != 0 {
+		changes = append(changes, fmt.Sprintf("remove labels %s", strings.Join(m, " ")))
+		l.RemoveLabels = (*gitlab.LabelOptions)(&m)
+	}
+	if m, _ := cmd.Flags().GetBool("close"); m {
+		changes = append(changes, "close")
+		l.StateEvent = gitlab.Ptr("close")
+	}
+	if m, _ := cmd.Flags().GetBool("reopen"); m {
+		changes = append(changes, "reopen")
+		l.StateEvent = gitlab.Ptr("reopen")
+	}
+
+	milestone, _ := cmd.Flags().GetString("milestone")
+	hasMilestone := cmd.Flags().Changed("milestone") && milestone != "" && milestone != "0"
+	if cmd.Flags().Changed("milestone") {
+		if hasMilestone {
+			changes = append(changes, fmt.Sprintf("set milestone %q", milestone))
+		} else {
+			changes = append(changes, "unassign milestone")
+			l.MilestoneID = gitlab.Ptr(0)
+		}
+	}
+
+	if cmd.Flags().Changed("unassign") {
+		changes = append(changes, "unassign all users")
+		l.AssigneeIDs = &[]int{0}
+	}
+	var addedAssignees []*gitlab.User
+	if ua != nil {
+		if len(ua.ToReplace) != 0 {
+			l.AssigneeIDs, _, err = ua.UsersFromReplaces(apiClient, nil)
+			if err != nil {
+				return err
+			}
+		} else if len(ua.ToAdd) != 0 {
+			addedAssignees, err = api.UsersByNames(apiClient, ua.ToAdd)
+			if err != nil {
+				return err
+			}
+		}
+		changes = append(changes, ua.BulkChanges()...)
+	}
+	var addedReviewers []*gitlab.User
+	if ur != nil {
+		if len(ur.ToReplace) != 0 {
+			l.ReviewerIDs, _, err = ur.UsersFromReplaces(apiClient, nil)
+			if err != nil {
+				return err
+			}
+		} else if len(ur.ToAdd) != 0 {
+			addedReviewers, err = api.UsersByNames(apiClient, ur.ToAdd)
+			if err != nil {
+				return err
+			}
+		}
+		changes = append(changes, ur.BulkChanges()...)
+	}
+
+	if len(changes) == 0 {
+		return &cmdutils.FlagError{Err: fmt.Errorf("no changes to apply. Use flags like '--label' or '--milestone'.")}
+	}
+
+	mrs, failed, err := bulkMRs(f, opts)
+	if err != nil {
+		return err
+	}
+
+	// milestones are looked up by title in the project of each merge request.
+	milestones := map[int]int{}
+	if hasMilestone {
+		for _, mr := range mrs {
+			if _, ok := milestones[mr.ProjectID]; ok {
+				continue
+			}
+			milestones[mr.ProjectID], err = cmdutils.BulkMilestoneID(apiClient, mr.ProjectID, milestone)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	items := make([]cmdutils.BulkItem, 0, len(mrs))
+	for _, mr := range mrs {
+		mrOpts := l
+		if hasMilestone {
+			mrOpts.MilestoneID = gitlab.Ptr(milestones[mr.ProjectID])
+		}
+		if ua != nil && len(ua.ToReplace) == 0 {
+			mrOpts.AssigneeIDs = ua.BulkUserIDs(mr.Assignees, addedAssignees)
+		}
+		if ur != nil && len(ur.ToReplace) == 0 {
+			mrOpts.ReviewerIDs = ur.BulkUserIDs(mr.Reviewers, addedReviewers)
+		}
+
+		items = append(items, cmdutils.BulkItem{
+			Reference: mrutils.MRReference(mr),
+			Title:     mr.Title,
+			WebURL:    mr.WebURL,
+			Update: func() error {
+				_, err := api.UpdateMR(apiClient, mr.ProjectID, mr.IID, &mrOpts)
+				return err
+			},
+		})
+	}
+
+	items = append(items, failed...)
+
+	return cmdutils.RunBulk(f.IO, opts, "merge requests", items, changes)
+}
+
+// bulkMRs returns all the merge requests listed by 'mr list' with the flags of --filter, or
+// the merge requests whose IDs are read from standard input. IDs that can't be fetched are
+// returned as failed items.
+func bulkMRs(f *cmdutils.Factory, opts *cmdutils.BulkOptions) ([]*gitlab.MergeRequest, []cmdutils.BulkItem, error) {
+	if opts.Filter == "" {
+		ids, err := cmdutils.ReadBulkArgs(f.IO.In)
+		if err != nil {
+			return nil, nil, err
+		}
+		mrs, failed := cmdutils.FetchBulkItems(opts, ids, func(id string) (*gitlab.MergeRequest, error) {
+			mr, _, err := mrutils.MRFromArgs(f, []string{id}, "any")
+			return mr, err
+		})
+		return mrs, failed, nil
+	}
+
+	mrs, err := cmdutils.ListMatches[*gitlab.MergeRequest](f, func(f *cmdutils.Factory) *cobra.Command {
+		return mrListCmd.NewCmdList(f, nil)
+	}, opts.Filter, "merge requests")
+	return mrs, nil, err
+}
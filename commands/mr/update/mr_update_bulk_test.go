@@ -0,0 +1,95 @@
+package update
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runBulkCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdUpdate(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestMrUpdateBulk(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"iid": 1, "project_id": 3, "title": "First", "references": {"full": "OWNER/REPO!1"}},
+			{"iid": 2, "project_id": 3, "title": "Second", "references": {"full": "OWNER/REPO!2"}}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/3/milestones",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 8, "title": "1.1"}]`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/merge_requests/1",
+		httpmock.NewStringResponse(http.StatusOK, `{"iid": 1}`))
+	fakeHTTP.RegisterResponder(http.MethodPut, "/api/v4/projects/3/merge_requests/2",
+		httpmock.NewStringResponse(http.StatusForbidden, `{"message": "403 Forbidden"}`))
+
+	output, err := runBulkCommand(t, fakeHTTP, `--filter "--milestone 1.0" --milestone 1.1 --label backport --yes`)
+	require.ErrorIs(t, err, cmdutils.SilentError)
+
+	assert.Contains(t, output.Stderr(), "2 merge requests")
+	assert.Contains(t, output.Stderr(), "- add labels backport\n- set milestone \"1.1\"")
+
+	var report cmdutils.BulkReport
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, 2, report.Matched)
+	require.Len(t, report.Updated, 1)
+	assert.Equal(t, "OWNER/REPO!1", report.Updated[0].Reference)
+	require.Len(t, report.Failed, 1)
+	assert.Equal(t, "OWNER/REPO!2", report.Failed[0].Reference)
+	assert.Contains(t, report.Failed[0].Error, "403")
+}
+
+func TestMrUpdateBulkFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		cli     string
+		wantErr string
+	}{
+		{
+			name:    "single merge request flag",
+			cli:     `--filter "--label bug" --title "New title" --yes`,
+			wantErr: "--title can't be used to update several merge requests.",
+		},
+		{
+			name:    "no changes",
+			cli:     `--filter "--label bug" --yes`,
+			wantErr: "no changes to apply. Use flags like '--label' or '--milestone'.",
+		},
+		{
+			name:    "not interactive",
+			cli:     `--filter "--label bug" --close`,
+			wantErr: "--yes or -y flag is required when not running interactively.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runBulkCommand(t, fakeHTTP, tc.cli)
+			require.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package versions
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type VersionsOpts struct {
+	OutputFormat string
+
+	IO *iostreams.IOStreams
+}
+
+// Version is a diff version of a merge request, numbered from the oldest.
+type Version struct {
+	Version        int        `json:"version"`
+	ID             int        `json:"id"`
+	HeadCommitSHA  string     `json:"head_commit_sha"`
+	BaseCommitSHA  string     `json:"base_commit_sha"`
+	StartCommitSHA string     `json:"start_commit_sha"`
+	CreatedAt      *time.Time `json:"created_at"`
+	State          string     `json:"state"`
+	Latest         bool       `json:"latest"`
+}
+
+func NewCmdVersions(f *cmdutils.Factory) *cobra.Command {
+	opts := &VersionsOpts{
+		IO: f.IO,
+	}
+
+	mrVersionsCmd := &cobra.Command{
+		Use:   "versions [<id> | <branch>] [flags]",
+		Short: `List the diff versions of a merge request.`,
+		Long: heredoc.Doc(`
+			List the diff versions of a merge request. GitLab creates a new version
+			each time commits are pushed to the source branch.
+
+			Versions are numbered from the oldest, starting at 1. Use the numbers with
+			'glab mr diff --since-version' and 'glab mr range-diff'.
+		`),
+		Example: heredoc.Doc(`
+			glab mr versions 123
+			glab mr versions feature-branch --output json
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+			if err != nil {
+				return err
+			}
+
+			diffVersions, err := api.ListMRDiffVersions(apiClient, repo.FullName(), mr.IID)
+			if err != nil {
+				return err
+			}
+
+			// The API returns the most recent version first.
+			versions := make([]Version, 0, len(diffVersions))
+			for i, v := range diffVersions {
+				versions = append(versions, Version{
+					Version:        len(diffVersions) - i,
+					ID:             v.ID,
+					HeadCommitSHA:  v.HeadCommitSHA,
+					BaseCommitSHA:  v.BaseCommitSHA,
+					StartCommitSHA: v.StartCommitSHA,
+					CreatedAt:      v.CreatedAt,
+					State:          v.State,
+					Latest:         i == 0,
+				})
+			}
+
+			if opts.OutputFormat == "json" {
+				out, err := json.Marshal(versions)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(opts.IO.StdOut, string(out))
+				return nil
+			}
+
+			if len(versions) == 0 {
+				fmt.Fprintf(opts.IO.StdOut, "Merge request !%d has no diff versions.\n", mr.IID)
+				return nil
+			}
+
+			c := opts.IO.Color()
+			fmt.Fprintf(opts.IO.StdOut, "Showing %s of merge request !%d.\n\n",
+				utils.Pluralize(len(versions), "version"), mr.IID)
+
+			table := tableprinter.NewTablePrinter()
+			for _, v := range versions {
+				label := fmt.Sprintf("v%d", v.Version)
+				if v.Latest {
+					label += " (latest)"
+				}
+				createdAt := ""
+				if v.CreatedAt != nil {
+					createdAt = utils.TimeToPrettyTimeAgo(*v.CreatedAt)
+				}
+				table.AddRow(c.Bold(label), shortSHA(v.HeadCommitSHA), c.Gray("base "+shortSHA(v.BaseCommitSHA)), c.Gray(createdAt))
+			}
+			fmt.Fprint(opts.IO.StdOut, table.Render())
+
+			return nil
+		},
+	}
+
+	mrVersionsCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return mrVersionsCmd
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
@@ -0,0 +1,102 @@
+package versions
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	ios, _, stdout, stderr := iostreams.Test()
+
+	factory := &cmdutils.Factory{
+		IO: ios,
+		HttpClient: func() (*gitlab.Client, error) {
+			a, err := api.TestClient(&http.Client{Transport: rt}, "", "", false)
+			if err != nil {
+				return nil, err
+			}
+			return a.Lab(), err
+		},
+		BaseRepo: func() (glrepo.Interface, error) {
+			return glrepo.New("OWNER", "REPO"), nil
+		},
+	}
+
+	_, _ = factory.HttpClient()
+
+	cmd := NewCmdVersions(factory)
+
+	argv, err := shlex.Split(cli)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(argv)
+
+	_, err = cmd.ExecuteC()
+	return &test.CmdOut{
+		OutBuf: stdout,
+		ErrBuf: stderr,
+	}, err
+}
+
+func TestMrVersions(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 12, "head_commit_sha": "cccccccccccc", "base_commit_sha": "aaaaaaaaaaaa", "start_commit_sha": "aaaaaaaaaaaa", "created_at": "2024-02-01T10:00:00Z", "state": "collected"},
+			{"id": 11, "head_commit_sha": "bbbbbbbbbbbb", "base_commit_sha": "aaaaaaaaaaaa", "start_commit_sha": "aaaaaaaaaaaa", "created_at": "2024-01-01T10:00:00Z", "state": "collected"}
+		]`))
+
+	output, err := runCommand(fakeHTTP, "123")
+	require.NoError(t, err)
+
+	out := output.String()
+	assert.Contains(t, out, "Showing 2 versions of merge request !123.")
+	assert.Regexp(t, `v2 \(latest\)\s+cccccccc\s+base aaaaaaaa`, out)
+	assert.Regexp(t, `v1\s+bbbbbbbb\s+base aaaaaaaa`, out)
+	assert.Empty(t, output.Stderr())
+}
+
+func TestMrVersionsJSON(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123`,
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 123, "iid": 123}`))
+
+	fakeHTTP.RegisterResponder(http.MethodGet, `/projects/OWNER/REPO/merge_requests/123/versions`,
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 12, "head_commit_sha": "cccccccccccc", "base_commit_sha": "aaaaaaaaaaaa", "start_commit_sha": "aaaaaaaaaaaa", "created_at": "2024-02-01T10:00:00Z", "state": "collected"},
+			{"id": 11, "head_commit_sha": "bbbbbbbbbbbb", "base_commit_sha": "aaaaaaaaaaaa", "start_commit_sha": "aaaaaaaaaaaa", "created_at": "2024-01-01T10:00:00Z", "state": "collected"}
+		]`))
+
+	output, err := runCommand(fakeHTTP, "123 --output json")
+	require.NoError(t, err)
+
+	var versions []Version
+	require.NoError(t, json.Unmarshal(output.OutBuf.Bytes(), &versions))
+
+	require.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.Equal(t, 12, versions[0].ID)
+	assert.True(t, versions[0].Latest)
+	assert.Equal(t, 1, versions[1].Version)
+	assert.Equal(t, "bbbbbbbbbbbb", versions[1].HeadCommitSHA)
+	assert.False(t, versions[1].Latest)
+}
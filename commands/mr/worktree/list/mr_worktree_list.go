@@ -0,0 +1,67 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	worktreeListCmd := &cobra.Command{
+		Use:     "list",
+		Short:   `List the worktrees of merge requests, and the state of each merge request.`,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab mr worktree list
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO.Color()
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			worktrees, err := mrutils.MRWorktrees()
+			if err != nil {
+				return err
+			}
+
+			if len(worktrees) == 0 {
+				fmt.Fprintln(f.IO.StdOut, "No merge request worktrees. Create one with 'glab mr checkout <id> --worktree'.")
+				return nil
+			}
+
+			fmt.Fprintf(f.IO.StdOut, "Showing %s.\n\n", utils.Pluralize(len(worktrees), "worktree"))
+
+			table := tableprinter.NewTablePrinter()
+			for _, wt := range worktrees {
+				path := wt.Path
+				if wt.Prunable {
+					path += c.Gray(" (missing)")
+				}
+
+				mr, err := api.GetMR(apiClient, wt.Project, wt.IID, nil)
+				if err != nil {
+					table.AddRow(fmt.Sprintf("!%d", wt.IID), c.Gray("unknown"), wt.Branch, path)
+					continue
+				}
+
+				table.AddRow(f.IO.Hyperlink(mrutils.MRState(c, mr), mr.WebURL), mr.State, wt.Branch, path)
+			}
+			fmt.Fprint(f.IO.StdOut, table.Render())
+
+			return nil
+		},
+	}
+
+	return worktreeListCmd
+}
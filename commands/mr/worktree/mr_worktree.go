@@ -0,0 +1,21 @@
+package worktree
+
+import (
+	"github.com/spf13/cobra"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	worktreeListCmd "gitlab.com/gitlab-org/cli/commands/mr/worktree/list"
+	worktreePruneCmd "gitlab.com/gitlab-org/cli/commands/mr/worktree/prune"
+)
+
+func NewCmdWorktree(f *cmdutils.Factory) *cobra.Command {
+	worktreeCmd := &cobra.Command{
+		Use:   "worktree <command> [flags]",
+		Short: `Manage the worktrees created by 'glab mr checkout --worktree'.`,
+		Long:  ``,
+	}
+
+	worktreeCmd.AddCommand(worktreeListCmd.NewCmdList(f))
+	worktreeCmd.AddCommand(worktreePruneCmd.NewCmdPrune(f))
+
+	return worktreeCmd
+}
@@ -0,0 +1,88 @@
+package prune
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func NewCmdPrune(f *cmdutils.Factory) *cobra.Command {
+	var force bool
+
+	worktreePruneCmd := &cobra.Command{
+		Use:   "prune [flags]",
+		Short: `Remove the worktrees of merged and closed merge requests.`,
+		Long: heredoc.Doc(`
+			Remove the worktrees created by 'glab mr checkout --worktree' for merge
+			requests that are merged or closed, and delete their local branches.
+
+			Worktrees with uncommitted changes, and branches with commits that are not
+			merged, are kept unless you use '--force'. Merge requests that can't be
+			fetched are skipped with a warning.
+		`),
+		Example: heredoc.Doc(`
+			glab mr worktree prune
+			glab mr worktree prune --force
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO.Color()
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			worktrees, err := mrutils.MRWorktrees()
+			if err != nil {
+				return err
+			}
+
+			removed := 0
+			for _, wt := range worktrees {
+				mr, err := api.GetMR(apiClient, wt.Project, wt.IID, nil)
+				if err != nil {
+					fmt.Fprintf(f.IO.StdErr, "%s Could not get merge request !%d, so its worktree at %s was kept: %v\n", c.WarnIcon(), wt.IID, wt.Path, err)
+					continue
+				}
+
+				if mr.State != "merged" && mr.State != "closed" {
+					continue
+				}
+
+				if wt.Prunable {
+					err = git.PruneWorktrees()
+				} else {
+					err = git.RemoveWorktree(wt.Path, force)
+				}
+				if err != nil {
+					fmt.Fprintf(f.IO.StdErr, "%s Could not remove the worktree of !%d at %s. Use '--force' to discard its changes.\n", c.WarnIcon(), wt.IID, wt.Path)
+					continue
+				}
+
+				removed++
+				fmt.Fprintf(f.IO.StdOut, "%s Removed the worktree of !%d (%s) at %s.\n", c.GreenCheck(), wt.IID, mr.State, wt.Path)
+
+				if err := git.DeleteBranch(wt.Branch, force); err != nil {
+					fmt.Fprintf(f.IO.StdErr, "%s Kept branch %s, which has commits that are not merged. Use '--force' to delete it.\n", c.WarnIcon(), wt.Branch)
+				}
+			}
+
+			if removed == 0 {
+				fmt.Fprintln(f.IO.StdOut, "No worktrees of merged or closed merge requests.")
+			}
+
+			return nil
+		},
+	}
+
+	worktreePruneCmd.Flags().BoolVarP(&force, "force", "f", false, "Remove worktrees with uncommitted changes, and delete branches with commits that are not merged.")
+
+	return worktreePruneCmd
+}
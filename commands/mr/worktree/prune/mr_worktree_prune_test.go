@@ -0,0 +1,102 @@
+package prune
+
+import (
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdPrune(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func addMRWorktree(t *testing.T, branch string, iid int) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), branch)
+
+	require.NoError(t, git.RunCmd([]string{"branch", branch}))
+	require.NoError(t, git.AddWorktree(path, branch))
+	require.NoError(t, mrutils.RegisterMRWorktree(branch, &gitlab.MergeRequest{
+		WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/" + strconv.Itoa(iid),
+	}))
+
+	return path
+}
+
+func TestMrWorktreePrune(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+
+	merged := addMRWorktree(t, "merged", 1)
+	opened := addMRWorktree(t, "opened", 2)
+
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/1",
+		httpmock.NewStringResponse(http.StatusOK, `{"iid": 1, "state": "merged"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/2",
+		httpmock.NewStringResponse(http.StatusOK, `{"iid": 2, "state": "opened"}`))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Removed the worktree of !1 (merged) at "+merged+".")
+	assert.NotContains(t, output.String(), "!2")
+
+	assert.NoDirExists(t, merged)
+	assert.DirExists(t, opened)
+	assert.False(t, git.HasLocalBranch("merged"))
+	assert.True(t, git.HasLocalBranch("opened"))
+
+	worktrees, err := mrutils.MRWorktrees()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 1)
+	assert.Equal(t, 2, worktrees[0].IID)
+}
+
+func TestMrWorktreePruneKeeps(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+
+	closed := addMRWorktree(t, "closed", 1)
+	deleted := addMRWorktree(t, "deleted", 2)
+	require.NoError(t, git.RunCmd([]string{"-C", closed, "commit", "--quiet", "--allow-empty", "-m", "unmerged"}))
+
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/1",
+		httpmock.NewStringResponse(http.StatusOK, `{"iid": 1, "state": "closed"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/merge_requests/2",
+		httpmock.NewStringResponse(http.StatusNotFound, `{"message": "404 Not found"}`))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "Removed the worktree of !1 (closed) at "+closed+".")
+	assert.Contains(t, output.Stderr(), "Kept branch closed, which has commits that are not merged. Use '--force' to delete it.")
+	assert.Contains(t, output.Stderr(), "Could not get merge request !2, so its worktree at "+deleted+" was kept: ")
+
+	assert.NoDirExists(t, closed)
+	assert.True(t, git.HasLocalBranch("closed"))
+	assert.DirExists(t, deleted)
+}
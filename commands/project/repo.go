@@ -11,6 +11,7 @@ import (
 	repoCmdList "gitlab.com/gitlab-org/cli/commands/project/list"
 	repoCmdMirror "gitlab.com/gitlab-org/cli/commands/project/mirror"
 	repoCmdSearch "gitlab.com/gitlab-org/cli/commands/project/search"
+	repoCmdSync "gitlab.com/gitlab-org/cli/commands/project/sync"
 	repoCmdTransfer "gitlab.com/gitlab-org/cli/commands/project/transfer"
 	repoCmdView "gitlab.com/gitlab-org/cli/commands/project/view"
 
@@ -33,6 +34,7 @@ func NewCmdRepo(f *cmdutils.Factory) *cobra.Command {
 	repoCmd.AddCommand(repoCmdDelete.NewCmdDelete(f))
 	repoCmd.AddCommand(repoCmdFork.NewCmdFork(f, nil))
 	repoCmd.AddCommand(repoCmdSearch.NewCmdSearch(f))
+	repoCmd.AddCommand(repoCmdSync.NewCmdSync(f))
 	repoCmd.AddCommand(repoCmdTransfer.NewCmdTransfer(f))
 	repoCmd.AddCommand(repoCmdView.NewCmdView(f))
 	repoCmd.AddCommand(repoCmdMirror.NewCmdMirror(f))
@@ -0,0 +1,274 @@
+package sync
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+)
+
+type SyncOptions struct {
+	Branches []string
+	Upstream string
+	Remote   string
+	Force    bool
+
+	IO  *iostreams.IOStreams
+	Git git.GitRunner
+}
+
+// branchSync is the state of a branch in the upstream repository, the fork, and the local repository.
+type branchSync struct {
+	Name     string
+	Upstream string
+	Fork     string
+	Local    string
+	// CheckedOut is true if the local branch is checked out in the current worktree.
+	CheckedOut bool
+}
+
+func NewCmdSync(f *cmdutils.Factory) *cobra.Command {
+	opts := &SyncOptions{
+		IO:  f.IO,
+		Git: git.StandardGitCommand{},
+	}
+
+	repoSyncCmd := &cobra.Command{
+		Use:   "sync [flags]",
+		Short: `Update the branches of a fork, and the local branches, from the upstream repository.`,
+		Long: heredoc.Doc(`
+			Fast-forward branches of a fork, and the matching local branches, to the
+			branches of the upstream repository. Run it in a clone of the fork, with an
+			'upstream' remote, like the ones created by 'glab repo fork'.
+
+			By default, syncs the default branch of the upstream repository, and every
+			local branch that tracks a branch of the fork that also exists upstream.
+
+			A branch that has diverged from upstream is not changed, unless you use
+			'--force'. With '--force', the local and fork branches are reset to the
+			upstream branch, and commits that only exist in them are lost.
+
+			A branch that is only ahead of upstream, with new commits and nothing new
+			upstream, has nothing to sync. It is never changed, even with '--force'.
+		`),
+		Example: heredoc.Doc(`
+			glab repo sync
+			glab repo sync --branch main --branch release-1.0
+			glab repo sync --upstream source --remote fork
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return syncRun(opts)
+		},
+	}
+
+	repoSyncCmd.Flags().StringSliceVarP(&opts.Branches, "branch", "b", nil, "Branches to sync. Defaults to the default branch, and the local branches that track a branch of the fork.")
+	repoSyncCmd.Flags().StringVar(&opts.Upstream, "upstream", "upstream", "Name of the remote of the upstream repository.")
+	repoSyncCmd.Flags().StringVar(&opts.Remote, "remote", git.DefaultRemote, "Name of the remote of the fork.")
+	repoSyncCmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Reset branches that have diverged from upstream.")
+
+	return repoSyncCmd
+}
+
+func syncRun(opts *SyncOptions) error {
+	c := opts.IO.Color()
+
+	for _, remote := range []string{opts.Upstream, opts.Remote} {
+		if _, err := opts.Git.Git("remote", "get-url", remote); err != nil {
+			return fmt.Errorf("no %q remote. Use '--upstream' and '--remote' to select the remotes of the upstream repository and the fork.", remote)
+		}
+	}
+
+	for _, remote := range []string{opts.Upstream, opts.Remote} {
+		if _, err := opts.Git.Git("fetch", "--quiet", remote); err != nil {
+			return fmt.Errorf("could not fetch %s: %w", remote, err)
+		}
+	}
+
+	names := opts.Branches
+	if len(names) == 0 {
+		var err error
+		names, err = trackedBranches(opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	current, _ := git.CurrentBranch()
+
+	failed := false
+	for _, name := range names {
+		b := branchSync{
+			Name:       name,
+			Upstream:   revParse(opts, "refs/remotes/"+opts.Upstream+"/"+name),
+			Fork:       revParse(opts, "refs/remotes/"+opts.Remote+"/"+name),
+			Local:      revParse(opts, "refs/heads/"+name),
+			CheckedOut: name == current,
+		}
+
+		if b.Upstream == "" {
+			fmt.Fprintf(opts.IO.StdErr, "%s %s: no such branch in %s.\n", c.FailedIcon(), name, opts.Upstream)
+			failed = true
+			continue
+		}
+
+		ok, err := syncBranch(opts, b)
+		if err != nil {
+			return fmt.Errorf("could not sync %s: %w", name, err)
+		}
+		failed = failed || !ok
+	}
+
+	if failed {
+		return cmdutils.SilentError
+	}
+
+	return nil
+}
+
+// trackedBranches returns the default branch of the upstream repository, and the
+// local branches that track a branch of the fork that also exists upstream.
+func trackedBranches(opts *SyncOptions) ([]string, error) {
+	output, err := opts.Git.Git("remote", "show", opts.Upstream)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the default branch of %s: %w", opts.Upstream, err)
+	}
+
+	defaultBranch, err := git.ParseDefaultBranch([]byte(output))
+	if err != nil {
+		return nil, fmt.Errorf("could not find the default branch of %s: %w", opts.Upstream, err)
+	}
+	branches := []string{defaultBranch}
+
+	output, err = opts.Git.Git("for-each-ref", "--format=%(upstream:remotename) %(upstream:remoteref)", "refs/heads")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		remote, ref, found := strings.Cut(line, " ")
+		if !found || remote != opts.Remote {
+			continue
+		}
+
+		name := strings.TrimPrefix(ref, "refs/heads/")
+		if name == "" || slices.Contains(branches, name) {
+			continue
+		}
+		if revParse(opts, "refs/remotes/"+opts.Upstream+"/"+name) == "" {
+			continue
+		}
+		branches = append(branches, name)
+	}
+
+	return branches, nil
+}
+
+// syncBranch updates the local and fork branches of b to its upstream commit.
+// Branches only ahead of upstream are left as they are, as resetting them would
+// lose their commits. It returns false if a branch has diverged and was not reset.
+func syncBranch(opts *SyncOptions, b branchSync) (bool, error) {
+	c := opts.IO.Color()
+
+	var updated, ahead []string
+	ok := true
+
+	if b.Local != "" && b.Local != b.Upstream {
+		switch {
+		case isAncestor(opts, b.Upstream, b.Local):
+			ahead = append(ahead, "the local branch")
+		case !isAncestor(opts, b.Local, b.Upstream) && !opts.Force:
+			fmt.Fprintf(opts.IO.StdErr, "%s %s: the local branch has diverged from %s. Use '--force' to reset it.\n", c.FailedIcon(), b.Name, opts.Upstream)
+			ok = false
+		default:
+			if err := updateLocal(opts, b); err != nil {
+				return false, err
+			}
+			updated = append(updated, "local")
+		}
+	}
+
+	if b.Fork != "" && b.Fork != b.Upstream && isAncestor(opts, b.Upstream, b.Fork) {
+		ahead = append(ahead, "the branch of "+opts.Remote)
+	} else if b.Fork != b.Upstream {
+		diverged := b.Fork != "" && !isAncestor(opts, b.Fork, b.Upstream)
+		if diverged && !opts.Force {
+			fmt.Fprintf(opts.IO.StdErr, "%s %s: the branch of %s has diverged from %s. Use '--force' to reset it.\n", c.FailedIcon(), b.Name, opts.Remote, opts.Upstream)
+			ok = false
+		} else {
+			args := []string{"push", "--quiet"}
+			if diverged {
+				args = append(args, fmt.Sprintf("--force-with-lease=refs/heads/%s:%s", b.Name, b.Fork))
+			}
+			args = append(args, opts.Remote, fmt.Sprintf("%s:refs/heads/%s", b.Upstream, b.Name))
+
+			if _, err := opts.Git.Git(args...); err != nil {
+				return false, fmt.Errorf("could not push to %s: %w", opts.Remote, err)
+			}
+			updated = append(updated, opts.Remote)
+		}
+	}
+
+	if len(updated) > 0 {
+		fmt.Fprintf(opts.IO.StdOut, "%s %s: updated %s to %s.\n", c.GreenCheck(), b.Name, strings.Join(updated, " and "), shortSHA(b.Upstream))
+	}
+	for _, branch := range ahead {
+		fmt.Fprintf(opts.IO.StdOut, "%s %s: %s is ahead of %s. Left unchanged.\n", c.GreenCheck(), b.Name, branch, opts.Upstream)
+	}
+	if len(updated) == 0 && len(ahead) == 0 && ok {
+		fmt.Fprintf(opts.IO.StdOut, "%s %s: already up to date.\n", c.GreenCheck(), b.Name)
+	}
+
+	return ok, nil
+}
+
+// updateLocal moves the local branch of b to its upstream commit.
+func updateLocal(opts *SyncOptions, b branchSync) error {
+	if !b.CheckedOut {
+		_, err := opts.Git.Git("update-ref", "refs/heads/"+b.Name, b.Upstream, b.Local)
+		return err
+	}
+
+	if opts.Force {
+		count, err := git.UncommittedChangeCount()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return errors.New("the branch is checked out and has uncommitted changes. Commit or stash them first.")
+		}
+
+		_, err = opts.Git.Git("reset", "--quiet", "--hard", b.Upstream)
+		return err
+	}
+
+	_, err := opts.Git.Git("merge", "--quiet", "--ff-only", b.Upstream)
+	return err
+}
+
+func revParse(opts *SyncOptions, ref string) string {
+	output, err := opts.Git.Git("rev-parse", "--verify", "--quiet", ref)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(output)
+}
+
+func isAncestor(opts *SyncOptions, ancestor, commit string) bool {
+	_, err := opts.Git.Git("merge-base", "--is-ancestor", ancestor, commit)
+	return err == nil
+}
+
+func shortSHA(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
@@ -0,0 +1,137 @@
+package sync
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, nil)
+
+	cmd := NewCmdSync(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func gitIn(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=glab test bot", "GIT_AUTHOR_EMAIL=no-reply+cli-tests@gitlab.com",
+		"GIT_COMMITTER_NAME=glab test bot", "GIT_COMMITTER_EMAIL=no-reply+cli-tests@gitlab.com",
+	)
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	return strings.TrimSpace(string(output))
+}
+
+// setupFork creates an upstream repository, a fork of it, and a clone of the fork
+// with an upstream remote. It returns their paths, and changes to the clone.
+func setupFork(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	root := t.TempDir()
+	upstream := filepath.Join(root, "upstream.git")
+	fork := filepath.Join(root, "fork.git")
+	clone := filepath.Join(root, "clone")
+	work := filepath.Join(root, "work")
+
+	gitIn(t, root, "init", "--quiet", "--bare", "--initial-branch=main", upstream)
+	gitIn(t, root, "clone", "--quiet", upstream, work)
+	gitIn(t, work, "checkout", "--quiet", "-b", "main")
+	gitIn(t, work, "commit", "--quiet", "--allow-empty", "-m", "Initial commit")
+	gitIn(t, work, "push", "--quiet", "origin", "main", "main:stable")
+
+	gitIn(t, root, "clone", "--quiet", "--bare", upstream, fork)
+	gitIn(t, root, "clone", "--quiet", fork, clone)
+	gitIn(t, clone, "remote", "add", "upstream", upstream)
+	gitIn(t, clone, "checkout", "--quiet", "--track", "origin/stable")
+	gitIn(t, clone, "checkout", "--quiet", "main")
+
+	require.NoError(t, os.Chdir(clone))
+
+	// new upstream commits, that the fork doesn't have yet.
+	gitIn(t, work, "commit", "--quiet", "--allow-empty", "-m", "Upstream change")
+	gitIn(t, work, "push", "--quiet", "origin", "main", "main:stable")
+
+	return upstream, fork, clone
+}
+
+func TestRepoSync(t *testing.T) {
+	upstream, fork, clone := setupFork(t)
+	tip := gitIn(t, upstream, "rev-parse", "main")
+
+	output, err := runCommand(t, "")
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "main: updated local and origin to "+tip[:8]+".")
+	assert.Contains(t, output.String(), "stable: updated local and origin to "+tip[:8]+".")
+
+	for _, branch := range []string{"main", "stable"} {
+		assert.Equal(t, tip, gitIn(t, fork, "rev-parse", branch))
+		assert.Equal(t, tip, gitIn(t, clone, "rev-parse", branch))
+	}
+
+	output, err = runCommand(t, "--branch main")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ main: already up to date.\n", output.String())
+}
+
+func TestRepoSyncDiverged(t *testing.T) {
+	upstream, fork, clone := setupFork(t)
+	tip := gitIn(t, upstream, "rev-parse", "main")
+
+	gitIn(t, clone, "commit", "--quiet", "--allow-empty", "-m", "Fork change")
+	gitIn(t, clone, "push", "--quiet", "origin", "main")
+	diverged := gitIn(t, clone, "rev-parse", "main")
+
+	output, err := runCommand(t, "--branch main")
+	require.ErrorIs(t, err, cmdutils.SilentError)
+	assert.Contains(t, output.Stderr(), "main: the local branch has diverged from upstream. Use '--force' to reset it.")
+	assert.Contains(t, output.Stderr(), "main: the branch of origin has diverged from upstream. Use '--force' to reset it.")
+	assert.Equal(t, diverged, gitIn(t, fork, "rev-parse", "main"))
+	assert.Equal(t, diverged, gitIn(t, clone, "rev-parse", "main"))
+
+	_, err = runCommand(t, "--branch main --force")
+	require.NoError(t, err)
+	assert.Equal(t, tip, gitIn(t, fork, "rev-parse", "main"))
+	assert.Equal(t, tip, gitIn(t, clone, "rev-parse", "main"))
+}
+
+func TestRepoSyncAhead(t *testing.T) {
+	_, fork, clone := setupFork(t)
+
+	_, err := runCommand(t, "--branch main")
+	require.NoError(t, err)
+
+	gitIn(t, clone, "commit", "--quiet", "--allow-empty", "-m", "Fork change")
+	gitIn(t, clone, "push", "--quiet", "origin", "main")
+	ahead := gitIn(t, clone, "rev-parse", "main")
+
+	output, err := runCommand(t, "--branch main --force")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ main: the local branch is ahead of upstream. Left unchanged.\n✓ main: the branch of origin is ahead of upstream. Left unchanged.\n", output.String())
+	assert.Equal(t, ahead, gitIn(t, fork, "rev-parse", "main"))
+	assert.Equal(t, ahead, gitIn(t, clone, "rev-parse", "main"))
+}
+
+func TestRepoSyncMissingRemote(t *testing.T) {
+	setupFork(t)
+
+	_, err := runCommand(t, "--upstream source")
+	require.EqualError(t, err, `no "source" remote. Use '--upstream' and '--remote' to select the remotes of the upstream repository and the fork.`)
+}
@@ -13,14 +13,20 @@ import (
 	pipelineCmd "gitlab.com/gitlab-org/cli/commands/ci"
 	clusterCmd "gitlab.com/gitlab-org/cli/commands/cluster"
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	commitCmd "gitlab.com/gitlab-org/cli/commands/commit"
 	completionCmd "gitlab.com/gitlab-org/cli/commands/completion"
 	configCmd "gitlab.com/gitlab-org/cli/commands/config"
 	duoCmd "gitlab.com/gitlab-org/cli/commands/duo"
+	epicCmd "gitlab.com/gitlab-org/cli/commands/epic"
+	filterCmd "gitlab.com/gitlab-org/cli/commands/filter"
 	"gitlab.com/gitlab-org/cli/commands/help"
+	hooksCmd "gitlab.com/gitlab-org/cli/commands/hooks"
 	incidentCmd "gitlab.com/gitlab-org/cli/commands/incident"
 	issueCmd "gitlab.com/gitlab-org/cli/commands/issue"
+	iterationCmd "gitlab.com/gitlab-org/cli/commands/iteration"
 	jobCmd "gitlab.com/gitlab-org/cli/commands/job"
 	labelCmd "gitlab.com/gitlab-org/cli/commands/label"
+	milestoneCmd "gitlab.com/gitlab-org/cli/commands/milestone"
 	mrCmd "gitlab.com/gitlab-org/cli/commands/mr"
 	projectCmd "gitlab.com/gitlab-org/cli/commands/project"
 	releaseCmd "gitlab.com/gitlab-org/cli/commands/release"
@@ -28,6 +34,7 @@ import (
 	snippetCmd "gitlab.com/gitlab-org/cli/commands/snippet"
 	sshCmd "gitlab.com/gitlab-org/cli/commands/ssh-key"
 	stackCmd "gitlab.com/gitlab-org/cli/commands/stack"
+	todoCmd "gitlab.com/gitlab-org/cli/commands/todo"
 	tokenCmd "gitlab.com/gitlab-org/cli/commands/token"
 	updateCmd "gitlab.com/gitlab-org/cli/commands/update"
 	userCmd "gitlab.com/gitlab-org/cli/commands/user"
@@ -118,10 +125,16 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 
 	rootCmd.AddCommand(changelogCmd.NewCmdChangelog(f))
 	rootCmd.AddCommand(clusterCmd.NewCmdCluster(f))
+	rootCmd.AddCommand(commitCmd.NewCmdCommit(f))
+	rootCmd.AddCommand(epicCmd.NewCmdEpic(f))
+	rootCmd.AddCommand(filterCmd.NewCmdFilter(f))
+	rootCmd.AddCommand(hooksCmd.NewCmdHooks(f))
 	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
 	rootCmd.AddCommand(incidentCmd.NewCmdIncident(f))
+	rootCmd.AddCommand(iterationCmd.NewCmdIteration(f))
 	rootCmd.AddCommand(jobCmd.NewCmdJob(f))
 	rootCmd.AddCommand(labelCmd.NewCmdLabel(f))
+	rootCmd.AddCommand(milestoneCmd.NewCmdMilestone(f))
 	rootCmd.AddCommand(mrCmd.NewCmdMR(f))
 	rootCmd.AddCommand(pipelineCmd.NewCmdCI(f))
 	rootCmd.AddCommand(projectCmd.NewCmdRepo(f))
@@ -133,6 +146,7 @@ func NewCmdRoot(f *cmdutils.Factory, version, buildDate string) *cobra.Command {
 	rootCmd.AddCommand(scheduleCmd.NewCmdSchedule(f))
 	rootCmd.AddCommand(snippetCmd.NewCmdSnippet(f))
 	rootCmd.AddCommand(duoCmd.NewCmdDuo(f))
+	rootCmd.AddCommand(todoCmd.NewCmdTodo(f))
 	rootCmd.AddCommand(tokenCmd.NewTokenCmd(f))
 	rootCmd.AddCommand(stackCmd.NewCmdStack(f))
 
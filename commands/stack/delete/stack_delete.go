@@ -0,0 +1,274 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+type DeleteOpts struct {
+	Title          string
+	LocalBranches  bool
+	RemoteBranches bool
+	CloseMRs       bool
+	Comment        string
+	DryRun         bool
+	Yes            bool
+
+	IO         *iostreams.IOStreams
+	HttpClient func() (*gitlab.Client, error)
+}
+
+// deletePlan is everything deleting a stack touches.
+type deletePlan struct {
+	localBranches  []string
+	remoteBranches []string
+	mrs            []*gitlab.MergeRequest
+}
+
+func NewCmdStackDelete(f *cmdutils.Factory) *cobra.Command {
+	opts := &DeleteOpts{
+		IO:         f.IO,
+		HttpClient: f.HttpClient,
+	}
+
+	stackDeleteCmd := &cobra.Command{
+		Use:   "delete <title> [flags]",
+		Short: "Delete a stack, and optionally its branches and merge requests. (EXPERIMENTAL.)",
+		Long: heredoc.Docf(`
+			Delete the metadata of a stack. With flags, also delete its local and remote
+			branches, and close its open merge requests with a comment.
+
+			Lists everything that will be deleted or closed, and asks for confirmation.
+			Use %[1]s--dry-run%[1]s to only list them.
+		`, "`") + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack delete cool-feature
+			glab stack delete cool-feature --local-branches --remote-branches --close-mrs --dry-run
+			glab stack delete cool-feature --close-mrs --comment "Replaced by !42." --yes
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Title = args[0]
+
+			return deleteRun(opts, git.StandardGitCommand{})
+		},
+	}
+	stackDeleteCmd.Flags().BoolVar(&opts.LocalBranches, "local-branches", false, "Delete the local branches of the stack.")
+	stackDeleteCmd.Flags().BoolVar(&opts.RemoteBranches, "remote-branches", false, "Delete the branches of the stack on the remote.")
+	stackDeleteCmd.Flags().BoolVar(&opts.CloseMRs, "close-mrs", false, "Close the open merge requests of the stack.")
+	stackDeleteCmd.Flags().StringVar(&opts.Comment, "comment", "", "Comment to add to the merge requests closed with --close-mrs.")
+	stackDeleteCmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List what would be deleted and closed, without changing anything.")
+	stackDeleteCmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return stackDeleteCmd
+}
+
+func deleteRun(opts *DeleteOpts, gr git.GitRunner) error {
+	stacks, err := git.GetStacks()
+	if err != nil {
+		return fmt.Errorf("getting stacks: %v", err)
+	}
+	if !slices.ContainsFunc(stacks, func(s git.Stack) bool { return s.Title == opts.Title }) {
+		return fmt.Errorf("no stack named %q found.", opts.Title)
+	}
+
+	stack, err := git.GatherStackRefs(opts.Title)
+	if err != nil {
+		return err
+	}
+
+	var client *gitlab.Client
+	if opts.CloseMRs {
+		if client, err = opts.HttpClient(); err != nil {
+			return err
+		}
+	}
+
+	plan, err := planDelete(opts, client, &stack, gr)
+	if err != nil {
+		return err
+	}
+
+	printPlan(opts, plan)
+
+	if opts.DryRun {
+		fmt.Fprintln(opts.IO.StdOut, "Nothing was changed, because of --dry-run.")
+		return nil
+	}
+
+	if !opts.Yes {
+		if !opts.IO.PromptEnabled() {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--yes or -y flag is required when not running interactively.")}
+		}
+
+		err = prompt.Confirm(&opts.Yes, fmt.Sprintf("Delete stack %s?", opts.Title), false)
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !opts.Yes {
+			return cmdutils.CancelError()
+		}
+	}
+
+	return deleteStack(opts, client, plan, gr)
+}
+
+func planDelete(opts *DeleteOpts, client *gitlab.Client, stack *git.Stack, gr git.GitRunner) (*deletePlan, error) {
+	plan := &deletePlan{}
+
+	if opts.LocalBranches {
+		for _, branch := range stack.Branches() {
+			if git.HasLocalBranch(branch) {
+				plan.localBranches = append(plan.localBranches, branch)
+			}
+		}
+	}
+
+	if opts.RemoteBranches && !stack.Empty() {
+		output, err := gr.Git(append([]string{"ls-remote", "--heads", git.DefaultRemote}, stack.Branches()...)...)
+		if err != nil {
+			return nil, fmt.Errorf("could not list the branches of %s: %v", git.DefaultRemote, err)
+		}
+
+		remote := make(map[string]bool)
+		for _, line := range strings.Split(output, "\n") {
+			if _, ref, found := strings.Cut(line, "\t"); found {
+				remote[strings.TrimPrefix(ref, "refs/heads/")] = true
+			}
+		}
+		for _, branch := range stack.Branches() {
+			if remote[branch] {
+				plan.remoteBranches = append(plan.remoteBranches, branch)
+			}
+		}
+	}
+
+	if opts.CloseMRs {
+		for ref := range stack.Iter() {
+			if ref.MR == "" {
+				continue
+			}
+
+			project, iid, err := mrutils.ParseMRURL(ref.MR)
+			if err != nil {
+				return nil, err
+			}
+
+			mr, err := api.GetMR(client, project, iid, &gitlab.GetMergeRequestsOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("could not get merge request of %s: %v", ref.Branch, err)
+			}
+			if mr.State == "opened" {
+				plan.mrs = append(plan.mrs, mr)
+			}
+		}
+	}
+
+	return plan, nil
+}
+
+func printPlan(opts *DeleteOpts, plan *deletePlan) {
+	out := opts.IO.StdOut
+	c := opts.IO.Color()
+
+	fmt.Fprintf(out, "Deleting stack %s will:\n", c.Bold(opts.Title))
+	fmt.Fprintln(out, "  - Delete its metadata.")
+	for _, branch := range plan.localBranches {
+		fmt.Fprintf(out, "  - Delete local branch %s.\n", branch)
+	}
+	for _, branch := range plan.remoteBranches {
+		fmt.Fprintf(out, "  - Delete remote branch %s/%s.\n", git.DefaultRemote, branch)
+	}
+	for _, mr := range plan.mrs {
+		fmt.Fprintf(out, "  - Close merge request !%d (%s), with a comment.\n", mr.IID, mr.Title)
+	}
+}
+
+func deleteStack(opts *DeleteOpts, client *gitlab.Client, plan *deletePlan, gr git.GitRunner) error {
+	c := opts.IO.Color()
+
+	comment := opts.Comment
+	if comment == "" {
+		comment = fmt.Sprintf("Closed by `glab stack delete`: the stack %s was deleted.", opts.Title)
+	}
+	for _, mr := range plan.mrs {
+		_, err := api.CreateMRNote(client, mr.ProjectID, mr.IID, &gitlab.CreateMergeRequestNoteOptions{Body: gitlab.Ptr(comment)})
+		if err != nil {
+			return fmt.Errorf("could not comment on merge request !%d: %v", mr.IID, err)
+		}
+
+		_, err = api.UpdateMR(client, mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{StateEvent: gitlab.Ptr("close")})
+		if err != nil {
+			return fmt.Errorf("could not close merge request !%d: %v", mr.IID, err)
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Closed merge request !%d.\n", c.RedCheck(), mr.IID)
+	}
+
+	if len(plan.remoteBranches) > 0 {
+		_, err := gr.Git(append([]string{"push", "--quiet", "--delete", git.DefaultRemote}, plan.remoteBranches...)...)
+		if err != nil {
+			return fmt.Errorf("could not delete the remote branches: %v", err)
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted remote branches %s.\n", c.RedCheck(), strings.Join(plan.remoteBranches, ", "))
+	}
+
+	if len(plan.localBranches) > 0 {
+		// the current branch can't be deleted.
+		if current, _ := git.CurrentBranch(); slices.Contains(plan.localBranches, current) {
+			defaultBranch, err := git.GetDefaultBranch(git.DefaultRemote)
+			if err != nil {
+				return fmt.Errorf("could not determine the default branch of %s: %v", git.DefaultRemote, err)
+			}
+			if err := git.CheckoutBranch(defaultBranch); err != nil {
+				return err
+			}
+		}
+
+		for _, branch := range plan.localBranches {
+			if err := git.DeleteLocalBranch(branch); err != nil {
+				return fmt.Errorf("could not delete local branch %s: %v", branch, err)
+			}
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Deleted local branches %s.\n", c.RedCheck(), strings.Join(plan.localBranches, ", "))
+	}
+
+	root, err := git.StackRootDir(opts.Title)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(root); err != nil {
+		return fmt.Errorf("could not delete the metadata of the stack: %v", err)
+	}
+	// the state of a stopped sync would be picked up by a new stack with the same title.
+	syncState, err := git.StackSyncStateFile(opts.Title)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(syncState); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not delete the sync state of the stack: %v", err)
+	}
+
+	if current, _ := git.GetCurrentStackTitle(); current == opts.Title {
+		if _, err := gr.Git("config", "--local", "--unset", "glab.currentstack"); err != nil {
+			return fmt.Errorf("error unsetting local Git config: %v", err)
+		}
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s Deleted stack %s.\n", c.RedCheck(), opts.Title)
+	return nil
+}
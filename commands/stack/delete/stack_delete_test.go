@@ -0,0 +1,178 @@
+package delete
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+// fakeAPI answers API requests with bodies keyed by method and path.
+type fakeAPI struct {
+	responses map[string]string
+	requests  []string
+}
+
+func (f *fakeAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.Path
+	f.requests = append(f.requests, key)
+
+	body, ok := f.responses[key]
+	if !ok {
+		return nil, fmt.Errorf("unexpected request %s", key)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Request:    req,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func runGit(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := git.StandardGitCommand{}.Git(args...)
+	require.NoError(t, err, output)
+	return strings.TrimSpace(output)
+}
+
+// initStack creates a repository with an origin remote and the stack "cool",
+// with the branches one and two. Merge request !1 is open, !2 is merged.
+func initStack(t *testing.T) {
+	t.Helper()
+
+	origin := t.TempDir()
+	runGit(t, "init", "--quiet", "--bare", origin)
+
+	git.InitGitRepoWithCommit(t)
+	runGit(t, "branch", "-M", "main")
+	runGit(t, "remote", "add", "origin", origin)
+	runGit(t, "push", "--quiet", "origin", "main")
+	runGit(t, "--git-dir", origin, "symbolic-ref", "HEAD", "refs/heads/main")
+	runGit(t, "checkout", "--quiet", "-b", "one")
+	runGit(t, "checkout", "--quiet", "-b", "two")
+	runGit(t, "push", "--quiet", "origin", "one", "two")
+
+	for _, ref := range []git.StackRef{
+		{SHA: "1", Next: "2", Branch: "one", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/1"},
+		{SHA: "2", Prev: "1", Branch: "two", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/2"},
+	} {
+		require.NoError(t, git.AddStackRefFile("cool", ref))
+	}
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool"))
+}
+
+func setupOpts(t *testing.T, api *fakeAPI) (*DeleteOpts, *bytes.Buffer) {
+	t.Helper()
+
+	ios, _, stdout, _ := cmdtest.InitIOStreams(false, "")
+	f := cmdtest.InitFactory(ios, api)
+	_, _ = f.HttpClient()
+
+	return &DeleteOpts{
+		Title:          "cool",
+		LocalBranches:  true,
+		RemoteBranches: true,
+		CloseMRs:       true,
+		IO:             ios,
+		HttpClient:     f.HttpClient,
+	}, stdout
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{responses: map[string]string{
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/1": `{"iid": 1, "project_id": 3, "title": "Add API", "state": "opened"}`,
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/2": `{"iid": 2, "project_id": 3, "title": "Add docs", "state": "merged"}`,
+		"POST /api/v4/projects/3/merge_requests/1/notes":               `{}`,
+		"PUT /api/v4/projects/3/merge_requests/1":                      `{"iid": 1, "state": "closed"}`,
+	}}
+}
+
+func Test_deleteRun_dryRun(t *testing.T) {
+	initStack(t)
+
+	api := newFakeAPI()
+	opts, stdout := setupOpts(t, api)
+	opts.DryRun = true
+
+	require.NoError(t, deleteRun(opts, git.StandardGitCommand{}))
+
+	require.Equal(t, heredoc.Doc(`
+		Deleting stack cool will:
+		  - Delete its metadata.
+		  - Delete local branch one.
+		  - Delete local branch two.
+		  - Delete remote branch origin/one.
+		  - Delete remote branch origin/two.
+		  - Close merge request !1 (Add API), with a comment.
+		Nothing was changed, because of --dry-run.
+	`), stdout.String())
+
+	require.True(t, git.HasLocalBranch("one"))
+	stack, err := git.GatherStackRefs("cool")
+	require.NoError(t, err)
+	require.Len(t, stack.Refs, 2)
+	require.NotContains(t, api.requests, "PUT /api/v4/projects/3/merge_requests/1")
+}
+
+func Test_deleteRun(t *testing.T) {
+	initStack(t)
+
+	syncState, err := git.StackSyncStateFile("cool")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(syncState, []byte(`{"title": "cool"}`), 0o644))
+
+	api := newFakeAPI()
+	opts, _ := setupOpts(t, api)
+	opts.Yes = true
+
+	require.NoError(t, deleteRun(opts, git.StandardGitCommand{}))
+
+	require.Contains(t, api.requests, "POST /api/v4/projects/3/merge_requests/1/notes")
+	require.Contains(t, api.requests, "PUT /api/v4/projects/3/merge_requests/1")
+
+	require.False(t, git.HasLocalBranch("one"))
+	require.False(t, git.HasLocalBranch("two"))
+	require.Equal(t, "", runGit(t, "ls-remote", "--heads", "origin", "one", "two"))
+
+	root, err := git.StackRootDir("cool")
+	require.NoError(t, err)
+	_, err = os.Stat(root)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(syncState)
+	require.True(t, os.IsNotExist(err))
+
+	title, _ := git.GetCurrentStackTitle()
+	require.Equal(t, "", title)
+}
+
+func Test_deleteRun_unknownStack(t *testing.T) {
+	initStack(t)
+
+	opts, _ := setupOpts(t, newFakeAPI())
+	opts.Title = "nope"
+
+	require.EqualError(t, deleteRun(opts, git.StandardGitCommand{}), `no stack named "nope" found.`)
+}
+
+func Test_deleteRun_requiresYes(t *testing.T) {
+	initStack(t)
+
+	opts, _ := setupOpts(t, newFakeAPI())
+	opts.CloseMRs = false
+
+	err := deleteRun(opts, git.StandardGitCommand{})
+	require.EqualError(t, err, "--yes or -y flag is required when not running interactively.")
+	require.True(t, git.HasLocalBranch("one"))
+}
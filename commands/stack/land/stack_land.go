@@ -0,0 +1,406 @@
+package land
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/avast/retry-go/v4"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type LandOpts struct {
+	UpTo       string
+	AutoMerge  bool
+	MergeTrain bool
+	Squash     bool
+	Timeout    time.Duration
+	Yes        bool
+
+	IO         *iostreams.IOStreams
+	HttpClient func() (*gitlab.Client, error)
+
+	defaultBranch string
+}
+
+// pollInterval is how often the state of a merge request is checked while waiting for it.
+var pollInterval = 10 * time.Second
+
+func NewCmdStackLand(f *cmdutils.Factory) *cobra.Command {
+	opts := &LandOpts{
+		IO:         f.IO,
+		HttpClient: f.HttpClient,
+	}
+
+	stackLandCmd := &cobra.Command{
+		Use:   "land [flags]",
+		Short: "Merge the merge requests of a stack, in order. (EXPERIMENTAL.)",
+		Long: heredoc.Doc(`
+			Merge the merge requests of the stack, starting from the first one.
+
+			For each merge request, this command:
+
+			1. Merges it, or sets it to auto-merge or adds it to the merge train,
+			   and waits until it is merged.
+			1. Rebases the rest of the stack onto the default branch and force
+			   pushes it, with lease.
+			1. Changes the target branch of the next merge request to the default branch.
+			1. Removes the merged diff from the stack.
+
+			Landing stops at the first failure. Merge requests merged before it stay
+			merged. Fix the problem, then run this command again to land the rest.
+
+			The merge requests must be in sync with the local branches. Run
+			'glab stack sync' first.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack land
+			glab stack land --up-to add-api --auto-merge
+			glab stack land --merge-train --yes
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return landStack(opts, git.StandardGitCommand{})
+		},
+	}
+	stackLandCmd.Flags().StringVar(&opts.UpTo, "up-to", "", "Land the stack up to this branch, included. Defaults to the whole stack.")
+	stackLandCmd.Flags().BoolVar(&opts.AutoMerge, "auto-merge", false, "Merge each merge request when its pipeline succeeds.")
+	stackLandCmd.Flags().BoolVar(&opts.MergeTrain, "merge-train", false, "Add each merge request to the merge train.")
+	stackLandCmd.Flags().BoolVarP(&opts.Squash, "squash", "s", false, "Squash commits on merge.")
+	stackLandCmd.Flags().DurationVar(&opts.Timeout, "timeout", time.Hour, "How long to wait for each merge request to merge.")
+	stackLandCmd.Flags().BoolVarP(&opts.Yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return stackLandCmd
+}
+
+func landStack(opts *LandOpts, gr git.GitRunner) error {
+	if err := git.EnsureCleanWorkingTree(); err != nil {
+		return err
+	}
+
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return err
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return err
+	}
+
+	if stack.Empty() {
+		return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+	}
+
+	refs, err := refsToLand(&stack, opts.UpTo)
+	if err != nil {
+		return err
+	}
+
+	opts.defaultBranch, err = git.GetDefaultBranch(git.DefaultRemote)
+	if err != nil {
+		return fmt.Errorf("could not determine the default branch of %s: %v", git.DefaultRemote, err)
+	}
+
+	if opts.IO.IsOutputTTY() && opts.IO.PromptEnabled() && !opts.Yes {
+		fmt.Fprintf(opts.IO.StdErr, "These merge requests will be merged into %s, in order:\n", opts.defaultBranch)
+		for _, ref := range refs {
+			fmt.Fprintf(opts.IO.StdErr, "  %s %s\n", ref.MR, ref.Branch)
+		}
+
+		confirmed := false
+		if err := prompt.Confirm(&confirmed, "Land them?", false); err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+		if !confirmed {
+			return cmdutils.CancelError()
+		}
+	}
+
+	client, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	c := opts.IO.Color()
+	for i, ref := range refs {
+		// the links of the ref change as the refs before it are removed.
+		ref = stack.Refs[ref.SHA]
+
+		err := landRef(opts, client, &stack, ref, i > 0, gr)
+		if err != nil {
+			return fmt.Errorf("could not land %s: %v\nLanded %d of %d merge requests. Fix the problem, then run 'glab stack land' again to land the rest.",
+				ref.Branch, err, i, len(refs))
+		}
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s Landed %s.\n", c.GreenCheck(), utils.Pluralize(len(refs), "merge request"))
+	return nil
+}
+
+// refsToLand returns the refs of the stack from the first one up to upTo,
+// which can be a branch or the SHA of a ref. An empty upTo means all the refs.
+func refsToLand(stack *git.Stack, upTo string) ([]git.StackRef, error) {
+	if upTo != "" && !slices.Contains(stack.Branches(), upTo) {
+		if _, ok := stack.Refs[upTo]; !ok {
+			return nil, fmt.Errorf("%q is not a branch of the stack %s.", upTo, stack.Title)
+		}
+	}
+
+	var refs []git.StackRef
+	for ref := range stack.Iter() {
+		if ref.MR == "" {
+			return nil, fmt.Errorf("%s has no merge request. Run 'glab stack sync' to create it.", ref.Branch)
+		}
+
+		refs = append(refs, ref)
+		if ref.Branch == upTo || ref.SHA == upTo {
+			break
+		}
+	}
+
+	return refs, nil
+}
+
+// landRef merges the merge request of the first ref of the stack, rebases the
+// rest of the stack onto the default branch, and removes ref from the stack.
+// pushed is true if the branch of ref was pushed by the previous landing.
+func landRef(opts *LandOpts, client *gitlab.Client, stack *git.Stack, ref git.StackRef, pushed bool, gr git.GitRunner) error {
+	c := opts.IO.Color()
+
+	mr, err := getMR(client, ref.MR)
+	if err != nil {
+		return err
+	}
+
+	switch mr.State {
+	case "merged":
+		fmt.Fprintf(opts.IO.StdOut, "%s !%d is already merged.\n", c.GreenCheck(), mr.IID)
+	case "closed":
+		return fmt.Errorf("merge request !%d is closed.", mr.IID)
+	default:
+		if err := retarget(opts, client, mr); err != nil {
+			return err
+		}
+
+		tip, err := gitLine(gr, "rev-parse", "--verify", ref.Branch)
+		if err != nil {
+			return err
+		}
+
+		mr, err = waitForSHA(opts, client, mr, tip, pushed)
+		if err != nil {
+			return err
+		}
+
+		mr, err = merge(opts, client, mr, tip)
+		if err != nil {
+			return err
+		}
+
+		if err := waitForMerge(opts, client, mr); err != nil {
+			return err
+		}
+		fmt.Fprintf(opts.IO.StdOut, "%s Merged !%d (%s).\n", c.GreenCheck(), mr.IID, ref.Branch)
+	}
+
+	if !ref.IsLast() {
+		if err := rebaseRest(opts, stack, ref, gr); err != nil {
+			return err
+		}
+
+		if next := stack.Refs[ref.Next]; next.MR != "" {
+			nextMR, err := getMR(client, next.MR)
+			if err != nil {
+				return err
+			}
+			if err := retarget(opts, client, nextMR); err != nil {
+				return err
+			}
+		}
+	}
+
+	return stack.RemoveRef(ref)
+}
+
+func getMR(client *gitlab.Client, webURL string) (*gitlab.MergeRequest, error) {
+	project, iid, err := mrutils.ParseMRURL(webURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetMR(client, project, iid, &gitlab.GetMergeRequestsOptions{})
+}
+
+// retarget changes the target branch of mr to the default branch.
+func retarget(opts *LandOpts, client *gitlab.Client, mr *gitlab.MergeRequest) error {
+	if mr.TargetBranch == opts.defaultBranch {
+		return nil
+	}
+
+	_, err := api.UpdateMR(client, mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{
+		TargetBranch: gitlab.Ptr(opts.defaultBranch),
+	})
+	if err != nil {
+		return fmt.Errorf("could not change the target branch of !%d to %s: %v", mr.IID, opts.defaultBranch, err)
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s Changed the target branch of !%d to %s.\n", opts.IO.Color().GreenCheck(), mr.IID, opts.defaultBranch)
+	mr.TargetBranch = opts.defaultBranch
+	return nil
+}
+
+// waitForSHA makes sure mr merges the commit at the tip of the local branch. If the
+// branch was just pushed, it waits until GitLab updates the merge request.
+func waitForSHA(opts *LandOpts, client *gitlab.Client, mr *gitlab.MergeRequest, tip string, pushed bool) (*gitlab.MergeRequest, error) {
+	if mr.SHA == tip {
+		return mr, nil
+	}
+	if !pushed {
+		return nil, fmt.Errorf("merge request !%d is not in sync with the local branch. Run 'glab stack sync' first.", mr.IID)
+	}
+
+	opts.IO.StartSpinner("Waiting for !%d to be updated", mr.IID)
+	defer opts.IO.StopSpinner("")
+
+	return poll(opts, client, mr, func(mr *gitlab.MergeRequest) (bool, error) {
+		return mr.SHA == tip, nil
+	})
+}
+
+func merge(opts *LandOpts, client *gitlab.Client, mr *gitlab.MergeRequest, tip string) (*gitlab.MergeRequest, error) {
+	if opts.MergeTrain {
+		trainOpts := &gitlab.AddMergeRequestToMergeTrainOptions{
+			SHA:                  gitlab.Ptr(tip),
+			WhenPipelineSucceeds: gitlab.Ptr(opts.AutoMerge),
+		}
+		if opts.Squash {
+			trainOpts.Squash = gitlab.Ptr(true)
+		}
+
+		if _, err := api.AddMRToMergeTrain(client, mr.ProjectID, mr.IID, trainOpts); err != nil {
+			return nil, fmt.Errorf("could not add !%d to the merge train: %v", mr.IID, err)
+		}
+		return mr, nil
+	}
+
+	mergeOpts := &gitlab.AcceptMergeRequestOptions{
+		SHA: gitlab.Ptr(tip),
+	}
+	if opts.Squash {
+		mergeOpts.Squash = gitlab.Ptr(true)
+	}
+	if opts.AutoMerge {
+		mergeOpts.MergeWhenPipelineSucceeds = gitlab.Ptr(true)
+	}
+
+	var merged *gitlab.MergeRequest
+	err := retry.Do(func() error {
+		var resp *gitlab.Response
+		var err error
+		merged, resp, err = api.MergeMR(client, mr.ProjectID, mr.IID, mergeOpts)
+		// GitLab can take a moment to find that a branch it just received is mergeable.
+		if err != nil && (resp == nil || resp.StatusCode != http.StatusNotAcceptable) {
+			return retry.Unrecoverable(err)
+		}
+		return err
+	}, retry.Attempts(3), retry.Delay(pollInterval))
+	if err != nil {
+		return nil, fmt.Errorf("could not merge !%d: %v", mr.IID, err)
+	}
+
+	return merged, nil
+}
+
+// waitForMerge waits until mr is merged, and fails if it is closed or its pipeline fails.
+func waitForMerge(opts *LandOpts, client *gitlab.Client, mr *gitlab.MergeRequest) error {
+	if mr.State == "merged" {
+		return nil
+	}
+
+	opts.IO.StartSpinner("Waiting for !%d to merge", mr.IID)
+	defer opts.IO.StopSpinner("")
+
+	_, err := poll(opts, client, mr, func(mr *gitlab.MergeRequest) (bool, error) {
+		switch {
+		case mr.State == "merged":
+			return true, nil
+		case mr.State == "closed":
+			return false, fmt.Errorf("merge request !%d was closed.", mr.IID)
+		case mr.HeadPipeline != nil && (mr.HeadPipeline.Status == "failed" || mr.HeadPipeline.Status == "canceled"):
+			return false, fmt.Errorf("the pipeline of merge request !%d %s.", mr.IID, mr.HeadPipeline.Status)
+		}
+		return false, nil
+	})
+	return err
+}
+
+// poll gets mr until done returns true or an error, or until the timeout.
+func poll(opts *LandOpts, client *gitlab.Client, mr *gitlab.MergeRequest, done func(*gitlab.MergeRequest) (bool, error)) (*gitlab.MergeRequest, error) {
+	deadline := time.Now().Add(opts.Timeout)
+	for {
+		updated, err := api.GetMR(client, mr.ProjectID, mr.IID, &gitlab.GetMergeRequestsOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := done(updated)
+		if err != nil || ok {
+			return updated, err
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for merge request !%d.", opts.Timeout, mr.IID)
+		}
+		time.Sleep(pollInterval)
+	}
+}
+
+// rebaseRest rebases the refs after ref onto the default branch, which now has the
+// changes of ref, and force pushes them.
+func rebaseRest(opts *LandOpts, stack *git.Stack, ref git.StackRef, gr git.GitRunner) error {
+	if _, err := gr.Git("fetch", git.DefaultRemote); err != nil {
+		return err
+	}
+
+	upstream := git.DefaultRemote + "/" + opts.defaultBranch
+	_, err := gr.Git("rebase", "--quiet", "--update-refs", "--onto", upstream, ref.Branch, stack.Last().Branch)
+	if err != nil {
+		_, _ = gr.Git("rebase", "--abort")
+		return fmt.Errorf("could not rebase the rest of the stack onto %s, likely due to a merge conflict. Rebase it with Git, then run 'glab stack sync'.", upstream)
+	}
+
+	var branches []string
+	for r := range stack.Iter() {
+		if r.SHA != ref.SHA {
+			branches = append(branches, r.Branch)
+		}
+	}
+
+	_, err = gr.Git(append([]string{"push", "--quiet", "--force-with-lease", git.DefaultRemote}, branches...)...)
+	if err != nil {
+		return fmt.Errorf("could not push %s: %v", strings.Join(branches, ", "), err)
+	}
+
+	fmt.Fprintf(opts.IO.StdOut, "%s Rebased %s onto %s.\n", opts.IO.Color().GreenCheck(), strings.Join(branches, ", "), upstream)
+	return nil
+}
+
+func gitLine(gr git.GitRunner, args ...string) (string, error) {
+	output, err := gr.Git(args...)
+	return strings.TrimSpace(output), err
+}
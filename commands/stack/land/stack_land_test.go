@@ -0,0 +1,197 @@
+package land
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+// fakeAPI answers API requests with handlers keyed by method and path, so the
+// same merge request can be returned in different states.
+type fakeAPI struct {
+	handlers map[string]func() string
+	requests []string
+}
+
+func (f *fakeAPI) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.Path
+	f.requests = append(f.requests, key)
+
+	handler, ok := f.handlers[key]
+	if !ok {
+		return nil, fmt.Errorf("unexpected request %s", key)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Request:    req,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(handler())),
+	}, nil
+}
+
+func runGit(t *testing.T, args ...string) string {
+	t.Helper()
+
+	output, err := git.StandardGitCommand{}.Git(args...)
+	require.NoError(t, err, output)
+	return strings.TrimSpace(output)
+}
+
+// initStack creates a repository with an origin remote, and a stack of two
+// refs, one and two, with merge requests !1 and !2.
+func initStack(t *testing.T) {
+	t.Helper()
+
+	origin := t.TempDir()
+	runGit(t, "init", "--quiet", "--bare", origin)
+
+	dir := git.InitGitRepoWithCommit(t)
+	runGit(t, "branch", "-M", "main")
+	runGit(t, "remote", "add", "origin", origin)
+	runGit(t, "push", "--quiet", "origin", "main")
+	runGit(t, "--git-dir", origin, "symbolic-ref", "HEAD", "refs/heads/main")
+
+	refs := map[string]git.StackRef{}
+	for i, branch := range []string{"one", "two"} {
+		runGit(t, "checkout", "--quiet", "-b", branch)
+		require.NoError(t, os.WriteFile(dir+"/"+branch+".txt", []byte(branch), 0o644))
+		runGit(t, "add", branch+".txt")
+		runGit(t, "commit", "--quiet", "-m", "add "+branch)
+
+		ref := git.StackRef{
+			SHA:    "sha-" + branch,
+			Branch: branch,
+			MR:     fmt.Sprintf("https://gitlab.com/stack_guy/stackproject/-/merge_requests/%d", i+1),
+		}
+		if branch == "one" {
+			ref.Next = "sha-two"
+		} else {
+			ref.Prev = "sha-one"
+		}
+		refs[ref.SHA] = ref
+	}
+	runGit(t, "push", "--quiet", "origin", "one", "two")
+	runGit(t, "fetch", "--quiet", "origin")
+
+	require.NoError(t, git.CreateRefFiles(refs, "cool stack"))
+	require.NoError(t, git.SetConfig("glab.currentstack", "cool stack"))
+}
+
+func mrJSON(iid int, state, target, sha string) string {
+	return fmt.Sprintf(`{"iid": %d, "project_id": 3, "state": %q, "target_branch": %q, "sha": %q}`, iid, state, target, sha)
+}
+
+func Test_landStack(t *testing.T) {
+	initStack(t)
+
+	interval := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = interval })
+
+	tipOne := runGit(t, "rev-parse", "one")
+	mrTwoGets := 0
+
+	api := &fakeAPI{handlers: map[string]func() string{
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/1": func() string {
+			return mrJSON(1, "opened", "main", tipOne)
+		},
+		"PUT /api/v4/projects/3/merge_requests/1/merge": func() string {
+			return mrJSON(1, "merged", "main", tipOne)
+		},
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/2": func() string {
+			mrTwoGets++
+			if mrTwoGets == 1 {
+				return mrJSON(2, "opened", "one", "old")
+			}
+			return mrJSON(2, "opened", "main", runGit(t, "rev-parse", "two"))
+		},
+		"PUT /api/v4/projects/3/merge_requests/2": func() string {
+			return mrJSON(2, "opened", "main", "old")
+		},
+		"PUT /api/v4/projects/3/merge_requests/2/merge": func() string {
+			return mrJSON(2, "merged", "main", "")
+		},
+	}}
+
+	ios, _, stdout, _ := cmdtest.InitIOStreams(false, "")
+	f := cmdtest.InitFactory(ios, api)
+	_, _ = f.HttpClient()
+
+	opts := &LandOpts{IO: ios, HttpClient: f.HttpClient, Timeout: time.Second}
+	err := landStack(opts, git.StandardGitCommand{})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/1",
+		"PUT /api/v4/projects/3/merge_requests/1/merge",
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/2",
+		"PUT /api/v4/projects/3/merge_requests/2",
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/2",
+		"PUT /api/v4/projects/3/merge_requests/2/merge",
+	}, api.requests)
+
+	// two was rebased onto main, without the commit of one, and pushed.
+	require.Equal(t, "add two", runGit(t, "log", "--format=%s", "main..origin/two"))
+	require.False(t, git.HasLocalBranch("one"))
+
+	stack, err := git.GatherStackRefs("cool stack")
+	require.NoError(t, err)
+	require.True(t, stack.Empty())
+
+	require.Contains(t, stdout.String(), "Merged !1 (one).")
+	require.Contains(t, stdout.String(), "Changed the target branch of !2 to main.")
+	require.Contains(t, stdout.String(), "Landed 2 merge requests.")
+}
+
+func Test_landStack_notInSync(t *testing.T) {
+	initStack(t)
+
+	api := &fakeAPI{handlers: map[string]func() string{
+		"GET /api/v4/projects/stack_guy/stackproject/merge_requests/1": func() string {
+			return mrJSON(1, "opened", "main", "other")
+		},
+	}}
+
+	ios, _, _, _ := cmdtest.InitIOStreams(false, "")
+	f := cmdtest.InitFactory(ios, api)
+	_, _ = f.HttpClient()
+
+	opts := &LandOpts{IO: ios, HttpClient: f.HttpClient, Timeout: time.Second}
+	err := landStack(opts, git.StandardGitCommand{})
+	require.EqualError(t, err, "could not land one: merge request !1 is not in sync with the local branch. Run 'glab stack sync' first.\n"+
+		"Landed 0 of 2 merge requests. Fix the problem, then run 'glab stack land' again to land the rest.")
+
+	stack, err := git.GatherStackRefs("cool stack")
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "two"}, stack.Branches())
+}
+
+func Test_refsToLand(t *testing.T) {
+	stack := &git.Stack{
+		Title: "cool stack",
+		Refs: map[string]git.StackRef{
+			"1": {SHA: "1", Next: "2", Branch: "one", MR: "https://gitlab.com/a/b/-/merge_requests/1"},
+			"2": {SHA: "2", Prev: "1", Next: "3", Branch: "two", MR: "https://gitlab.com/a/b/-/merge_requests/2"},
+			"3": {SHA: "3", Prev: "2", Branch: "three"},
+		},
+	}
+
+	refs, err := refsToLand(stack, "two")
+	require.NoError(t, err)
+	require.Len(t, refs, 2)
+
+	_, err = refsToLand(stack, "")
+	require.EqualError(t, err, "three has no merge request. Run 'glab stack sync' to create it.")
+
+	_, err = refsToLand(stack, "nope")
+	require.EqualError(t, err, `"nope" is not a branch of the stack cool stack.`)
+}
@@ -0,0 +1,73 @@
+package reorder
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+func NewCmdStackFold(f *cmdutils.Factory) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fold [<branch>]",
+		Short: "Merge a diff into the previous diff of the stack. (EXPERIMENTAL.)",
+		Long: heredoc.Doc(`
+			Merge a diff into the previous diff of the stack. Defaults to the current branch.
+
+			The branch of the previous diff is moved to include the commits of the diff,
+			and the branch of the diff is deleted. A merge request of the folded diff is
+			not closed.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack fold
+			glab stack fold my-stack-branch
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return err
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return err
+			}
+
+			if stack.Empty() {
+				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+			}
+
+			var ref git.StackRef
+			if len(args) > 0 {
+				ref, err = stack.RefFromBranch(args[0])
+			} else {
+				ref, err = git.CurrentStackRefFromCurrentBranch(title)
+			}
+			if err != nil {
+				return err
+			}
+
+			parent := stack.Refs[ref.Prev]
+			err = stack.Fold(ref)
+			if err != nil {
+				return fmt.Errorf("could not fold %s: %v", ref.Branch, err)
+			}
+
+			if f.IO.IsOutputTTY() {
+				c := f.IO.Color()
+				fmt.Fprintf(f.IO.StdOut, "%s %s: Folded %s into %s.\n", c.GreenCheck(), c.Blue(title), ref.Branch, parent.Branch)
+				if ref.MR != "" {
+					fmt.Fprintf(f.IO.StdOut, "%s The merge request of %s is still open: %s\n", c.WarnIcon(), ref.Branch, ref.MR)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package reorder
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+func NewCmdStackReorder(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reorder [<branch>...]",
+		Short: "Change the order of the diffs in the stack. (EXPERIMENTAL.)",
+		Long: heredoc.Doc(`
+			Change the order of the diffs in the stack.
+
+			Without arguments, opens an editor with one line per diff, like 'git rebase -i'.
+			Reorder the lines, save and quit. With arguments, the branches of the stack
+			are given in their new order.
+
+			The commits of each diff are cherry-picked in the new order, starting from the
+			first diff that moves. If a commit does not apply, the stack is left unchanged.
+			Run 'glab stack sync' afterwards to push the updated branches.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack reorder
+			glab stack reorder second-branch first-branch third-branch
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return err
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return err
+			}
+
+			if stack.Empty() {
+				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+			}
+
+			var order []string
+			if len(args) > 0 {
+				order, err = orderFromBranches(stack, args)
+			} else {
+				order, err = orderFromEditor(f, getText, stack)
+			}
+			if err != nil {
+				return err
+			}
+
+			err = stack.Reorder(order)
+			if err != nil {
+				return fmt.Errorf("could not reorder stack: %v", err)
+			}
+
+			if f.IO.IsOutputTTY() {
+				c := f.IO.Color()
+				fmt.Fprintf(f.IO.StdOut, "%s %s: Reordered %s.\n", c.GreenCheck(), c.Blue(title), strings.Join(stack.Branches(), ", "))
+			}
+
+			return nil
+		},
+	}
+}
+
+func orderFromBranches(stack git.Stack, branches []string) ([]string, error) {
+	var order []string
+	for _, branch := range branches {
+		ref, err := stack.RefFromBranch(branch)
+		if err != nil {
+			return nil, err
+		}
+		order = append(order, ref.SHA)
+	}
+
+	return order, nil
+}
+
+func orderFromEditor(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor, stack git.Stack) ([]string, error) {
+	if !f.IO.IsOutputTTY() {
+		return nil, errors.New("no TTY to open an editor. Pass the branches of the stack in their new order.")
+	}
+
+	editor, err := cmdutils.GetEditor(f.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	edited, err := getText(editor, "glab-stack-reorder*.txt", reorderList(stack))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseReorderList(edited), nil
+}
+
+// reorderList renders the refs of a stack, one per line, for editing.
+func reorderList(stack git.Stack) string {
+	var b strings.Builder
+	for ref := range stack.Iter() {
+		fmt.Fprintf(&b, "%s %s %s\n", ref.SHA, ref.Branch, ref.Subject())
+	}
+
+	fmt.Fprintf(&b, heredoc.Doc(`
+
+		# Reorder the diffs of stack %q by moving these lines.
+		# The first line is the bottom of the stack.
+		# Lines starting with '#' are ignored. Do not remove any line.
+	`), stack.Title)
+
+	return b.String()
+}
+
+// parseReorderList returns the ref SHAs of an edited list, in order.
+func parseReorderList(list string) []string {
+	var order []string
+	for _, line := range strings.Split(list, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		order = append(order, fields[0])
+	}
+
+	return order
+}
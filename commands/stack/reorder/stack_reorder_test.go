@@ -0,0 +1,53 @@
+package reorder
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func testStack() git.Stack {
+	return git.Stack{
+		Title: "sweet-stack",
+		Refs: map[string]git.StackRef{
+			"aaa": {SHA: "aaa", Next: "bbb", Branch: "first", Description: "first change"},
+			"bbb": {SHA: "bbb", Prev: "aaa", Next: "ccc", Branch: "second", Description: "second change"},
+			"ccc": {SHA: "ccc", Prev: "bbb", Branch: "third", Description: "third change"},
+		},
+	}
+}
+
+func Test_reorderList(t *testing.T) {
+	require.Equal(t, heredoc.Doc(`
+		aaa first first change
+		bbb second second change
+		ccc third third change
+
+		# Reorder the diffs of stack "sweet-stack" by moving these lines.
+		# The first line is the bottom of the stack.
+		# Lines starting with '#' are ignored. Do not remove any line.
+	`), reorderList(testStack()))
+}
+
+func Test_parseReorderList(t *testing.T) {
+	edited := heredoc.Doc(`
+		ccc third third change
+		aaa first first change
+
+		bbb second second change
+		# a comment
+	`)
+
+	require.Equal(t, []string{"ccc", "aaa", "bbb"}, parseReorderList(edited))
+}
+
+func Test_orderFromBranches(t *testing.T) {
+	order, err := orderFromBranches(testStack(), []string{"second", "first", "third"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bbb", "aaa", "ccc"}, order)
+
+	_, err = orderFromBranches(testStack(), []string{"second", "nope"})
+	require.EqualError(t, err, "Could not find stack ref for branch: nope")
+}
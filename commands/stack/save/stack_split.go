@@ -0,0 +1,126 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+func NewCmdSplitStack(f *cmdutils.Factory, getText cmdutils.GetTextUsingEditor) *cobra.Command {
+	var files []string
+
+	stackSplitCmd := &cobra.Command{
+		Use:   "split [<branch>]",
+		Short: `Split a diff of the stack in two. (EXPERIMENTAL.)`,
+		Long: heredoc.Doc(`
+			Split the commit of a diff in two. Defaults to the current branch.
+
+			The changes to the selected files are moved out of the diff, into a new diff
+			inserted after it with its own branch. The diffs after it are rebased onto the
+			new diff. Only a diff with a single commit can be split.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack split
+			glab stack split --file docs/README.md -m "update the docs"
+			glab stack split my-stack-branch --file a.go --file b.go`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return fmt.Errorf("error running Git command: %v", err)
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return fmt.Errorf("error getting refs from file system: %v", err)
+			}
+
+			var ref git.StackRef
+			if len(args) > 0 {
+				ref, err = stack.RefFromBranch(args[0])
+			} else {
+				ref, err = git.CurrentStackRefFromCurrentBranch(title)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(files) == 0 {
+				files, err = promptForFiles(f, &stack, ref)
+				if err != nil {
+					return err
+				}
+			}
+
+			// a description is required, so ask if one is not provided
+			if description == "" {
+				description, err = promptForCommit(f, getText, "")
+				if err != nil {
+					return fmt.Errorf("error getting commit message: %v", err)
+				}
+			}
+
+			author, err := git.GitUserName()
+			if err != nil {
+				return fmt.Errorf("error getting Git author: %v", err)
+			}
+
+			sha, err := generateStackSha(description, title, string(author), time.Now())
+			if err != nil {
+				return fmt.Errorf("error generating hash for stack branch name: %v", err)
+			}
+
+			branch, err := createShaBranch(f, sha, title)
+			if err != nil {
+				return fmt.Errorf("error creating branch name: %v", err)
+			}
+
+			newRef := git.StackRef{SHA: sha, Branch: branch, Description: description}
+			err = stack.Split(ref, newRef, files)
+			if err != nil {
+				return fmt.Errorf("could not split %s: %v", ref.Branch, err)
+			}
+
+			if f.IO.IsOutputTTY() {
+				c := f.IO.Color()
+				fmt.Fprintf(f.IO.StdOut, "%s %s: Split %s into %s.\n", c.GreenCheck(), c.Blue(title), utils.Pluralize(len(files), "file"), branch)
+			}
+
+			return nil
+		},
+	}
+	stackSplitCmd.Flags().StringSliceVarP(&files, "file", "f", nil, "Files to move into the new diff. Prompts for them if not set.")
+	stackSplitCmd.Flags().StringVarP(&description, "description", "d", "", "Description of the new diff.")
+	stackSplitCmd.Flags().StringVarP(&description, "message", "m", "", "Alias for the description flag.")
+	stackSplitCmd.MarkFlagsMutuallyExclusive("message", "description")
+
+	return stackSplitCmd
+}
+
+func promptForFiles(f *cmdutils.Factory, stack *git.Stack, ref git.StackRef) ([]string, error) {
+	if !f.IO.PromptEnabled() {
+		return nil, errors.New("no files selected. Use --file to select the files to move into the new diff.")
+	}
+
+	changed, err := stack.RefFiles(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	err = prompt.MultiSelect(&files, "files", "Select the files to move into the new diff:", changed)
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
@@ -0,0 +1,49 @@
+package save
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func Test_stackSplitCmd(t *testing.T) {
+	dir := git.InitGitRepoWithCommit(t)
+	err := git.SetLocalConfig("glab.currentstack", "cool-test-feature")
+	require.Nil(t, err)
+
+	getText := getMockEditor("", &[]string{})
+
+	createTemporaryFiles(t, dir, []string{"first"})
+	_, err = runSaveCommand(nil, getText, true, "-m first first")
+	require.Nil(t, err)
+
+	createTemporaryFiles(t, dir, []string{"second", "third"})
+	_, err = runSaveCommand(nil, getText, true, "-m second second third")
+	require.Nil(t, err)
+
+	_, stdout, stderr, factory := setupTestFactory(nil, false)
+	_, err = cmdtest.ExecuteCommand(NewCmdSplitStack(factory, getText), "-m third", stdout, stderr)
+	require.EqualError(t, err, "no files selected. Use --file to select the files to move into the new diff.")
+
+	_, stdout, stderr, factory = setupTestFactory(nil, true)
+	output, err := cmdtest.ExecuteCommand(NewCmdSplitStack(factory, getText), "--file third -m third", stdout, stderr)
+	require.Nil(t, err)
+	require.Contains(t, output.String(), "cool-test-feature: Split 1 file into ")
+
+	stack, err := git.GatherStackRefs("cool-test-feature")
+	require.Nil(t, err)
+	require.Len(t, stack.Refs, 3)
+
+	last := stack.Last()
+	require.Equal(t, "third", last.Description)
+
+	files, err := stack.RefFiles(last)
+	require.Nil(t, err)
+	require.Equal(t, []string{"third"}, files)
+
+	files, err = stack.RefFiles(stack.Refs[last.Prev])
+	require.Nil(t, err)
+	require.Equal(t, []string{"second"}, files)
+}
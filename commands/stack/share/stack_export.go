@@ -0,0 +1,95 @@
+package share
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+func NewCmdStackExport(f *cmdutils.Factory) *cobra.Command {
+	stackExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Share the current stack by pushing its metadata to the remote. (EXPERIMENTAL.)",
+		Long: heredoc.Docf(`
+			Push the metadata of the current stack to the %[1]s%[2]s<title>%[1]s ref of the remote,
+			so you can import the stack on another computer, or a teammate can work on it,
+			with %[1]sglab stack import%[1]s.
+
+			Export the stack again after changing it. The branches of the stack are not
+			pushed: run %[1]sglab stack sync%[1]s to push them.
+		`, "`", git.StackRefPrefix) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack export
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return err
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return err
+			}
+
+			if stack.Empty() {
+				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+			}
+
+			f.IO.StartSpinner("Exporting stack %s", title)
+			err = stack.Export(git.DefaultRemote)
+			f.IO.StopSpinner("")
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Exported stack %s to %s on %s.\n", c.GreenCheck(), title, git.StackRefName(title), git.DefaultRemote)
+
+			unpushed, err := unpushedBranches(&stack, git.StandardGitCommand{})
+			if err != nil {
+				return err
+			}
+			if len(unpushed) > 0 {
+				fmt.Fprintf(f.IO.StdErr, "%s These branches are not on %s yet: %s. Run 'glab stack sync' to push them.\n",
+					c.WarnIcon(), git.DefaultRemote, strings.Join(unpushed, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	return stackExportCmd
+}
+
+// unpushedBranches returns the branches of the stack that are not on the remote.
+func unpushedBranches(stack *git.Stack, gr git.GitRunner) ([]string, error) {
+	output, err := gr.Git(append([]string{"ls-remote", "--heads", git.DefaultRemote}, stack.Branches()...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	pushed := make(map[string]bool)
+	for _, line := range strings.Split(output, "\n") {
+		if _, ref, found := strings.Cut(line, "\t"); found {
+			pushed[strings.TrimPrefix(ref, "refs/heads/")] = true
+		}
+	}
+
+	var unpushed []string
+	for _, branch := range stack.Branches() {
+		if !pushed[branch] {
+			unpushed = append(unpushed, branch)
+		}
+	}
+
+	return unpushed, nil
+}
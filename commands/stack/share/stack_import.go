@@ -0,0 +1,288 @@
+package share
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"golang.org/x/crypto/sha3"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/prompt"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ImportOpts struct {
+	Title      string
+	FromBranch string
+}
+
+func NewCmdStackImport(f *cmdutils.Factory) *cobra.Command {
+	opts := &ImportOpts{}
+
+	stackImportCmd := &cobra.Command{
+		Use:   "import [<title>] [flags]",
+		Short: "Import a stack exported with 'glab stack export', or rebuild it from merge requests. (EXPERIMENTAL.)",
+		Long: heredoc.Docf(`
+			Import a stack to work on it from this computer.
+
+			By default, imports the metadata that %[1]sglab stack export%[1]s pushed to the remote.
+			Without a title, lists the exported stacks to choose from.
+
+			With %[1]s--from-branch%[1]s, rebuilds the stack from the open merge requests of the
+			project instead: the merge request of the branch, the merge requests it targets
+			down to the default branch, and the merge requests that target it.
+
+			Local branches are created for the branches of the stack that exist only on the
+			remote, and the imported stack becomes the current stack.
+		`, "`") + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack import
+			glab stack import cool-feature
+			glab stack import cool-feature --from-branch jane-cool-feature-3a5e8f01
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Title = args[0]
+			}
+
+			if opts.FromBranch != "" && opts.Title == "" {
+				return &cmdutils.FlagError{Err: errors.New("a title for the stack is required with --from-branch.")}
+			}
+
+			if opts.Title == "" {
+				title, err := selectExportedStack(f)
+				if err != nil {
+					return err
+				}
+				opts.Title = title
+			}
+
+			title := utils.ReplaceNonAlphaNumericChars(opts.Title, "-")
+			if title != opts.Title {
+				c := f.IO.Color()
+				fmt.Fprintf(f.IO.StdErr, "%s warning: invalid characters have been replaced with dashes: %s\n", c.WarnIcon(), c.Blue(title))
+				opts.Title = title
+			}
+
+			existing, err := git.GatherStackRefs(opts.Title)
+			if err != nil {
+				return err
+			}
+			if !existing.Empty() {
+				return fmt.Errorf("a stack named %s already exists. Switch to it with 'glab stack switch %s'.", opts.Title, opts.Title)
+			}
+
+			var stack git.Stack
+			if opts.FromBranch != "" {
+				stack, err = stackFromBranch(f, opts)
+			} else {
+				f.IO.StartSpinner("Importing stack %s", opts.Title)
+				stack, err = git.ImportStack(git.DefaultRemote, opts.Title)
+				f.IO.StopSpinner("")
+			}
+			if err != nil {
+				return err
+			}
+
+			return saveImportedStack(f, &stack)
+		},
+	}
+	stackImportCmd.Flags().StringVar(&opts.FromBranch, "from-branch", "", "Rebuild the stack from the open merge requests around this branch.")
+
+	return stackImportCmd
+}
+
+func selectExportedStack(f *cmdutils.Factory) (string, error) {
+	titles, err := git.ExportedStacks(git.DefaultRemote)
+	if err != nil {
+		return "", err
+	}
+
+	if len(titles) == 0 {
+		return "", fmt.Errorf("no stack was exported to %s. Export one with 'glab stack export'.", git.DefaultRemote)
+	}
+
+	if !f.IO.PromptEnabled() {
+		return "", &cmdutils.FlagError{Err: fmt.Errorf("specify the title of the stack to import: %s.", strings.Join(titles, ", "))}
+	}
+
+	var title string
+	err = prompt.Select(&title, "title", "Which stack do you want to import?", titles)
+	if err != nil {
+		return "", fmt.Errorf("could not prompt: %w", err)
+	}
+
+	return title, nil
+}
+
+func saveImportedStack(f *cmdutils.Factory, stack *git.Stack) error {
+	if err := stack.WriteRefFiles(); err != nil {
+		return err
+	}
+
+	missing, err := stack.TrackRemoteBranches(git.DefaultRemote)
+	if err != nil {
+		return err
+	}
+
+	if err := git.SetLocalConfig("glab.currentstack", stack.Title); err != nil {
+		return fmt.Errorf("error setting local Git config: %v", err)
+	}
+
+	c := f.IO.Color()
+	fmt.Fprintf(f.IO.StdOut, "%s Imported stack %s: %s.\n", c.GreenCheck(), stack.Title, strings.Join(stack.Branches(), ", "))
+	if len(missing) > 0 {
+		fmt.Fprintf(f.IO.StdErr, "%s These branches are not on %s: %s.\n", c.WarnIcon(), git.DefaultRemote, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func stackFromBranch(f *cmdutils.Factory, opts *ImportOpts) (git.Stack, error) {
+	client, err := f.HttpClient()
+	if err != nil {
+		return git.Stack{}, err
+	}
+
+	repo, err := f.BaseRepo()
+	if err != nil {
+		return git.Stack{}, err
+	}
+
+	defaultBranch, err := git.GetDefaultBranch(git.DefaultRemote)
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("could not determine the default branch of %s: %v", git.DefaultRemote, err)
+	}
+
+	f.IO.StartSpinner("Finding the merge requests of the stack")
+	mrs, err := mrChain(client, repo, opts.FromBranch, defaultBranch)
+	f.IO.StopSpinner("")
+	if err != nil {
+		return git.Stack{}, err
+	}
+
+	return stackFromMRs(opts.Title, mrs), nil
+}
+
+// mrChain returns the open merge requests stacked around branch, from the one
+// that targets defaultBranch to the last one.
+func mrChain(client *gitlab.Client, repo glrepo.Interface, branch, defaultBranch string) ([]*gitlab.MergeRequest, error) {
+	mr, err := openMR(client, repo, &gitlab.ListProjectMergeRequestsOptions{SourceBranch: gitlab.Ptr(branch)})
+	if err != nil {
+		return nil, err
+	}
+	if mr == nil {
+		return nil, fmt.Errorf("%s has no open merge request.", branch)
+	}
+
+	chain := []*gitlab.MergeRequest{mr}
+	seen := map[string]bool{mr.SourceBranch: true}
+
+	// down to the default branch
+	for first := mr; first.TargetBranch != defaultBranch; {
+		if seen[first.TargetBranch] {
+			return nil, fmt.Errorf("the merge requests of %s target each other.", first.TargetBranch)
+		}
+
+		first, err = openMR(client, repo, &gitlab.ListProjectMergeRequestsOptions{SourceBranch: gitlab.Ptr(first.TargetBranch)})
+		if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			return nil, fmt.Errorf("%s targets %s, which has no open merge request, instead of %s.", chain[0].SourceBranch, chain[0].TargetBranch, defaultBranch)
+		}
+
+		seen[first.SourceBranch] = true
+		chain = append([]*gitlab.MergeRequest{first}, chain...)
+	}
+
+	// up from branch
+	for last := mr; ; {
+		last, err = openMR(client, repo, &gitlab.ListProjectMergeRequestsOptions{TargetBranch: gitlab.Ptr(last.SourceBranch)})
+		if err != nil {
+			return nil, err
+		}
+		if last == nil {
+			break
+		}
+		if seen[last.SourceBranch] {
+			return nil, fmt.Errorf("the merge requests of %s target each other.", last.SourceBranch)
+		}
+
+		seen[last.SourceBranch] = true
+		chain = append(chain, last)
+	}
+
+	return chain, nil
+}
+
+// openMR returns the only open merge request matching opts, or nil if there is none.
+func openMR(client *gitlab.Client, repo glrepo.Interface, opts *gitlab.ListProjectMergeRequestsOptions) (*gitlab.MergeRequest, error) {
+	opts.State = gitlab.Ptr("opened")
+
+	mrs, err := api.ListMRs(client, repo.FullName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(mrs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return mrs[0], nil
+	}
+
+	var iids []string
+	for _, mr := range mrs {
+		iids = append(iids, fmt.Sprintf("!%d", mr.IID))
+	}
+	return nil, fmt.Errorf("several open merge requests match: %s. A stack can't have branches.", strings.Join(iids, ", "))
+}
+
+func stackFromMRs(title string, mrs []*gitlab.MergeRequest) git.Stack {
+	stack := git.Stack{Title: title, Refs: make(map[string]git.StackRef)}
+
+	var prev string
+	for i, mr := range mrs {
+		ref := git.StackRef{
+			SHA:         refSHA(mr.SourceBranch),
+			Branch:      mr.SourceBranch,
+			MR:          mr.WebURL,
+			Description: mr.Title,
+			Prev:        prev,
+		}
+		if i < len(mrs)-1 {
+			ref.Next = refSHA(mrs[i+1].SourceBranch)
+		}
+
+		stack.Refs[ref.SHA] = ref
+		prev = ref.SHA
+	}
+
+	return stack
+}
+
+var stackBranchSHA = regexp.MustCompile(`-([0-9a-f]{8})$`)
+
+// refSHA returns the SHA of the ref of branch: the one in the name of branches
+// created by 'glab stack save', or one derived from the name of the branch.
+func refSHA(branch string) string {
+	if match := stackBranchSHA.FindStringSubmatch(branch); match != nil {
+		return match[1]
+	}
+
+	hash := make([]byte, 4)
+	sha3.ShakeSum256(hash, []byte(branch))
+	return hex.EncodeToString(hash)
+}
@@ -0,0 +1,76 @@
+package share
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+func stubListMRs(t *testing.T, mrs ...*gitlab.MergeRequest) {
+	t.Helper()
+
+	listMRs := api.ListMRs
+	t.Cleanup(func() { api.ListMRs = listMRs })
+
+	api.ListMRs = func(_ *gitlab.Client, _ interface{}, opts *gitlab.ListProjectMergeRequestsOptions, _ ...api.CliListMROption) ([]*gitlab.MergeRequest, error) {
+		require.Equal(t, "opened", *opts.State)
+
+		var found []*gitlab.MergeRequest
+		for _, mr := range mrs {
+			if (opts.SourceBranch != nil && mr.SourceBranch == *opts.SourceBranch) ||
+				(opts.TargetBranch != nil && mr.TargetBranch == *opts.TargetBranch) {
+				found = append(found, mr)
+			}
+		}
+		return found, nil
+	}
+}
+
+func Test_mrChain(t *testing.T) {
+	repo := glrepo.TestProject("stack_guy", "stackproject")
+
+	stubListMRs(t,
+		&gitlab.MergeRequest{IID: 1, SourceBranch: "jane-cool-3a5e8f01", TargetBranch: "main", Title: "Add API"},
+		&gitlab.MergeRequest{IID: 2, SourceBranch: "api-docs", TargetBranch: "jane-cool-3a5e8f01", Title: "Document API"},
+		&gitlab.MergeRequest{IID: 3, SourceBranch: "cli", TargetBranch: "api-docs", Title: "Add command"},
+		&gitlab.MergeRequest{IID: 4, SourceBranch: "other", TargetBranch: "main"},
+	)
+
+	mrs, err := mrChain(nil, repo, "api-docs", "main")
+	require.NoError(t, err)
+
+	var iids []int
+	for _, mr := range mrs {
+		iids = append(iids, mr.IID)
+	}
+	require.Equal(t, []int{1, 2, 3}, iids)
+
+	stack := stackFromMRs("cool", mrs)
+	require.Equal(t, []string{"jane-cool-3a5e8f01", "api-docs", "cli"}, stack.Branches())
+	require.Equal(t, "Document API", stack.Refs[refSHA("api-docs")].Description)
+
+	_, err = mrChain(nil, repo, "nope", "main")
+	require.EqualError(t, err, "nope has no open merge request.")
+}
+
+func Test_mrChain_branched(t *testing.T) {
+	stubListMRs(t,
+		&gitlab.MergeRequest{IID: 1, SourceBranch: "one", TargetBranch: "main"},
+		&gitlab.MergeRequest{IID: 2, SourceBranch: "two", TargetBranch: "one"},
+		&gitlab.MergeRequest{IID: 3, SourceBranch: "three", TargetBranch: "one"},
+	)
+
+	_, err := mrChain(nil, glrepo.TestProject("stack_guy", "stackproject"), "one", "main")
+	require.EqualError(t, err, "several open merge requests match: !2, !3. A stack can't have branches.")
+}
+
+func Test_refSHA(t *testing.T) {
+	require.Equal(t, "3a5e8f01", refSHA("jane-cool-3a5e8f01"))
+	require.Len(t, refSHA("api-docs"), 8)
+	require.Equal(t, refSHA("api-docs"), refSHA("api-docs"))
+	require.NotEqual(t, refSHA("api-docs"), refSHA("cli"))
+}
@@ -3,9 +3,14 @@ package stack
 import (
 	"gitlab.com/gitlab-org/cli/commands/cmdutils"
 	stackCreateCmd "gitlab.com/gitlab-org/cli/commands/stack/create"
+	stackDeleteCmd "gitlab.com/gitlab-org/cli/commands/stack/delete"
+	stackLandCmd "gitlab.com/gitlab-org/cli/commands/stack/land"
 	stackListCmd "gitlab.com/gitlab-org/cli/commands/stack/list"
 	stackMoveCmd "gitlab.com/gitlab-org/cli/commands/stack/navigate"
+	stackReorderCmd "gitlab.com/gitlab-org/cli/commands/stack/reorder"
 	stackSaveCmd "gitlab.com/gitlab-org/cli/commands/stack/save"
+	stackShareCmd "gitlab.com/gitlab-org/cli/commands/stack/share"
+	stackStatusCmd "gitlab.com/gitlab-org/cli/commands/stack/status"
 	stackSwitchCmd "gitlab.com/gitlab-org/cli/commands/stack/switch"
 	stackSyncCmd "gitlab.com/gitlab-org/cli/commands/stack/sync"
 	"gitlab.com/gitlab-org/cli/pkg/surveyext"
@@ -46,7 +51,15 @@ func NewCmdStack(f *cmdutils.Factory) *cobra.Command {
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackLast(f))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackMove(f))
 	stackCmd.AddCommand(stackListCmd.NewCmdStackList(f))
+	stackCmd.AddCommand(stackStatusCmd.NewCmdStackStatus(f))
+	stackCmd.AddCommand(stackLandCmd.NewCmdStackLand(f))
+	stackCmd.AddCommand(stackShareCmd.NewCmdStackExport(f))
+	stackCmd.AddCommand(stackShareCmd.NewCmdStackImport(f))
+	stackCmd.AddCommand(stackDeleteCmd.NewCmdStackDelete(f))
 	stackCmd.AddCommand(stackSwitchCmd.NewCmdStackSwitch(f))
+	stackCmd.AddCommand(stackReorderCmd.NewCmdStackReorder(f, getTextFromEditor))
+	stackCmd.AddCommand(stackReorderCmd.NewCmdStackFold(f))
+	stackCmd.AddCommand(stackSaveCmd.NewCmdSplitStack(f, getTextFromEditor))
 
 	return stackCmd
 }
@@ -0,0 +1,336 @@
+package status
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/commands/stack/sync"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/text"
+)
+
+type StatusOpts struct {
+	OutputFormat string
+
+	IO *iostreams.IOStreams
+}
+
+// BranchState compares a branch with another one: its parent in the stack, or its remote branch.
+type BranchState struct {
+	Branch string `json:"branch"`
+	Ahead  int    `json:"ahead"`
+	Behind int    `json:"behind"`
+}
+
+// MRState is the state of the merge request of a ref.
+type MRState struct {
+	IID               int    `json:"iid"`
+	WebURL            string `json:"web_url"`
+	State             string `json:"state"`
+	PipelineStatus    string `json:"pipeline_status,omitempty"`
+	ApprovalsGiven    int    `json:"approvals_given"`
+	ApprovalsRequired int    `json:"approvals_required"`
+	UnresolvedThreads int    `json:"unresolved_threads"`
+}
+
+// RefStatus is the status of one ref of the stack.
+type RefStatus struct {
+	Branch      string       `json:"branch"`
+	Description string       `json:"description"`
+	Current     bool         `json:"current"`
+	MR          *MRState     `json:"merge_request"`
+	Parent      *BranchState `json:"parent"`
+	Remote      *BranchState `json:"remote"`
+	SyncActions []string     `json:"sync_actions"`
+}
+
+func NewCmdStackStatus(f *cmdutils.Factory) *cobra.Command {
+	opts := &StatusOpts{
+		IO: f.IO,
+	}
+
+	stackStatusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of every diff in the stack. (EXPERIMENTAL.)",
+		Long: heredoc.Doc(`
+			Show the status of every diff in the stack: its merge request and pipeline,
+			approvals, unresolved threads, whether its branch is behind its parent in the
+			stack, and what 'glab stack sync' would do.
+
+			Remote branches are compared as of the last fetch. Like 'glab stack sync',
+			the command checks out each branch to get its status, then returns to the
+			current branch.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			glab stack status
+			glab stack status --output json
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return err
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return err
+			}
+
+			if stack.Empty() {
+				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+			}
+
+			client, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			currentBranch, _ := git.CurrentBranch()
+
+			// the first ref is compared with the default branch, like 'glab stack sync' does.
+			defaultBranch := ""
+			if branch, err := git.GetDefaultBranch(git.DefaultRemote); err == nil {
+				defaultBranch = git.DefaultRemote + "/" + branch
+			}
+
+			f.IO.StartSpinner("Fetching stack status")
+			statuses, err := stackStatus(client, &stack, currentBranch, defaultBranch, git.StandardGitCommand{})
+			f.IO.StopSpinner("")
+			if err != nil {
+				return err
+			}
+
+			if opts.OutputFormat == "json" {
+				statusJSON, _ := json.Marshal(statuses)
+				fmt.Fprintln(opts.IO.StdOut, string(statusJSON))
+				return nil
+			}
+
+			printStatus(opts.IO, title, statuses)
+			return nil
+		},
+	}
+	stackStatusCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+
+	return stackStatusCmd
+}
+
+func stackStatus(client *gitlab.Client, stack *git.Stack, currentBranch, defaultBranch string, gr git.GitRunner) ([]*RefStatus, error) {
+	if currentBranch != "" {
+		defer func() { _, _ = gr.Git("checkout", currentBranch) }()
+	}
+
+	var statuses []*RefStatus
+	for ref := range stack.Iter() {
+		status := &RefStatus{
+			Branch:      ref.Branch,
+			Description: ref.Description,
+			Current:     ref.Branch == currentBranch,
+		}
+
+		parent := defaultBranch
+		if !ref.IsFirst() {
+			parent = stack.Refs[ref.Prev].Branch
+		}
+		if parent != "" {
+			state, err := compareBranches(gr, parent, ref.Branch)
+			if err != nil {
+				return nil, err
+			}
+			status.Parent = state
+		}
+
+		remote, err := compareWithUpstream(gr, ref.Branch)
+		if err != nil {
+			return nil, err
+		}
+		status.Remote = remote
+
+		if ref.MR != "" {
+			status.MR, err = mrState(client, ref.MR)
+			if err != nil {
+				return nil, fmt.Errorf("could not get merge request of %s: %v", ref.Branch, err)
+			}
+		}
+
+		gitStatus, err := sync.BranchStatus(&ref, gr)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the status of %s: %v", ref.Branch, err)
+		}
+
+		status.SyncActions = syncActions(status, gitStatus)
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// compareBranches counts the commits of branch that are not in base, and the other way around.
+func compareBranches(gr git.GitRunner, base, branch string) (*BranchState, error) {
+	output, err := gr.Git("rev-list", "--left-right", "--count", base+"..."+branch, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	state := &BranchState{Branch: base}
+	_, err = fmt.Sscanf(strings.TrimSpace(output), "%d %d", &state.Behind, &state.Ahead)
+	if err != nil {
+		return nil, fmt.Errorf("could not compare %s with %s: %v", branch, base, err)
+	}
+
+	return state, nil
+}
+
+// compareWithUpstream compares a branch with its upstream branch, or returns nil if it has none.
+func compareWithUpstream(gr git.GitRunner, branch string) (*BranchState, error) {
+	output, err := gr.Git("for-each-ref", "--format=%(upstream:short)", "refs/heads/"+branch)
+	if err != nil {
+		return nil, err
+	}
+
+	upstream := strings.TrimSpace(output)
+	if upstream == "" || !hasRef(gr, upstream) {
+		return nil, nil
+	}
+
+	return compareBranches(gr, upstream, branch)
+}
+
+func hasRef(gr git.GitRunner, ref string) bool {
+	_, err := gr.Git("rev-parse", "--verify", "--quiet", ref)
+	return err == nil
+}
+
+func mrState(client *gitlab.Client, webURL string) (*MRState, error) {
+	project, iid, err := mrutils.ParseMRURL(webURL)
+	if err != nil {
+		return nil, err
+	}
+
+	mr, err := api.GetMR(client, project, iid, &gitlab.GetMergeRequestsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	state := &MRState{
+		IID:    mr.IID,
+		WebURL: mr.WebURL,
+		State:  mr.State,
+	}
+	if mr.HeadPipeline != nil {
+		state.PipelineStatus = mr.HeadPipeline.Status
+	}
+
+	discussions, err := api.ListMRDiscussions(client, project, iid)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range discussions {
+		if len(d.Notes) > 0 && d.Notes[0].Resolvable && !d.Notes[0].Resolved {
+			state.UnresolvedThreads++
+		}
+	}
+
+	// Approvals are not available on every instance.
+	if approvals, err := api.GetMRApprovals(client, project, iid); err == nil {
+		state.ApprovalsGiven = len(approvals.ApprovedBy)
+		state.ApprovalsRequired = approvals.ApprovalsRequired
+	}
+
+	return state, nil
+}
+
+// syncActions describes what 'glab stack sync' would do with a ref, given the 'git status' of its branch.
+func syncActions(status *RefStatus, gitStatus string) []string {
+	actions := []string{}
+
+	switch {
+	case strings.Contains(gitStatus, sync.BranchIsBehind):
+		actions = append(actions, "pull")
+	case strings.Contains(gitStatus, sync.BranchHasDiverged):
+		actions = append(actions, "rebase and force push")
+	}
+
+	switch {
+	case status.MR == nil:
+		actions = append(actions, "push and create merge request")
+	case status.MR.State == "merged":
+		actions = append(actions, "remove from stack")
+	}
+
+	return actions
+}
+
+func printStatus(ios *iostreams.IOStreams, title string, statuses []*RefStatus) {
+	c := ios.Color()
+
+	fmt.Fprintf(ios.StdOut, "Showing status of stack %s.\n\n", c.Bold(title))
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("", "BRANCH", "MERGE REQUEST", "PIPELINE", "APPROVALS", "THREADS", "PARENT", "SYNC")
+	for _, s := range statuses {
+		marker := ""
+		if s.Current {
+			marker = ">"
+		}
+
+		mr, pipeline, approvals, threads := c.Gray("none"), "", "", ""
+		if s.MR != nil {
+			mr = fmt.Sprintf("!%d (%s)", s.MR.IID, s.MR.State)
+			pipeline = pipelineStatus(c, s.MR.PipelineStatus)
+			approvals = fmt.Sprintf("%d/%d", s.MR.ApprovalsGiven, s.MR.ApprovalsRequired)
+			threads = strconv.Itoa(s.MR.UnresolvedThreads)
+			if s.MR.UnresolvedThreads > 0 {
+				threads = c.Yellow(threads)
+			}
+		}
+
+		sync := c.Gray("nothing")
+		if len(s.SyncActions) > 0 {
+			sync = strings.Join(s.SyncActions, ", ")
+		}
+
+		table.AddRow(marker, s.Branch, mr, pipeline, approvals, threads, parentState(c, s.Parent), sync)
+	}
+
+	fmt.Fprint(ios.StdOut, table.Render())
+}
+
+func pipelineStatus(c *iostreams.ColorPalette, status string) string {
+	switch status {
+	case "":
+		return c.Gray("none")
+	case "success":
+		return c.Green(status)
+	case "failed":
+		return c.Red(status)
+	default:
+		return c.Yellow(status)
+	}
+}
+
+func parentState(c *iostreams.ColorPalette, parent *BranchState) string {
+	switch {
+	case parent == nil:
+		return c.Gray("unknown")
+	case parent.Behind > 0 && parent.Ahead > 0:
+		return c.Red(fmt.Sprintf("diverged from %s", parent.Branch))
+	case parent.Behind > 0:
+		return c.Yellow(fmt.Sprintf("%d behind %s", parent.Behind, parent.Branch))
+	default:
+		return c.Green("up to date")
+	}
+}
@@ -0,0 +1,151 @@
+package status
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+)
+
+// fakeGit answers git commands from a map of their arguments to their output.
+// 'git status' is answered for the branch checked out last, as "<branch>: status -uno".
+type fakeGit struct {
+	outputs map[string]string
+	branch  string
+}
+
+func (g *fakeGit) Git(args ...string) (string, error) {
+	command := strings.Join(args, " ")
+	switch {
+	case args[0] == "checkout":
+		g.branch = args[1]
+		return "", nil
+	case args[0] == "status":
+		command = g.branch + ": " + command
+	}
+
+	output, ok := g.outputs[command]
+	if !ok {
+		return "", fmt.Errorf("unexpected git command: %s", command)
+	}
+	return output, nil
+}
+
+func Test_stackStatus(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/stack_guy/stackproject/merge_requests/3",
+		httpmock.NewStringResponse(http.StatusOK, `{
+			"iid": 3,
+			"state": "opened",
+			"web_url": "https://gitlab.com/stack_guy/stackproject/-/merge_requests/3",
+			"head_pipeline": {"status": "failed"}
+		}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/stack_guy/stackproject/merge_requests/3/discussions",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"notes": [{"resolvable": true, "resolved": false}]},
+			{"notes": [{"resolvable": true, "resolved": true}]},
+			{"notes": [{"resolvable": false}]}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/stack_guy/stackproject/merge_requests/3/approvals",
+		httpmock.NewStringResponse(http.StatusOK, `{"approvals_required": 2, "approved_by": [{"user": {"username": "reviewer"}}]}`))
+
+	ios, _, _, _ := cmdtest.InitIOStreams(false, "")
+	f := cmdtest.InitFactory(ios, fakeHTTP)
+	_, _ = f.HttpClient()
+	client, err := f.HttpClient()
+	require.NoError(t, err)
+
+	stack := &git.Stack{
+		Title: "cool stack",
+		Refs: map[string]git.StackRef{
+			"1": {SHA: "1", Next: "2", Branch: "one", MR: "https://gitlab.com/stack_guy/stackproject/-/merge_requests/3"},
+			"2": {SHA: "2", Prev: "1", Branch: "two"},
+		},
+	}
+	gr := &fakeGit{outputs: map[string]string{
+		"rev-list --left-right --count origin/main...one --":     "0\t1\n",
+		"for-each-ref --format=%(upstream:short) refs/heads/one": "origin/one\n",
+		"rev-parse --verify --quiet origin/one":                  "abc\n",
+		"rev-list --left-right --count origin/one...one --":      "2\t0\n",
+		"one: status -uno":                                       "Your branch is behind 'origin/one' by 2 commits, and can be fast-forwarded.\n\nnothing to commit (use -u to show untracked files)\n",
+		"rev-list --left-right --count one...two --":             "1\t1\n",
+		"for-each-ref --format=%(upstream:short) refs/heads/two": "\n",
+		"two: status -uno":                                       "On branch two\nnothing to commit (use -u to show untracked files)\n",
+	}}
+
+	statuses, err := stackStatus(client, stack, "two", "origin/main", gr)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+
+	one := statuses[0]
+	require.False(t, one.Current)
+	require.Equal(t, &MRState{
+		IID:               3,
+		WebURL:            "https://gitlab.com/stack_guy/stackproject/-/merge_requests/3",
+		State:             "opened",
+		PipelineStatus:    "failed",
+		ApprovalsGiven:    1,
+		ApprovalsRequired: 2,
+		UnresolvedThreads: 1,
+	}, one.MR)
+	require.Equal(t, &BranchState{Branch: "origin/main", Ahead: 1}, one.Parent)
+	require.Equal(t, &BranchState{Branch: "origin/one", Behind: 2}, one.Remote)
+	require.Equal(t, []string{"pull"}, one.SyncActions)
+
+	two := statuses[1]
+	require.True(t, two.Current)
+	require.Nil(t, two.MR)
+	require.Nil(t, two.Remote)
+	require.Equal(t, &BranchState{Branch: "one", Ahead: 1, Behind: 1}, two.Parent)
+	require.Equal(t, []string{"push and create merge request"}, two.SyncActions)
+
+	// the branch that was current is checked out again.
+	require.Equal(t, "two", gr.branch)
+}
+
+func Test_syncActions(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    RefStatus
+		gitStatus string
+		want      []string
+	}{
+		{
+			name:      "up to date",
+			status:    RefStatus{MR: &MRState{State: "opened"}},
+			gitStatus: "Your branch is up to date with 'origin/one'.\n\nnothing to commit (use -u to show untracked files)\n",
+			want:      []string{},
+		},
+		{
+			name:      "diverged from remote",
+			status:    RefStatus{MR: &MRState{State: "opened"}},
+			gitStatus: "Your branch and 'origin/one' have diverged,\nand have 1 and 1 different commits each, respectively.\n",
+			want:      []string{"rebase and force push"},
+		},
+		{
+			name:      "ahead of remote",
+			status:    RefStatus{MR: &MRState{State: "opened"}},
+			gitStatus: "Your branch is ahead of 'origin/one' by 1 commit.\n\nnothing to commit (use -u to show untracked files)\n",
+			want:      []string{},
+		},
+		{
+			name:      "merged",
+			status:    RefStatus{MR: &MRState{State: "merged"}},
+			gitStatus: "nothing to commit (use -u to show untracked files)\n",
+			want:      []string{"remove from stack"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, syncActions(&tc.status, tc.gitStatus))
+		})
+	}
+}
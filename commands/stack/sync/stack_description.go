@@ -0,0 +1,86 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+// The stack block is kept between these markers in the description of every
+// merge request of a stack. Text outside of them is never changed.
+const (
+	stackBlockStart = "<!-- glab stack: start -->"
+	stackBlockEnd   = "<!-- glab stack: end -->"
+)
+
+// updateStackDescriptions refreshes the stack block in the description of the
+// merge request of every ref. mrs maps the SHA of each ref to its merge request.
+func updateStackDescriptions(client *gitlab.Client, stack *git.Stack, mrs map[string]*gitlab.MergeRequest) error {
+	var ordered []*gitlab.MergeRequest
+	for ref := range stack.Iter() {
+		if mr, ok := mrs[ref.SHA]; ok {
+			ordered = append(ordered, mr)
+		}
+	}
+
+	for _, mr := range ordered {
+		description := replaceStackBlock(mr.Description, stackBlock(stack.Title, ordered, mr))
+		if description == mr.Description {
+			continue
+		}
+
+		debug("Updating description of merge request:", mr.WebURL)
+		_, err := api.UpdateMR(client, mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{
+			Description: gitlab.Ptr(description),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating the description of merge request !%d: %v", mr.IID, err)
+		}
+		mr.Description = description
+	}
+
+	return nil
+}
+
+// stackBlock lists the merge requests of a stack in order, marking current.
+func stackBlock(title string, mrs []*gitlab.MergeRequest, current *gitlab.MergeRequest) string {
+	var b strings.Builder
+
+	b.WriteString(stackBlockStart + "\n")
+	fmt.Fprintf(&b, "This merge request is part of the stack **%s**. Review and merge the merge requests in this order:\n\n", title)
+	for i, mr := range mrs {
+		line := fmt.Sprintf("!%d %s (%s)", mr.IID, mr.Title, mr.State)
+		if mr.IID == current.IID && mr.ProjectID == current.ProjectID {
+			line = fmt.Sprintf("**%s** ← this merge request", line)
+		}
+		fmt.Fprintf(&b, "%d. %s\n", i+1, line)
+	}
+	b.WriteString("\n_Updated by `glab stack sync`._\n")
+	b.WriteString(stackBlockEnd)
+
+	return b.String()
+}
+
+// replaceStackBlock replaces the stack block in description with block, or
+// appends block if the description has none.
+func replaceStackBlock(description, block string) string {
+	start := strings.Index(description, stackBlockStart)
+	if start == -1 {
+		if strings.TrimSpace(description) == "" {
+			return block
+		}
+		return strings.TrimRight(description, "\n") + "\n\n" + block
+	}
+
+	// without an end marker, the block runs to the end of the description.
+	rest := ""
+	if end := strings.Index(description[start:], stackBlockEnd); end != -1 {
+		rest = description[start+end+len(stackBlockEnd):]
+	}
+
+	return description[:start] + block + rest
+}
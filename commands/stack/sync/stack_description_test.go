@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/require"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func Test_stackBlock(t *testing.T) {
+	mrs := []*gitlab.MergeRequest{
+		{IID: 1, ProjectID: 3, Title: "Add API", State: "merged"},
+		{IID: 2, ProjectID: 3, Title: "Add command", State: "opened"},
+		{IID: 3, ProjectID: 3, Title: "Add docs", State: "closed"},
+	}
+
+	want := heredoc.Doc(`
+		<!-- glab stack: start -->
+		This merge request is part of the stack **cool stack**. Review and merge the merge requests in this order:
+
+		1. !1 Add API (merged)
+		2. **!2 Add command (opened)** ← this merge request
+		3. !3 Add docs (closed)
+
+		_Updated by ` + "`glab stack sync`" + `._
+		<!-- glab stack: end -->`)
+
+	require.Equal(t, want, stackBlock("cool stack", mrs, mrs[1]))
+}
+
+func Test_replaceStackBlock(t *testing.T) {
+	block := stackBlockStart + "\nnew\n" + stackBlockEnd
+
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			name:        "empty description",
+			description: "",
+			want:        block,
+		},
+		{
+			name:        "appends to the description",
+			description: "Some text.\n",
+			want:        "Some text.\n\n" + block,
+		},
+		{
+			name:        "replaces the block only",
+			description: "Before.\n\n" + stackBlockStart + "\nold\n" + stackBlockEnd + "\n\nAfter.",
+			want:        "Before.\n\n" + block + "\n\nAfter.",
+		},
+		{
+			name:        "replaces a block without an end marker",
+			description: "Before.\n\n" + stackBlockStart + "\nold",
+			want:        "Before.\n\n" + block,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, replaceStackBlock(tc.description, block))
+		})
+	}
+}
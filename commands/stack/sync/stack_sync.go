@@ -33,6 +33,9 @@ type Options struct {
 	Remotes     func() (glrepo.Remotes, error)
 	Config      func() (config.Config, error)
 	user        gitlab.User
+
+	Continue bool
+	Abort    bool
 }
 
 var iostream *iostreams.IOStreams
@@ -67,15 +70,31 @@ func NewCmdSyncStack(f *cmdutils.Factory) *cobra.Command {
 1. Pushes any amended changes to their merge requests.
 1. Rebases any changes that happened previously in the stack.
 1. Removes any branches that were already merged, or with a closed merge request.
+1. Lists the merge requests of the stack, in order, in the description of each
+   merge request. Only the text between the glab stack markers is updated.
+
+If a rebase stops on a conflict, resolve it and add the files with 'git add',
+then run 'glab stack sync --continue' to finish the sync. To restore every
+branch of the stack to its state before the sync, run 'glab stack sync --abort'.
 ` + text.ExperimentalString),
 		Example: heredoc.Doc(`
 			glab stack sync
+			glab stack sync --continue
+			glab stack sync --abort
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			iostream.StartSpinner("Syncing")
-
 			var gr git.StandardGitCommand
 
+			if opts.Abort {
+				err := abortSync(gr)
+				if err != nil {
+					return fmt.Errorf("could not abort sync: %v", err)
+				}
+				return nil
+			}
+
+			iostream.StartSpinner("Syncing")
+
 			err := stackSync(f, iostream, opts, gr)
 			iostream.StopSpinner("")
 			if err != nil {
@@ -86,6 +105,10 @@ func NewCmdSyncStack(f *cmdutils.Factory) *cobra.Command {
 		},
 	}
 
+	stackSaveCmd.Flags().BoolVar(&opts.Continue, "continue", false, "Continue a sync that stopped on a rebase conflict, after resolving the conflict.")
+	stackSaveCmd.Flags().BoolVar(&opts.Abort, "abort", false, "Abort a sync that stopped on a rebase conflict, and restore the branches of the stack.")
+	stackSaveCmd.MarkFlagsMutuallyExclusive("continue", "abort")
+
 	return stackSaveCmd
 }
 
@@ -126,45 +149,85 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 	opts.source = source
 	opts.user = *user
 
-	err = fetchOrigin(gr)
+	state, err := loadSyncState(stack.Title)
 	if err != nil {
 		return err
 	}
 
-	pushAfterSync := false
+	switch {
+	case opts.Continue && state == nil:
+		return errors.New("no sync of this stack stopped on a conflict, so there is nothing to continue.")
+	case !opts.Continue && state != nil:
+		return errors.New("a sync of this stack stopped on a conflict. Run `glab stack sync --continue` after resolving it, or `glab stack sync --abort`.")
+	}
 
-	for ref := range stack.Iter() {
-		status, err := branchStatus(&ref, gr)
+	if opts.Continue {
+		err = continueRebase(gr)
 		if err != nil {
-			return fmt.Errorf("error getting branch status: %v", err)
+			return err
 		}
+	}
 
-		switch {
-		case strings.Contains(status, BranchIsBehind):
-			err = branchBehind(&ref, gr)
-			if err != nil {
-				return err
-			}
-		case strings.Contains(status, BranchHasDiverged):
-			needsPush, err := branchDiverged(&ref, &stack, gr)
+	err = fetchOrigin(gr)
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		state, err = newSyncState(&stack, gr)
+		if err != nil {
+			return err
+		}
+	}
+
+	// when continuing, the refs up to the one that stopped on a conflict
+	// are already rebased.
+	resuming := opts.Continue
+	pushAfterSync := state.PushNeeded
+	mrs := make(map[string]*gitlab.MergeRequest)
+
+	for ref := range stack.Iter() {
+		if resuming {
+			resuming = ref.SHA != state.Ref
+		} else {
+			status, err := BranchStatus(&ref, gr)
 			if err != nil {
-				return err
+				return fmt.Errorf("error getting branch status: %v", err)
 			}
 
-			if needsPush {
-				pushAfterSync = true
+			switch {
+			case strings.Contains(status, BranchIsBehind):
+				err = branchBehind(&ref, gr)
+				if err != nil {
+					return err
+				}
+			case strings.Contains(status, BranchHasDiverged):
+				needsPush, err := branchDiverged(&ref, &stack, gr)
+				if err != nil {
+					state.Ref = ref.SHA
+					state.PushNeeded = true
+					if saveErr := state.save(); saveErr != nil {
+						return fmt.Errorf("error saving sync progress: %v", saveErr)
+					}
+					return err
+				}
+
+				if needsPush {
+					pushAfterSync = true
+				}
+			case strings.Contains(status, NothingToCommit):
+				// this is fine. we can just move on.
+			default:
+				return fmt.Errorf("your Git branch is ahead, but it shouldn't be. You might need to squash your commits.")
 			}
-		case strings.Contains(status, NothingToCommit):
-			// this is fine. we can just move on.
-		default:
-			return fmt.Errorf("your Git branch is ahead, but it shouldn't be. You might need to squash your commits.")
 		}
 
 		if ref.MR == "" {
-			err := populateMR(&ref, opts, client, gr)
+			mr, err := populateMR(&ref, opts, client, gr)
 			if err != nil {
 				return err
 			}
+			mrs[ref.SHA] = mr
 		} else {
 			// we found an MR. let's get the status:
 			mr, _, err := mrutils.MRFromArgsWithOpts(f, []string{ref.Branch}, nil, "any")
@@ -179,6 +242,9 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 			if err != nil {
 				return fmt.Errorf("error removing merged merge request: %v", err)
 			}
+			if mr.State != mergedStatus {
+				mrs[ref.SHA] = mr
+			}
 		}
 	}
 
@@ -189,6 +255,16 @@ func stackSync(f *cmdutils.Factory, iostream *iostreams.IOStreams, opts *Options
 		}
 	}
 
+	err = updateStackDescriptions(client, &stack, mrs)
+	if err != nil {
+		return err
+	}
+
+	err = state.remove()
+	if err != nil {
+		return fmt.Errorf("error removing sync progress: %v", err)
+	}
+
 	fmt.Print(progressString("Sync finished!"))
 	return nil
 }
@@ -227,7 +303,8 @@ func fetchOrigin(gr git.GitRunner) error {
 	return nil
 }
 
-func branchStatus(ref *git.StackRef, gr git.GitRunner) (string, error) {
+// BranchStatus checks out the branch of a ref and returns its 'git status'.
+func BranchStatus(ref *git.StackRef, gr git.GitRunner) (string, error) {
 	checkout, err := gr.Git("checkout", ref.Branch)
 	if err != nil {
 		return "", err
@@ -396,7 +473,8 @@ func branchDiverged(ref *git.StackRef, stack *git.Stack, gr git.GitRunner) (bool
 	if err != nil {
 		return false, errors.New(errorString(
 			"could not rebase, likely due to a merge conflict.",
-			"Fix the issues with Git and run `glab stack sync` again.",
+			"Resolve the conflicts, add the files with `git add`, and run `glab stack sync --continue`.",
+			"To restore the branches to their state before the sync, run `glab stack sync --abort`.",
 		))
 	}
 
@@ -416,13 +494,13 @@ func branchBehind(ref *git.StackRef, gr git.GitRunner) error {
 	return nil
 }
 
-func populateMR(ref *git.StackRef, opts *Options, client *gitlab.Client, gr git.GitRunner) error {
+func populateMR(ref *git.StackRef, opts *Options, client *gitlab.Client, gr git.GitRunner) (*gitlab.MergeRequest, error) {
 	// no MR - lets create one!
 	fmt.Println(progressString(ref.Branch + " needs a merge request. Creating it now."))
 
 	mr, err := createMR(client, opts, ref, gr)
 	if err != nil {
-		return fmt.Errorf("error updating stack ref files: %v", err)
+		return nil, fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
 	fmt.Println(progressString("Merge request created!"))
@@ -432,10 +510,10 @@ func populateMR(ref *git.StackRef, opts *Options, client *gitlab.Client, gr git.
 	ref.MR = mr.WebURL
 	err = git.UpdateStackRefFile(opts.stack.Title, *ref)
 	if err != nil {
-		return fmt.Errorf("error updating stack ref files: %v", err)
+		return nil, fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
-	return nil
+	return mr, nil
 }
 
 func getDefaultBranch(remote string, gr git.GitRunner) (string, error) {
@@ -0,0 +1,193 @@
+package sync
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+// syncState is the progress of a sync that stopped on a rebase conflict, so it can
+// be continued with --continue, or undone with --abort.
+type syncState struct {
+	Title string `json:"title"`
+	// Branch is the branch checked out before the sync.
+	Branch string `json:"branch"`
+	// Tips are the commits of the branches of the stack before the sync.
+	Tips map[string]string `json:"tips"`
+	// Ref is the SHA of the stack ref whose rebase stopped on a conflict.
+	Ref string `json:"ref"`
+	// PushNeeded is true if the branches must be force pushed at the end of the sync.
+	PushNeeded bool `json:"push_needed"`
+}
+
+// newSyncState records the branch tips of the stack before the sync changes them.
+func newSyncState(stack *git.Stack, gr git.GitRunner) (*syncState, error) {
+	state := &syncState{Title: stack.Title, Tips: make(map[string]string)}
+	state.Branch, _ = git.CurrentBranch()
+
+	branches := stack.Branches()
+	if len(branches) == 0 {
+		return state, nil
+	}
+
+	output, err := gr.Git(append([]string{"rev-parse"}, branches...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the commits of the stack branches: %v", err)
+	}
+
+	tips := strings.Fields(output)
+	if len(tips) != len(branches) {
+		return nil, fmt.Errorf("error getting the commits of the stack branches: expected %d commits, got %d", len(branches), len(tips))
+	}
+	for i, branch := range branches {
+		state.Tips[branch] = tips[i]
+	}
+
+	return state, nil
+}
+
+// loadSyncState returns the sync state of a stack, or nil if no sync stopped.
+func loadSyncState(title string) (*syncState, error) {
+	path, err := git.StackSyncStateFile(title)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &syncState{}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("error reading sync state %s: %v", path, err)
+	}
+
+	return state, nil
+}
+
+func (s *syncState) save() error {
+	path, err := git.StackSyncStateFile(s.Title)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("error marshaling data: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func (s *syncState) remove() error {
+	path, err := git.StackSyncStateFile(s.Title)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func rebaseInProgress(gr git.GitRunner) bool {
+	for _, dir := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := gr.Git("rev-parse", "--git-path", dir)
+		if err != nil {
+			continue
+		}
+		if _, err := os.Stat(strings.TrimSpace(path)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// continueRebase continues the rebase stopped by a conflict, if any.
+func continueRebase(gr git.GitRunner) error {
+	if !rebaseInProgress(gr) {
+		return nil
+	}
+
+	// don't open an editor for the commit messages.
+	_, err := gr.Git("-c", "core.editor=true", "rebase", "--continue")
+	if err != nil {
+		return errors.New(errorString(
+			"could not continue the rebase.",
+			"Resolve the remaining conflicts, add the files with `git add`, and run `glab stack sync --continue` again.",
+		))
+	}
+
+	return nil
+}
+
+// abortSync stops the rebase of a stopped sync, and restores every branch of the
+// stack to its commit before the sync.
+func abortSync(gr git.GitRunner) error {
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return fmt.Errorf("error getting current stack: %v", err)
+	}
+
+	state, err := loadSyncState(title)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return errors.New("no sync of this stack stopped on a conflict, so there is nothing to abort.")
+	}
+
+	if rebaseInProgress(gr) {
+		if _, err := gr.Git("rebase", "--abort"); err != nil {
+			return fmt.Errorf("error aborting the rebase: %v", err)
+		}
+	}
+
+	// a branch can't be reset while it is checked out.
+	if _, err := gr.Git("checkout", "--quiet", "--detach"); err != nil {
+		return err
+	}
+
+	branches := make([]string, 0, len(state.Tips))
+	for branch := range state.Tips {
+		branches = append(branches, branch)
+	}
+	slices.Sort(branches)
+
+	for _, branch := range branches {
+		if _, err := gr.Git("branch", "--force", branch, state.Tips[branch]); err != nil {
+			return fmt.Errorf("error restoring %s: %v", branch, err)
+		}
+	}
+
+	if state.Branch != "" {
+		if _, err := gr.Git("checkout", "--quiet", state.Branch); err != nil {
+			return err
+		}
+	}
+
+	if err := state.remove(); err != nil {
+		return err
+	}
+
+	fmt.Print(progressString("Sync aborted. Restored " + strings.Join(branches, ", ") + "."))
+	return nil
+}
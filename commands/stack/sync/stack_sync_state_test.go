@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.com/gitlab-org/cli/pkg/git"
+)
+
+func Test_syncState(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+
+	state, err := loadSyncState("my-stack")
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	state = &syncState{
+		Title:      "my-stack",
+		Branch:     "main",
+		Tips:       map[string]string{"branch-1": "abc", "branch-2": "def"},
+		Ref:        "123",
+		PushNeeded: true,
+	}
+	require.NoError(t, state.save())
+
+	loaded, err := loadSyncState("my-stack")
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+
+	// the state must not be listed as a stack.
+	stacks, err := git.GetStacks()
+	require.NoError(t, err)
+	require.Empty(t, stacks)
+
+	require.NoError(t, state.remove())
+
+	loaded, err = loadSyncState("my-stack")
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+}
+
+func Test_abortSync(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+	iostream, _, _ = setupTestFactory(nil)
+
+	var gr git.StandardGitCommand
+
+	original, err := gr.Git("rev-parse", "HEAD")
+	require.NoError(t, err)
+	original = strings.TrimSpace(original)
+
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "my-stack"))
+	require.NoError(t, git.CheckoutNewBranch("branch-1"))
+
+	state, err := newSyncState(&git.Stack{
+		Title: "my-stack",
+		Refs: map[string]git.StackRef{
+			"1": {SHA: "1", Branch: "branch-1"},
+		},
+	}, gr)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"branch-1": original}, state.Tips)
+	require.NoError(t, state.save())
+
+	_, err = gr.Git("commit", "--allow-empty", "-m", "rebased")
+	require.NoError(t, err)
+
+	require.NoError(t, abortSync(gr))
+
+	tip, err := gr.Git("rev-parse", "branch-1")
+	require.NoError(t, err)
+	require.Equal(t, original, strings.TrimSpace(tip))
+
+	branch, err := git.CurrentBranch()
+	require.NoError(t, err)
+	require.Equal(t, "branch-1", branch)
+
+	loaded, err := loadSyncState("my-stack")
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -94,7 +95,9 @@ func Test_stackSync(t *testing.T) {
 				git.MockStackUser(),
 				git.MockListStackMRsByBranch("Branch1", "25"),
 				git.MockGetStackMR("Branch1", "25"),
-				git.MockPostStackMR("Branch2", "Branch1", "3"),
+				git.MockPostStackMR("Branch2", "Branch1", "3", "26"),
+				git.MockPutStackMRDescription("3", "25"),
+				git.MockPutStackMRDescription("3", "26"),
 			},
 		},
 
@@ -118,8 +121,10 @@ func Test_stackSync(t *testing.T) {
 
 			httpMocks: []git.HttpMock{
 				git.MockStackUser(),
-				git.MockPostStackMR("Branch1", "", "3"),
-				git.MockPostStackMR("Branch2", "Branch1", "3"),
+				git.MockPostStackMR("Branch1", "", "3", "25"),
+				git.MockPostStackMR("Branch2", "Branch1", "3", "26"),
+				git.MockPutStackMRDescription("3", "25"),
+				git.MockPutStackMRDescription("3", "26"),
 			},
 		},
 
@@ -165,11 +170,17 @@ func Test_stackSync(t *testing.T) {
 				git.MockStackUser(),
 				git.MockListStackMRsByBranch("Branch1", "25"),
 				git.MockGetStackMR("Branch1", "25"),
-				git.MockPostStackMR("Branch2", "Branch1", "3"),
-				git.MockPostStackMR("Branch3", "Branch2", "3"),
-				git.MockPostStackMR("Branch4", "Branch3", "3"),
-				git.MockPostStackMR("Branch5", "Branch4", "3"),
-				git.MockPostStackMR("Branch6", "Branch5", "3"),
+				git.MockPostStackMR("Branch2", "Branch1", "3", "26"),
+				git.MockPostStackMR("Branch3", "Branch2", "3", "27"),
+				git.MockPostStackMR("Branch4", "Branch3", "3", "28"),
+				git.MockPostStackMR("Branch5", "Branch4", "3", "29"),
+				git.MockPostStackMR("Branch6", "Branch5", "3", "30"),
+				git.MockPutStackMRDescription("3", "25"),
+				git.MockPutStackMRDescription("3", "26"),
+				git.MockPutStackMRDescription("3", "27"),
+				git.MockPutStackMRDescription("3", "28"),
+				git.MockPutStackMRDescription("3", "29"),
+				git.MockPutStackMRDescription("3", "30"),
 			},
 		},
 	}
@@ -194,6 +205,7 @@ func Test_stackSync(t *testing.T) {
 			require.NoError(t, err)
 
 			mockCmd.EXPECT().Git([]string{"fetch", "origin"})
+			mockCmd.EXPECT().Git(append([]string{"rev-parse"}, stack.Branches()...)).Return(fakeTips(stack.Branches()), nil)
 
 			for ref := range stack.Iter() {
 				state := tc.args.stack.refs[ref.SHA].state
@@ -251,6 +263,14 @@ func createStack(t *testing.T, title string, scenario map[string]TestRef) {
 	}
 }
 
+func fakeTips(branches []string) string {
+	var tips []string
+	for _, branch := range branches {
+		tips = append(tips, "tip-of-"+branch)
+	}
+	return strings.Join(tips, "\n")
+}
+
 func checkoutBranch(branch string) func(_ ...string) (string, error) {
 	return func(_ ...string) (string, error) {
 		err := git.CheckoutBranch(branch)
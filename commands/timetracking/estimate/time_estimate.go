@@ -0,0 +1,71 @@
+package estimate
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+func NewCmdEstimate(f *cmdutils.Factory, kind timetracking.Kind) *cobra.Command {
+	use := "estimate <id> <duration> [flags]"
+	if kind == timetracking.KindMR {
+		use = "estimate [<id> | <branch>] <duration> [flags]"
+	}
+
+	timeEstimateCmd := &cobra.Command{
+		Use:   use,
+		Short: fmt.Sprintf(`Set the time estimate of %s.`, kind.WithArticle()),
+		Long: heredoc.Docf(`
+			Set the time estimate of %s, replacing the previous estimate.
+
+			The duration uses the GitLab time tracking syntax, like 1w 2d 3h 30m. A day is
+			8 hours, a week is 5 days, and a month is 4 weeks.
+		`, kind.WithArticle()),
+		Example: heredoc.Docf(`
+			glab %[1]s time estimate 12 3d
+			glab %[1]s time estimate 12 1d 4h
+		`, kind),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg, duration, err := timetracking.SplitArgs(kind, args)
+			if err != nil {
+				return err
+			}
+			seconds, err := utils.ParseTimeTracking(duration)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+			if seconds < 0 {
+				return &cmdutils.FlagError{Err: errors.New("the time estimate can't be negative.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issuable, err := timetracking.FromArg(f, kind, arg)
+			if err != nil {
+				return err
+			}
+
+			stats, err := issuable.SetEstimate(apiClient, duration)
+			if err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to set the time estimate of %s %s.", kind.Name(), issuable.Reference()))
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "%s Set the time estimate of %s %s to %s.\n", c.GreenCheck(), kind.Name(), issuable.Reference(), utils.FmtTimeTracking(seconds))
+			fmt.Fprintln(f.IO.StdOut, c.Gray(timetracking.FormatStats(stats)))
+			return nil
+		},
+	}
+
+	return timeEstimateCmd
+}
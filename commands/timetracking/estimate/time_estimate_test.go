@@ -0,0 +1,50 @@
+package estimate
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdEstimate(factory, timetracking.KindIssue)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueTimeEstimate(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/12/time_estimate",
+		httpmock.NewStringResponse(http.StatusOK, `{"time_estimate": 43200, "total_time_spent": 3600}`))
+
+	output, err := runCommand(t, fakeHTTP, "12 1d 4h")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Set the time estimate of issue #12 to 1d 4h.\n1h spent of 1d 4h estimated\n", output.String())
+}
+
+func TestIssueTimeEstimateNegative(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+
+	_, err := runCommand(t, fakeHTTP, "12 -- -1h")
+	require.EqualError(t, err, "the time estimate can't be negative.")
+}
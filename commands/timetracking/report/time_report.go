@@ -0,0 +1,221 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type ReportOptions struct {
+	From         string
+	To           string
+	By           string
+	User         string
+	OutputFormat string
+}
+
+// Row is the time spent by a user, or on the issues or merge requests of a label or milestone.
+type Row struct {
+	Name string `json:"name"`
+	// TimeSpent is in seconds.
+	TimeSpent int `json:"time_spent"`
+	Timelogs  int `json:"timelogs"`
+}
+
+// Report is the time spent in a date range, printed by 'time report'.
+type Report struct {
+	By   string `json:"by"`
+	From string `json:"from"`
+	To   string `json:"to"`
+	Rows []Row  `json:"rows"`
+	// Total is in seconds. With --by label, time spent on issues with several labels
+	// is counted once for each label, but once in the total.
+	Total int `json:"total"`
+}
+
+func NewCmdReport(f *cmdutils.Factory, kind timetracking.Kind) *cobra.Command {
+	opts := &ReportOptions{}
+
+	timeReportCmd := &cobra.Command{
+		Use:   "report [flags]",
+		Short: fmt.Sprintf(`Report the time spent on the %ss of a project, by user, label, or milestone.`, kind.Name()),
+		Long: heredoc.Docf(`
+			Report the time spent on the %[1]ss of a project in a date range, by user, label,
+			or milestone. Both days of the range are included, and it defaults to the current
+			month.
+
+			Time spent on %[2]s with several labels is counted for each of its labels.
+			Use --output csv to import the report in a spreadsheet: times are in hours.
+		`, kind.Name(), kind.WithArticle()),
+		Example: heredoc.Docf(`
+			glab %[1]s time report
+			glab %[1]s time report --from 2024-06-01 --to 2024-06-30 --by label
+			glab %[1]s time report --by milestone --user alice --output csv > june.csv
+		`, kind),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch opts.By {
+			case "user", "label", "milestone":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("--by must be one of: user, label, milestone.")}
+			}
+			switch opts.OutputFormat {
+			case "text", "csv", "json":
+			default:
+				return &cmdutils.FlagError{Err: fmt.Errorf("--output must be one of: text, csv, json.")}
+			}
+
+			now := time.Now()
+			if opts.From == "" {
+				opts.From = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local).Format(time.DateOnly)
+			}
+			if opts.To == "" {
+				opts.To = now.Format(time.DateOnly)
+			}
+			for name, value := range map[string]string{"from": opts.From, "to": opts.To} {
+				if _, err := time.Parse(time.DateOnly, value); err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --%s: %q. Use the YYYY-MM-DD format.", name, value)}
+				}
+			}
+			if opts.From > opts.To {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--from must be before --to.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			timelogs, err := api.ListProjectTimelogs(apiClient, repo.FullName(), &api.ListTimelogsOptions{
+				StartDate: opts.From,
+				EndDate:   opts.To,
+				Username:  opts.User,
+			})
+			if err != nil {
+				return cmdutils.WrapError(err, "failed to list the time spent.")
+			}
+
+			report := newReport(timelogs, kind, opts)
+
+			switch opts.OutputFormat {
+			case "json":
+				reportJSON, _ := json.Marshal(report)
+				fmt.Fprintln(f.IO.StdOut, string(reportJSON))
+				return nil
+			case "csv":
+				return writeCSV(f.IO.StdOut, report)
+			}
+
+			c := f.IO.Color()
+			fmt.Fprintf(f.IO.StdOut, "Time spent on %ss of %s by %s, from %s to %s\n\n",
+				kind.Name(), repo.FullName(), opts.By, opts.From, opts.To)
+			if len(report.Rows) == 0 {
+				fmt.Fprintln(f.IO.StdOut, c.Gray("No time spent."))
+				return nil
+			}
+
+			table := tableprinter.NewTablePrinter()
+			for _, row := range report.Rows {
+				table.AddRow(row.Name, utils.FmtTimeTracking(row.TimeSpent), c.Gray(formatHours(row.TimeSpent)+"h"))
+			}
+			table.AddRow(c.Bold("Total"), c.Bold(utils.FmtTimeTracking(report.Total)), c.Gray(formatHours(report.Total)+"h"))
+			fmt.Fprint(f.IO.StdOut, table.Render())
+			return nil
+		},
+	}
+
+	timeReportCmd.Flags().StringVar(&opts.From, "from", "", "First day of the report, in the YYYY-MM-DD format. Defaults to the first day of the month.")
+	timeReportCmd.Flags().StringVar(&opts.To, "to", "", "Last day of the report, in the YYYY-MM-DD format. Defaults to today.")
+	timeReportCmd.Flags().StringVar(&opts.By, "by", "user", "Group time spent by: user, label, milestone.")
+	timeReportCmd.Flags().StringVarP(&opts.User, "user", "u", "", "Only report time spent by this user.")
+	timeReportCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, csv, json.")
+
+	return timeReportCmd
+}
+
+func newReport(timelogs []*api.Timelog, kind timetracking.Kind, opts *ReportOptions) *Report {
+	report := &Report{By: opts.By, From: opts.From, To: opts.To, Rows: []Row{}}
+	rows := map[string]*Row{}
+	add := func(name string, timelog *api.Timelog) {
+		row, ok := rows[name]
+		if !ok {
+			row = &Row{Name: name}
+			rows[name] = row
+		}
+		row.TimeSpent += timelog.TimeSpent
+		row.Timelogs++
+	}
+
+	for _, timelog := range timelogs {
+		issuable := timelog.Issue
+		if kind == timetracking.KindMR {
+			issuable = timelog.MergeRequest
+		}
+		if issuable == nil {
+			continue
+		}
+		report.Total += timelog.TimeSpent
+
+		switch opts.By {
+		case "user":
+			add(timelog.User.Username, timelog)
+		case "label":
+			if len(issuable.Labels.Nodes) == 0 {
+				add("(no label)", timelog)
+			}
+			for _, label := range issuable.Labels.Nodes {
+				add(label.Title, timelog)
+			}
+		case "milestone":
+			if issuable.Milestone == nil {
+				add("(no milestone)", timelog)
+			} else {
+				add(issuable.Milestone.Title, timelog)
+			}
+		}
+	}
+
+	for _, row := range rows {
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		if report.Rows[i].TimeSpent != report.Rows[j].TimeSpent {
+			return report.Rows[i].TimeSpent > report.Rows[j].TimeSpent
+		}
+		return report.Rows[i].Name < report.Rows[j].Name
+	})
+	return report
+}
+
+func writeCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{report.By, "hours", "seconds", "timelogs"})
+	for _, row := range report.Rows {
+		_ = cw.Write([]string{row.Name, formatHours(row.TimeSpent), strconv.Itoa(row.TimeSpent), strconv.Itoa(row.Timelogs)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatHours formats seconds as hours with 2 decimals, for billing.
+func formatHours(seconds int) string {
+	return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64)
+}
@@ -0,0 +1,152 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const timelogsResponse = `{"data": {"project": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2024-06-03T10:00:00Z", "timeSpent": 7200, "user": {"username": "alice"},
+			"issue": {"iid": "1", "labels": {"nodes": [{"title": "bug"}, {"title": "backend"}]}, "milestone": {"title": "1.0"}}},
+		{"spentAt": "2024-06-04T10:00:00Z", "timeSpent": 1800, "user": {"username": "bob"},
+			"issue": {"iid": "2", "labels": {"nodes": []}, "milestone": null}},
+		{"spentAt": "2024-06-05T10:00:00Z", "timeSpent": 3600, "user": {"username": "bob"},
+			"issue": {"iid": "1", "labels": {"nodes": [{"title": "bug"}, {"title": "backend"}]}, "milestone": {"title": "1.0"}}},
+		{"spentAt": "2024-06-05T11:00:00Z", "timeSpent": 5400, "user": {"username": "alice"},
+			"mergeRequest": {"iid": "3", "labels": {"nodes": []}, "milestone": {"title": "1.0"}}}
+	],
+	"pageInfo": {"hasNextPage": false, "endCursor": "abc"}
+}}}}`
+
+func runCommand(t *testing.T, rt http.RoundTripper, kind timetracking.Kind, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdReport(factory, kind)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueTimeReportByUser(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.HostAndPath
+	fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"fullPath":  "OWNER/REPO",
+				"startDate": "2024-06-01",
+				"endDate":   "2024-06-30",
+			}, body.Variables)
+			return httpmock.NewStringResponse(http.StatusOK, timelogsResponse)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindIssue, "--from 2024-06-01 --to 2024-06-30")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Time spent on issues of OWNER/REPO by user, from 2024-06-01 to 2024-06-30\n\n"+
+		"alice\t2h\t2.00h\nbob\t1h 30m\t1.50h\nTotal\t3h 30m\t3.50h\n", output.String())
+}
+
+func TestIssueTimeReportByLabelCSV(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.HostAndPath
+	fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"fullPath":  "OWNER/REPO",
+				"startDate": "2024-06-01",
+				"endDate":   "2024-06-30",
+			}, body.Variables)
+			return httpmock.NewStringResponse(http.StatusOK, timelogsResponse)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindIssue, "--from 2024-06-01 --to 2024-06-30 --by label --output csv")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		label,hours,seconds,timelogs
+		backend,3.00,10800,2
+		bug,3.00,10800,2
+		(no label),0.50,1800,1
+	`), output.String())
+}
+
+func TestMRTimeReportByMilestoneJSON(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.HostAndPath
+	fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"fullPath":  "OWNER/REPO",
+				"startDate": "2024-06-01",
+				"endDate":   "2024-06-30",
+			}, body.Variables)
+			return httpmock.NewStringResponse(http.StatusOK, timelogsResponse)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindMR, "--from 2024-06-01 --to 2024-06-30 --by milestone --output json")
+	require.NoError(t, err)
+
+	var report Report
+	require.NoError(t, json.Unmarshal([]byte(output.String()), &report))
+	assert.Equal(t, Report{
+		By:    "milestone",
+		From:  "2024-06-01",
+		To:    "2024-06-30",
+		Rows:  []Row{{Name: "1.0", TimeSpent: 5400, Timelogs: 1}},
+		Total: 5400,
+	}, report)
+}
+
+func TestTimeReportErrors(t *testing.T) {
+	tests := []struct {
+		cli     string
+		wantErr string
+	}{
+		{cli: "--by project", wantErr: "--by must be one of: user, label, milestone."},
+		{cli: "--output xml", wantErr: "--output must be one of: text, csv, json."},
+		{cli: "--from 2024-13-01", wantErr: `invalid --from: "2024-13-01". Use the YYYY-MM-DD format.`},
+		{cli: "--from 2024-06-30 --to 2024-06-01", wantErr: "--from must be before --to."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cli, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runCommand(t, fakeHTTP, timetracking.KindIssue, tt.cli)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
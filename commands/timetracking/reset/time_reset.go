@@ -0,0 +1,85 @@
+package reset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+)
+
+type ResetOptions struct {
+	Estimate bool
+	Spent    bool
+}
+
+func NewCmdReset(f *cmdutils.Factory, kind timetracking.Kind) *cobra.Command {
+	opts := &ResetOptions{}
+
+	use := "reset <id> [flags]"
+	args := cobra.ExactArgs(1)
+	if kind == timetracking.KindMR {
+		use = "reset [<id> | <branch>] [flags]"
+		args = cobra.MaximumNArgs(1)
+	}
+
+	timeResetCmd := &cobra.Command{
+		Use:   use,
+		Short: fmt.Sprintf(`Reset the time estimate and the time spent of %s.`, kind.WithArticle()),
+		Long: heredoc.Docf(`
+			Reset the time estimate and the time spent of %s. Use --estimate or --spent
+			to reset only one of them.
+		`, kind.WithArticle()),
+		Example: heredoc.Docf(`
+			glab %[1]s time reset 12
+			glab %[1]s time reset 12 --spent
+		`, kind),
+		Args: args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.Estimate && !opts.Spent {
+				opts.Estimate, opts.Spent = true, true
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			arg := ""
+			if len(args) > 0 {
+				arg = args[0]
+			}
+			issuable, err := timetracking.FromArg(f, kind, arg)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			var stats *gitlab.TimeStats
+			if opts.Estimate {
+				stats, err = issuable.ResetEstimate(apiClient)
+				if err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to reset the time estimate of %s %s.", kind.Name(), issuable.Reference()))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Reset the time estimate of %s %s.\n", c.GreenCheck(), kind.Name(), issuable.Reference())
+			}
+			if opts.Spent {
+				stats, err = issuable.ResetSpent(apiClient)
+				if err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to reset the time spent on %s %s.", kind.Name(), issuable.Reference()))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Reset the time spent on %s %s.\n", c.GreenCheck(), kind.Name(), issuable.Reference())
+			}
+			fmt.Fprintln(f.IO.StdOut, c.Gray(timetracking.FormatStats(stats)))
+			return nil
+		},
+	}
+
+	timeResetCmd.Flags().BoolVar(&opts.Estimate, "estimate", false, "Reset only the time estimate.")
+	timeResetCmd.Flags().BoolVar(&opts.Spent, "spent", false, "Reset only the time spent.")
+
+	return timeResetCmd
+}
@@ -0,0 +1,113 @@
+package spend
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type SpendOptions struct {
+	Date    string
+	Summary string
+}
+
+func NewCmdSpend(f *cmdutils.Factory, kind timetracking.Kind) *cobra.Command {
+	opts := &SpendOptions{}
+
+	use := "spend <id> <duration> [flags]"
+	if kind == timetracking.KindMR {
+		use = "spend [<id> | <branch>] <duration> [flags]"
+	}
+
+	timeSpendCmd := &cobra.Command{
+		Use:   use,
+		Short: fmt.Sprintf(`Add time spent on %s.`, kind.WithArticle()),
+		Long: heredoc.Docf(`
+			Add time spent on %s. Prefix the duration with '-' to subtract time spent.
+
+			The duration uses the GitLab time tracking syntax, like 1w 2d 3h 30m. A day is
+			8 hours, a week is 5 days, and a month is 4 weeks.
+
+			Time is spent today, unless you set the day with --date.
+		`, kind.WithArticle()),
+		Example: heredoc.Docf(`
+			glab %[1]s time spend 12 1h 30m
+			glab %[1]s time spend 12 2h --summary "Code review"
+			glab %[1]s time spend 12 1d --date 2024-06-03
+			glab %[1]s time spend 12 -- -30m
+		`, kind),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			arg, duration, err := timetracking.SplitArgs(kind, args)
+			if err != nil {
+				return err
+			}
+			seconds, err := utils.ParseTimeTracking(duration)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			var spentAt time.Time
+			if opts.Date != "" {
+				spentAt, err = time.Parse(time.DateOnly, opts.Date)
+				if err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid --date: %q. Use the YYYY-MM-DD format.", opts.Date)}
+				}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			issuable, err := timetracking.FromArg(f, kind, arg)
+			if err != nil {
+				return err
+			}
+
+			c := f.IO.Color()
+			action, preposition := "Added", "to"
+			if seconds < 0 {
+				action, preposition = "Subtracted", "from"
+			}
+			message := fmt.Sprintf("%s %s %s %s %s", action, utils.FmtTimeTracking(abs(seconds)), preposition, kind.Name(), issuable.Reference())
+
+			// the REST API only adds time spent today, so a day is set through GraphQL.
+			if opts.Date != "" {
+				err := api.CreateTimelog(apiClient, issuable.GlobalID(), duration, spentAt, opts.Summary)
+				if err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to add time spent on %s %s.", kind.Name(), issuable.Reference()))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s %s on %s.\n", c.GreenCheck(), message, opts.Date)
+				return nil
+			}
+
+			stats, err := issuable.AddSpent(apiClient, duration, opts.Summary)
+			if err != nil {
+				return cmdutils.WrapError(err, fmt.Sprintf("failed to add time spent on %s %s.", kind.Name(), issuable.Reference()))
+			}
+			fmt.Fprintf(f.IO.StdOut, "%s %s.\n", c.GreenCheck(), message)
+			fmt.Fprintln(f.IO.StdOut, c.Gray(timetracking.FormatStats(stats)))
+			return nil
+		},
+	}
+
+	timeSpendCmd.Flags().StringVar(&opts.Date, "date", "", "Day the time was spent, in the YYYY-MM-DD format. Defaults to today.")
+	timeSpendCmd.Flags().StringVarP(&opts.Summary, "summary", "s", "", "Summary of the work done.")
+
+	return timeSpendCmd
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,112 @@
+package spend
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, kind timetracking.Kind, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdSpend(factory, kind)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestIssueTimeSpend(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/12/add_spent_time",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			assert.JSONEq(t, `{"duration": "1h 30m", "summary": "Review"}`, string(body))
+			return httpmock.NewStringResponse(http.StatusCreated, `{"time_estimate": 28800, "total_time_spent": 9000}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindIssue, `12 1h 30m --summary Review`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Added 1h 30m to issue #12.\n2h 30m spent of 1d estimated\n", output.String())
+}
+
+func TestIssueTimeSpendSubtract(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO/issues/12",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 112, "iid": 12, "title": "Issue", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/projects/OWNER/REPO/issues/12/add_spent_time",
+		httpmock.NewStringResponse(http.StatusCreated, `{"time_estimate": 0, "total_time_spent": 1800}`))
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindIssue, `12 -- -30m`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Subtracted 30m from issue #12.\n30m spent, no estimate\n", output.String())
+}
+
+func TestMRTimeSpendOnDate(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.MatchURL = httpmock.HostAndPath
+	fakeHTTP.RegisterResponder(http.MethodGet, "https://gitlab.com/api/v4/projects/OWNER/REPO/merge_requests/7",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 107, "iid": 7, "title": "MR", "state": "opened"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "https://gitlab.com/api/graphql",
+		func(req *http.Request) (*http.Response, error) {
+			var body struct {
+				Variables map[string]interface{} `json:"variables"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			assert.Equal(t, map[string]interface{}{
+				"issuableId": "gid://gitlab/MergeRequest/107",
+				"timeSpent":  "2h",
+				"spentAt":    "2024-06-03T00:00:00Z",
+				"summary":    "",
+			}, body.Variables)
+			return httpmock.NewStringResponse(http.StatusOK, `{"data": {"timelogCreate": {"errors": []}}}`)(req)
+		})
+
+	output, err := runCommand(t, fakeHTTP, timetracking.KindMR, `7 2h --date 2024-06-03`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Added 2h to merge request !7 on 2024-06-03.\n", output.String())
+}
+
+func TestTimeSpendErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    timetracking.Kind
+		cli     string
+		wantErr string
+	}{
+		{name: "invalid duration", kind: timetracking.KindIssue, cli: "12 1x", wantErr: `invalid duration: "1x". Use the GitLab time tracking syntax, like 1h 30m.`},
+		{name: "missing duration", kind: timetracking.KindIssue, cli: "12", wantErr: "specify an issue and a duration."},
+		{name: "invalid date", kind: timetracking.KindIssue, cli: "12 1h --date 06/03", wantErr: `invalid --date: "06/03". Use the YYYY-MM-DD format.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runCommand(t, fakeHTTP, tt.kind, tt.cli)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
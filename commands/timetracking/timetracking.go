@@ -0,0 +1,162 @@
+// Package timetracking holds the time tracking commands shared by 'glab issue time' and 'glab mr time'.
+package timetracking
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+type Kind string
+
+const (
+	KindIssue Kind = "issue"
+	KindMR    Kind = "mr"
+)
+
+// Name returns the name of the kind in messages.
+func (k Kind) Name() string {
+	if k == KindMR {
+		return "merge request"
+	}
+	return "issue"
+}
+
+// WithArticle returns the name of the kind with its indefinite article, like "an issue".
+func (k Kind) WithArticle() string {
+	if k == KindMR {
+		return "a merge request"
+	}
+	return "an issue"
+}
+
+// Issuable is an issue or a merge request whose time is tracked.
+type Issuable struct {
+	Kind      Kind
+	Repo      glrepo.Interface
+	ID        int
+	IID       int
+	Title     string
+	WebURL    string
+	TimeStats *gitlab.TimeStats
+}
+
+// FromArg returns the issue or merge request of an argument. Merge requests can also be
+// given by branch, and the merge request of the current branch is used when arg is empty.
+func FromArg(f *cmdutils.Factory, kind Kind, arg string) (*Issuable, error) {
+	if kind == KindMR {
+		var args []string
+		if arg != "" {
+			args = []string{arg}
+		}
+		mr, repo, err := mrutils.MRFromArgs(f, args, "any")
+		if err != nil {
+			return nil, err
+		}
+		return &Issuable{Kind: kind, Repo: repo, ID: mr.ID, IID: mr.IID, Title: mr.Title, WebURL: mr.WebURL, TimeStats: mr.TimeStats}, nil
+	}
+
+	apiClient, err := f.HttpClient()
+	if err != nil {
+		return nil, err
+	}
+	issue, repo, err := issueutils.IssueFromArg(apiClient, f.BaseRepo, arg)
+	if err != nil {
+		return nil, err
+	}
+	return &Issuable{Kind: kind, Repo: repo, ID: issue.ID, IID: issue.IID, Title: issue.Title, WebURL: issue.WebURL, TimeStats: issue.TimeStats}, nil
+}
+
+// SplitArgs splits the arguments of 'estimate' and 'spend' into the issue or merge request,
+// and the duration, which can be spread over several arguments, like 1h 30m. For merge
+// requests, arguments that all form a duration are the duration of the merge request of
+// the current branch. A plain number is an ID, not a number of hours.
+func SplitArgs(kind Kind, args []string) (string, string, error) {
+	if kind == KindMR && isDuration(args[0]) {
+		return "", strings.Join(args, " "), nil
+	}
+	if len(args) == 1 {
+		if kind == KindMR {
+			return "", args[0], nil
+		}
+		return "", "", &cmdutils.FlagError{Err: fmt.Errorf("specify an issue and a duration.")}
+	}
+	return args[0], strings.Join(args[1:], " "), nil
+}
+
+func isDuration(arg string) bool {
+	if _, err := strconv.Atoi(arg); err == nil {
+		return false
+	}
+	_, err := utils.ParseTimeTracking(arg)
+	return err == nil
+}
+
+// Reference returns the reference of the issue or merge request in its project, like #12 or !12.
+func (i *Issuable) Reference() string {
+	if i.Kind == KindMR {
+		return fmt.Sprintf("!%d", i.IID)
+	}
+	return fmt.Sprintf("#%d", i.IID)
+}
+
+// GlobalID returns the GraphQL global ID of the issue or merge request.
+func (i *Issuable) GlobalID() string {
+	if i.Kind == KindMR {
+		return fmt.Sprintf("gid://gitlab/MergeRequest/%d", i.ID)
+	}
+	return fmt.Sprintf("gid://gitlab/Issue/%d", i.ID)
+}
+
+func (i *Issuable) SetEstimate(client *gitlab.Client, duration string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr(duration)}
+	if i.Kind == KindMR {
+		return api.SetMRTimeEstimate(client, i.Repo.FullName(), i.IID, opts)
+	}
+	return api.SetIssueTimeEstimate(client, i.Repo.FullName(), i.IID, opts)
+}
+
+func (i *Issuable) AddSpent(client *gitlab.Client, duration, summary string) (*gitlab.TimeStats, error) {
+	opts := &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr(duration)}
+	if summary != "" {
+		opts.Summary = gitlab.Ptr(summary)
+	}
+	if i.Kind == KindMR {
+		return api.AddMRTimeSpent(client, i.Repo.FullName(), i.IID, opts)
+	}
+	return api.AddIssueTimeSpent(client, i.Repo.FullName(), i.IID, opts)
+}
+
+func (i *Issuable) ResetEstimate(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	if i.Kind == KindMR {
+		return api.ResetMRTimeEstimate(client, i.Repo.FullName(), i.IID)
+	}
+	return api.ResetIssueTimeEstimate(client, i.Repo.FullName(), i.IID)
+}
+
+func (i *Issuable) ResetSpent(client *gitlab.Client) (*gitlab.TimeStats, error) {
+	if i.Kind == KindMR {
+		return api.ResetMRTimeSpent(client, i.Repo.FullName(), i.IID)
+	}
+	return api.ResetIssueTimeSpent(client, i.Repo.FullName(), i.IID)
+}
+
+// FormatStats describes the time spent on an issue or merge request, and its estimate.
+func FormatStats(stats *gitlab.TimeStats) string {
+	if stats == nil {
+		return ""
+	}
+	if stats.TimeEstimate == 0 {
+		return fmt.Sprintf("%s spent, no estimate", utils.FmtTimeTracking(stats.TotalTimeSpent))
+	}
+	return fmt.Sprintf("%s spent of %s estimated", utils.FmtTimeTracking(stats.TotalTimeSpent), utils.FmtTimeTracking(stats.TimeEstimate))
+}
@@ -0,0 +1,34 @@
+package timetracking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		kind         Kind
+		args         []string
+		wantArg      string
+		wantDuration string
+	}{
+		{KindIssue, []string{"12", "1h", "30m"}, "12", "1h 30m"},
+		{KindMR, []string{"12", "1h", "30m"}, "12", "1h 30m"},
+		{KindMR, []string{"feature", "2h"}, "feature", "2h"},
+		{KindMR, []string{"1h", "30m"}, "", "1h 30m"},
+		{KindMR, []string{"-30m"}, "", "-30m"},
+		{KindMR, []string{"1.5", "30m"}, "", "1.5 30m"},
+	}
+
+	for _, tt := range tests {
+		arg, duration, err := SplitArgs(tt.kind, tt.args)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantArg, arg, tt.args)
+		assert.Equal(t, tt.wantDuration, duration, tt.args)
+	}
+
+	_, _, err := SplitArgs(KindIssue, []string{"1h"})
+	assert.EqualError(t, err, "specify an issue and a duration.")
+}
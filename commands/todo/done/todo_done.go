@@ -0,0 +1,68 @@
+package done
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+)
+
+func NewCmdDone(f *cmdutils.Factory) *cobra.Command {
+	var all bool
+
+	todoDoneCmd := &cobra.Command{
+		Use:   "done [<id>...] [flags]",
+		Short: `Mark to-do items as done.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab todo done 123
+			glab todo done 123 124
+			glab todo done --all
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (len(args) != 0) {
+				return &cmdutils.FlagError{Err: errors.New("specify the IDs of to-do items, or use --all.")}
+			}
+
+			ids := make([]int, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.Atoi(arg)
+				if err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid to-do item ID: %q.", arg)}
+				}
+				ids = append(ids, id)
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+			c := f.IO.Color()
+
+			if all {
+				if err := api.MarkAllTodosAsDone(apiClient); err != nil {
+					return cmdutils.WrapError(err, "failed to mark all to-do items as done.")
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Marked all to-do items as done.\n", c.GreenCheck())
+				return nil
+			}
+
+			for _, id := range ids {
+				if err := api.MarkTodoAsDone(apiClient, id); err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to mark to-do item %d as done.", id))
+				}
+				fmt.Fprintf(f.IO.StdOut, "%s Marked to-do item %d as done.\n", c.GreenCheck(), id)
+			}
+			return nil
+		},
+	}
+
+	todoDoneCmd.Flags().BoolVarP(&all, "all", "a", false, "Mark all pending to-do items as done.")
+
+	return todoDoneCmd
+}
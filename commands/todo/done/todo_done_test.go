@@ -0,0 +1,74 @@
+package done
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdDone(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTodoDone(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/todos/101/mark_as_done",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 101, "state": "done"}`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/todos/102/mark_as_done",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "state": "done"}`))
+
+	output, err := runCommand(t, fakeHTTP, "101 102")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Marked to-do item 101 as done.\n✓ Marked to-do item 102 as done.\n", output.String())
+}
+
+func TestTodoDoneAll(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/todos/mark_as_done",
+		httpmock.NewStringResponse(http.StatusNoContent, ``))
+
+	output, err := runCommand(t, fakeHTTP, "--all")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Marked all to-do items as done.\n", output.String())
+}
+
+func TestTodoDoneInvalidArgs(t *testing.T) {
+	tests := []struct {
+		cli     string
+		wantErr string
+	}{
+		{"", "specify the IDs of to-do items, or use --all."},
+		{"101 --all", "specify the IDs of to-do items, or use --all."},
+		{"first", `invalid to-do item ID: "first".`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cli, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runCommand(t, fakeHTTP, tt.cli)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/gosuri/uilive"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/iostreams"
+	"gitlab.com/gitlab-org/cli/pkg/tableprinter"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+// targetTypes maps the values of --type to the target types of the API.
+var targetTypes = map[string]gitlab.TodoTargetType{
+	"issue":  gitlab.TodoTargetIssue,
+	"mr":     gitlab.TodoTargetMergeRequest,
+	"epic":   "Epic",
+	"commit": "Commit",
+	"design": gitlab.TodoTargetDesignManagement,
+	"alert":  gitlab.TodoTargetAlertManagement,
+}
+
+var actions = []string{
+	"assigned", "mentioned", "build_failed", "marked", "approval_required", "unmergeable",
+	"directly_addressed", "merge_train_removed", "review_requested", "member_access_requested",
+}
+
+type ListOptions struct {
+	Type         string
+	Action       string
+	Project      string
+	Author       string
+	Page         int
+	PerPage      int
+	OutputFormat string
+	Watch        bool
+	Interval     time.Duration
+}
+
+func NewCmdList(f *cmdutils.Factory) *cobra.Command {
+	opts := &ListOptions{}
+
+	todoListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List your pending to-do items.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			glab todo list
+			glab todo list --type mr --action review_requested
+			glab todo list --project gitlab-org/cli --author @me
+			glab todo list --watch --interval 1m
+			glab todo list --output json
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			l := &gitlab.ListTodosOptions{
+				State: gitlab.Ptr("pending"),
+				ListOptions: gitlab.ListOptions{
+					Page:    opts.Page,
+					PerPage: opts.PerPage,
+				},
+			}
+
+			if opts.Type != "" {
+				targetType, ok := targetTypes[opts.Type]
+				if !ok {
+					return &cmdutils.FlagError{Err: fmt.Errorf("--type must be one of: issue, mr, epic, commit, design, alert.")}
+				}
+				l.Type = gitlab.Ptr(string(targetType))
+			}
+			if opts.Action != "" {
+				if !utils.PresentInStringSlice(actions, opts.Action) {
+					return &cmdutils.FlagError{Err: fmt.Errorf("--action must be one of: %s.", strings.Join(actions, ", "))}
+				}
+				l.Action = gitlab.Ptr(gitlab.TodoAction(opts.Action))
+			}
+			if opts.Watch && opts.OutputFormat == "json" {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--watch can't be used with --output json.")}
+			}
+			if opts.Interval < time.Second {
+				return &cmdutils.FlagError{Err: fmt.Errorf("--interval must be at least 1s.")}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			if opts.Project != "" {
+				project, err := api.GetProject(apiClient, opts.Project)
+				if err != nil {
+					return err
+				}
+				l.ProjectID = gitlab.Ptr(project.ID)
+			}
+			if opts.Author != "" {
+				u, err := api.UserByName(apiClient, opts.Author)
+				if err != nil {
+					return err
+				}
+				l.AuthorID = gitlab.Ptr(u.ID)
+			}
+
+			if !opts.Watch {
+				todos, err := api.ListTodos(apiClient, l)
+				if err != nil {
+					return err
+				}
+
+				if opts.OutputFormat == "json" {
+					todosJSON, _ := json.Marshal(todos)
+					fmt.Fprintln(f.IO.StdOut, string(todosJSON))
+					return nil
+				}
+
+				fmt.Fprint(f.IO.StdOut, displayTodos(f.IO, todos, opts.Page))
+				return nil
+			}
+
+			// refresh until interrupted. Each list replaces the previous one on the terminal.
+			writer := uilive.New()
+			writer.Out = f.IO.StdOut
+			for {
+				todos, err := api.ListTodos(apiClient, l)
+				if err != nil {
+					return err
+				}
+				status := fmt.Sprintf("Updated at %s. Refreshing every %s. Press Ctrl+C to quit.", time.Now().Format(time.TimeOnly), opts.Interval)
+				fmt.Fprintf(writer, "%s%s\n", displayTodos(f.IO, todos, opts.Page), f.IO.Color().Gray(status))
+				if err := writer.Flush(); err != nil {
+					return err
+				}
+				time.Sleep(opts.Interval)
+			}
+		},
+	}
+
+	todoListCmd.Flags().StringVarP(&opts.Type, "type", "t", "", "Filter by the type of the target: issue, mr, epic, commit, design, alert.")
+	todoListCmd.Flags().StringVarP(&opts.Action, "action", "a", "", "Filter by the action that created the to-do item, like assigned, mentioned, or review_requested.")
+	todoListCmd.Flags().StringVar(&opts.Project, "project", "", "Filter by project <path>.")
+	todoListCmd.Flags().StringVar(&opts.Author, "author", "", "Filter by the <username> of the user who caused the to-do item.")
+	todoListCmd.Flags().IntVarP(&opts.Page, "page", "p", 1, "Page number.")
+	todoListCmd.Flags().IntVarP(&opts.PerPage, "per-page", "P", 30, "Number of items to list per page.")
+	todoListCmd.Flags().StringVarP(&opts.OutputFormat, "output", "F", "text", "Format output as: text, json.")
+	todoListCmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Refresh the list until interrupted.")
+	todoListCmd.Flags().DurationVar(&opts.Interval, "interval", 30*time.Second, "Time between refreshes with --watch.")
+
+	return todoListCmd
+}
+
+func displayTodos(io *iostreams.IOStreams, todos []*gitlab.Todo, page int) string {
+	if len(todos) == 0 {
+		return "No pending to-do items.\n"
+	}
+
+	c := io.Color()
+	table := tableprinter.NewTablePrinter()
+	for _, todo := range todos {
+		author := ""
+		if todo.Author != nil {
+			author = "@" + todo.Author.Username
+		}
+		ago := ""
+		if todo.CreatedAt != nil {
+			ago = c.Gray(utils.TimeToPrettyTimeAgo(*todo.CreatedAt))
+		}
+		table.AddRow(c.Gray(fmt.Sprint(todo.ID)), reference(todo), targetTitle(todo), utils.Humanize(string(todo.ActionName)), author, ago)
+	}
+	return fmt.Sprintf("Showing %s. (Page %d)\n\n%s", utils.Pluralize(len(todos), "pending to-do item"), page, table.Render())
+}
+
+// reference returns the reference of the target of a to-do item, like group/project!12.
+func reference(todo *gitlab.Todo) string {
+	project := ""
+	if todo.Project != nil {
+		project = todo.Project.PathWithNamespace
+	}
+	if todo.Target == nil {
+		return project
+	}
+
+	switch todo.TargetType {
+	case gitlab.TodoTargetMergeRequest:
+		return fmt.Sprintf("%s!%d", project, todo.Target.IID)
+	case gitlab.TodoTargetIssue:
+		return fmt.Sprintf("%s#%d", project, todo.Target.IID)
+	case "Epic":
+		return fmt.Sprintf("&%d", todo.Target.IID)
+	case "Commit":
+		return fmt.Sprintf("%s@%v", project, todo.Target.ID)
+	}
+	return project
+}
+
+// targetTitle returns the title of the target of a to-do item, or the body of the to-do item.
+func targetTitle(todo *gitlab.Todo) string {
+	if todo.Target != nil && todo.Target.Title != "" {
+		return todo.Target.Title
+	}
+	return todo.Body
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+const todosResponse = `[
+	{"id": 102, "project": {"path_with_namespace": "OWNER/REPO"}, "author": {"username": "alice"},
+		"action_name": "review_requested", "target_type": "MergeRequest",
+		"target": {"iid": 12, "title": "Add todo command"},
+		"target_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/12", "state": "pending"},
+	{"id": 101, "project": {"path_with_namespace": "OWNER/REPO"}, "author": {"username": "bob"},
+		"action_name": "mentioned", "target_type": "Issue",
+		"target": {"iid": 3, "title": "Crash on start"},
+		"target_url": "https://gitlab.com/OWNER/REPO/-/issues/3", "state": "pending"}
+]`
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(false, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	cmd := NewCmdList(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTodoList(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/todos",
+		httpmock.NewStringResponse(http.StatusOK, todosResponse))
+
+	output, err := runCommand(t, fakeHTTP, "")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		Showing 2 pending to-do items. (Page 1)
+
+		102	OWNER/REPO!12	Add todo command	review requested	@alice	
+		101	OWNER/REPO#3	Crash on start	mentioned	@bob	
+	`), output.String())
+	assert.Equal(t, "page=1&per_page=30&state=pending", fakeHTTP.Requests[0].URL.RawQuery)
+}
+
+func TestTodoListFilters(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/projects/OWNER/REPO",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 7, "path_with_namespace": "OWNER/REPO"}`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/users",
+		httpmock.NewStringResponse(http.StatusOK, `[{"id": 5, "username": "alice"}]`))
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/todos",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	output, err := runCommand(t, fakeHTTP, "--type mr --action review_requested --project OWNER/REPO --author alice")
+	require.NoError(t, err)
+
+	assert.Equal(t, "No pending to-do items.\n", output.String())
+	assert.Equal(t, "action=review_requested&author_id=5&page=1&per_page=30&project_id=7&state=pending&type=MergeRequest",
+		fakeHTTP.Requests[2].URL.RawQuery)
+}
+
+func TestTodoListJSON(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/todos",
+		httpmock.NewStringResponse(http.StatusOK, todosResponse))
+
+	output, err := runCommand(t, fakeHTTP, "--output json")
+	require.NoError(t, err)
+
+	assert.Contains(t, output.String(), `"target_url":"https://gitlab.com/OWNER/REPO/-/merge_requests/12"`)
+}
+
+func TestTodoListInvalidFlags(t *testing.T) {
+	tests := []struct {
+		cli     string
+		wantErr string
+	}{
+		{"--type pipeline", "--type must be one of: issue, mr, epic, commit, design, alert."},
+		{"--action approved", "--action must be one of: assigned, mentioned, build_failed, marked, approval_required, unmergeable, directly_addressed, merge_train_removed, review_requested, member_access_requested."},
+		{"--watch --output json", "--watch can't be used with --output json."},
+		{"--watch --interval 10ms", "--interval must be at least 1s."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cli, func(t *testing.T) {
+			fakeHTTP := httpmock.New()
+			defer fakeHTTP.Verify(t)
+
+			_, err := runCommand(t, fakeHTTP, tt.cli)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package open
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/api"
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	"gitlab.com/gitlab-org/cli/pkg/utils"
+)
+
+func NewCmdOpen(f *cmdutils.Factory) *cobra.Command {
+	var done bool
+
+	todoOpenCmd := &cobra.Command{
+		Use:   "open <id> [flags]",
+		Short: `Open the target of a pending to-do item in a browser.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			glab todo open 123
+			glab todo open 123 --done
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid to-do item ID: %q.", args[0])}
+			}
+
+			apiClient, err := f.HttpClient()
+			if err != nil {
+				return err
+			}
+
+			// the API has no endpoint to get a single to-do item.
+			todos, err := api.ListAllTodos(apiClient, &gitlab.ListTodosOptions{State: gitlab.Ptr("pending")})
+			if err != nil {
+				return err
+			}
+			var todo *gitlab.Todo
+			for _, t := range todos {
+				if t.ID == id {
+					todo = t
+					break
+				}
+			}
+			if todo == nil {
+				return fmt.Errorf("no pending to-do item %d.", id)
+			}
+
+			if done {
+				if err := api.MarkTodoAsDone(apiClient, id); err != nil {
+					return cmdutils.WrapError(err, fmt.Sprintf("failed to mark to-do item %d as done.", id))
+				}
+			}
+
+			if f.IO.IsaTTY && f.IO.IsErrTTY {
+				fmt.Fprintf(f.IO.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(todo.TargetURL))
+			}
+
+			cfg, _ := f.Config()
+			browser, _ := cfg.Get("", "browser")
+			return utils.OpenInBrowser(todo.TargetURL, browser)
+		},
+	}
+
+	todoOpenCmd.Flags().BoolVarP(&done, "done", "d", false, "Mark the to-do item as done.")
+
+	return todoOpenCmd
+}
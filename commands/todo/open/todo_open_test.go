@@ -0,0 +1,61 @@
+package open
+
+import (
+	"net/http"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdtest"
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/pkg/httpmock"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func runCommand(t *testing.T, rt http.RoundTripper, cli string) (*test.CmdOut, error) {
+	t.Helper()
+
+	ios, _, stdout, stderr := cmdtest.InitIOStreams(true, "")
+	factory := cmdtest.InitFactory(ios, rt)
+
+	_, err := factory.HttpClient()
+	require.NoError(t, err)
+
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		return &test.OutputStub{}
+	})
+	t.Cleanup(restoreCmd)
+
+	cmd := NewCmdOpen(factory)
+
+	return cmdtest.ExecuteCommand(cmd, cli, stdout, stderr)
+}
+
+func TestTodoOpen(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/todos",
+		httpmock.NewStringResponse(http.StatusOK, `[
+			{"id": 101, "target_url": "https://gitlab.com/OWNER/REPO/-/issues/3"},
+			{"id": 102, "target_url": "https://gitlab.com/OWNER/REPO/-/merge_requests/12"}
+		]`))
+	fakeHTTP.RegisterResponder(http.MethodPost, "/api/v4/todos/102/mark_as_done",
+		httpmock.NewStringResponse(http.StatusOK, `{"id": 102, "state": "done"}`))
+
+	output, err := runCommand(t, fakeHTTP, "102 --done")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Opening gitlab.com/OWNER/REPO/-/merge_requests/12 in your browser.\n", output.Stderr())
+}
+
+func TestTodoOpenNotFound(t *testing.T) {
+	fakeHTTP := httpmock.New()
+	defer fakeHTTP.Verify(t)
+	fakeHTTP.RegisterResponder(http.MethodGet, "/api/v4/todos",
+		httpmock.NewStringResponse(http.StatusOK, `[]`))
+
+	_, err := runCommand(t, fakeHTTP, "103")
+	require.EqualError(t, err, "no pending to-do item 103.")
+}
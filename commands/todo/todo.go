@@ -0,0 +1,33 @@
+package todo
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/commands/cmdutils"
+	todoDoneCmd "gitlab.com/gitlab-org/cli/commands/todo/done"
+	todoListCmd "gitlab.com/gitlab-org/cli/commands/todo/list"
+	todoOpenCmd "gitlab.com/gitlab-org/cli/commands/todo/open"
+)
+
+func NewCmdTodo(f *cmdutils.Factory) *cobra.Command {
+	todoCmd := &cobra.Command{
+		Use:   "todo <command> [flags]",
+		Short: `Work with your GitLab to-do list.`,
+		Long: heredoc.Doc(`
+			Work with the to-do items of your to-do list, across all projects and groups.
+			To-do items are identified by the ID shown by 'glab todo list'.
+		`),
+		Example: heredoc.Doc(`
+			glab todo list --type mr
+			glab todo open 123 --done
+			glab todo done --all
+		`),
+	}
+
+	todoCmd.AddCommand(todoListCmd.NewCmdList(f))
+	todoCmd.AddCommand(todoOpenCmd.NewCmdOpen(f))
+	todoCmd.AddCommand(todoDoneCmd.NewCmdDone(f))
+
+	return todoCmd
+}
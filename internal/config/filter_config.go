@@ -0,0 +1,125 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Filter is a saved set of list flags, keyed by flag name.
+type Filter map[string]string
+
+// Filters holds the saved filters of a config file, keyed by the command they
+// belong to, like issue or mr, and then by filter name.
+type Filters struct {
+	Local bool
+
+	entries map[string]map[string]Filter
+}
+
+func globalFiltersFile() string {
+	return path.Join(ConfigDir(), "filters.yml")
+}
+
+// LocalFiltersFile returns the filters file of the current repository.
+var LocalFiltersFile = func() string {
+	filtersFile := append(LocalConfigDir(), "filters.yml")
+	return filepath.Join(filtersFile...)
+}
+
+func (f *Filters) filename() string {
+	if f.Local {
+		return LocalFiltersFile()
+	}
+	return globalFiltersFile()
+}
+
+// ReadFilters reads the global filters file, or the local one of the current repository.
+// A missing file has no filters.
+func ReadFilters(local bool) (*Filters, error) {
+	f := &Filters{Local: local, entries: map[string]map[string]Filter{}}
+
+	data, err := ReadConfigFile(f.filename())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return f, nil
+		}
+		return nil, err
+	}
+
+	err = yaml.Unmarshal(data, &f.entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", f.filename(), err)
+	}
+	if f.entries == nil {
+		f.entries = map[string]map[string]Filter{}
+	}
+	return f, nil
+}
+
+// Get returns the filter saved under name for a command.
+func (f *Filters) Get(command, name string) (Filter, bool) {
+	filter, ok := f.entries[command][name]
+	return filter, ok
+}
+
+// Set saves a filter under name for a command, replacing any filter with the same name.
+func (f *Filters) Set(command, name string, filter Filter) error {
+	if f.entries[command] == nil {
+		f.entries[command] = map[string]Filter{}
+	}
+	f.entries[command][name] = filter
+
+	return f.Write()
+}
+
+// Delete removes the filter saved under name for a command.
+func (f *Filters) Delete(command, name string) error {
+	delete(f.entries[command], name)
+	if len(f.entries[command]) == 0 {
+		delete(f.entries, command)
+	}
+
+	return f.Write()
+}
+
+// Commands returns the sorted names of the commands with saved filters.
+func (f *Filters) Commands() []string {
+	commands := make([]string, 0, len(f.entries))
+	for command := range f.entries {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// Names returns the sorted names of the filters saved for a command.
+func (f *Filters) Names(command string) []string {
+	names := make([]string, 0, len(f.entries[command]))
+	for name := range f.entries[command] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (f *Filters) Write() error {
+	if f.Local && !CheckPathExists(".git") {
+		return errors.New("not a Git repository")
+	}
+
+	filtersBytes, err := yaml.Marshal(f.entries)
+	if err != nil {
+		return err
+	}
+	err = WriteConfigFile(f.filename(), filtersBytes)
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
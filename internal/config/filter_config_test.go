@@ -0,0 +1,38 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Filters(t *testing.T) {
+	t.Setenv("GLAB_CONFIG_DIR", t.TempDir())
+
+	filters, err := ReadFilters(false)
+	require.NoError(t, err)
+	assert.Empty(t, filters.Commands())
+
+	require.NoError(t, filters.Set("issue", "triage", Filter{"label": "bug", "assignee": "@me"}))
+	require.NoError(t, filters.Set("mr", "review", Filter{"reviewer": "@me"}))
+	require.NoError(t, filters.Set("issue", "bugs", Filter{"label": "bug"}))
+
+	filters, err = ReadFilters(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"issue", "mr"}, filters.Commands())
+	assert.Equal(t, []string{"bugs", "triage"}, filters.Names("issue"))
+
+	filter, ok := filters.Get("issue", "triage")
+	assert.True(t, ok)
+	assert.Equal(t, Filter{"label": "bug", "assignee": "@me"}, filter)
+
+	_, ok = filters.Get("mr", "triage")
+	assert.False(t, ok)
+
+	require.NoError(t, filters.Delete("mr", "review"))
+
+	filters, err = ReadFilters(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"issue"}, filters.Commands())
+}
@@ -228,6 +228,26 @@ func CommitBody(sha string) (string, error) {
 	return string(output), nil
 }
 
+// CommitExists reports whether a commit is available in the local repository
+func CommitExists(sha string) bool {
+	catCmd := GitCommand("cat-file", "-e", sha+"^{commit}")
+	return run.PrepareCmd(catCmd).Run() == nil
+}
+
+// RangeDiff compares two commit ranges, like "base..head", with `git range-diff`
+func RangeDiff(oldRange, newRange string, color bool) (string, error) {
+	colorFlag := "--no-color"
+	if color {
+		colorFlag = "--color"
+	}
+	rangeDiffCmd := GitCommand("range-diff", colorFlag, oldRange, newRange)
+	output, err := run.PrepareCmd(rangeDiffCmd).Output()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
 // Push publishes a git ref to a remote
 func Push(remote string, ref string, cmdOut, cmdErr io.Writer) error {
 	pushCmd := GitCommand("push", remote, ref)
@@ -0,0 +1,342 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// stackGit runs the git commands that rewrite stacks.
+var stackGit GitRunner = StandardGitCommand{}
+
+func gitLine(args ...string) (string, error) {
+	output, err := stackGit.Git(args...)
+	return strings.TrimSpace(output), err
+}
+
+// Base returns the commit the first ref of the stack is based on:
+// where it diverges from the default branch of DefaultRemote.
+func (s *Stack) Base() (string, error) {
+	defaultBranch, err := GetDefaultBranch(DefaultRemote)
+	if err != nil {
+		return "", fmt.Errorf("could not determine the default branch of %s: %v", DefaultRemote, err)
+	}
+
+	base, err := gitLine("merge-base", DefaultRemote+"/"+defaultBranch, s.First().Branch, "--")
+	if err != nil {
+		return "", fmt.Errorf("could not find where the stack diverges from %s/%s: %v", DefaultRemote, defaultBranch, err)
+	}
+
+	return base, nil
+}
+
+// RefCommits returns the commits of a ref, oldest first: the commits of its branch
+// that are not in the branch of the previous ref, or in base for the first ref.
+func (s *Stack) RefCommits(ref StackRef, base string) ([]string, error) {
+	if !ref.IsFirst() {
+		base = s.Refs[ref.Prev].Branch
+	}
+
+	output, err := gitLine("rev-list", "--reverse", base+".."+ref.Branch, "--")
+	if err != nil {
+		return nil, err
+	}
+	if output == "" {
+		return nil, nil
+	}
+
+	return strings.Split(output, "\n"), nil
+}
+
+// EnsureCleanWorkingTree returns an error if tracked files have uncommitted changes,
+// which rewriting the branches of a stack would lose.
+func EnsureCleanWorkingTree() error {
+	status, err := gitLine("status", "--porcelain", "--untracked-files=no")
+	if err != nil {
+		return err
+	}
+	if status != "" {
+		return errors.New("you have uncommitted changes. Commit or stash them first.")
+	}
+
+	return nil
+}
+
+// Reorder rewrites the stack so its refs follow the order of shas, which must
+// contain the SHA of every ref exactly once. Refs are cherry-picked in their new
+// order, starting from the first ref that moves, and their branches are updated.
+// If a commit does not apply, every branch is restored.
+func (s *Stack) Reorder(shas []string) error {
+	current := slices.Collect(s.Iter())
+
+	if len(shas) != len(current) {
+		return fmt.Errorf("expected %d refs, got %d. Use 'glab stack fold' to remove a ref.", len(current), len(shas))
+	}
+	seen := make(map[string]bool)
+	for _, sha := range shas {
+		if _, ok := s.Refs[sha]; !ok {
+			return fmt.Errorf("unknown ref %q.", sha)
+		}
+		if seen[sha] {
+			return fmt.Errorf("ref %q is listed more than once.", sha)
+		}
+		seen[sha] = true
+	}
+
+	unchanged := 0
+	for unchanged < len(shas) && shas[unchanged] == current[unchanged].SHA {
+		unchanged++
+	}
+	if unchanged == len(shas) {
+		return nil
+	}
+
+	if err := EnsureCleanWorkingTree(); err != nil {
+		return err
+	}
+
+	var base string
+	if unchanged == 0 {
+		var err error
+		if base, err = s.Base(); err != nil {
+			return err
+		}
+	}
+
+	tips := make(map[string]string)
+	commits := make(map[string][]string)
+	for _, ref := range current {
+		tip, err := gitLine("rev-parse", "--verify", ref.Branch)
+		if err != nil {
+			return err
+		}
+		tips[ref.Branch] = tip
+
+		if commits[ref.SHA], err = s.RefCommits(ref, base); err != nil {
+			return err
+		}
+	}
+
+	start := base
+	if unchanged > 0 {
+		start = tips[current[unchanged-1].Branch]
+	}
+
+	err := s.replay(shas[unchanged:], start, commits, tips)
+	if err != nil {
+		return err
+	}
+
+	for i, sha := range shas {
+		ref := s.Refs[sha]
+		ref.Prev, ref.Next = "", ""
+		if i > 0 {
+			ref.Prev = shas[i-1]
+		}
+		if i < len(shas)-1 {
+			ref.Next = shas[i+1]
+		}
+		s.Refs[sha] = ref
+
+		if err := UpdateStackRefFile(s.Title, ref); err != nil {
+			return fmt.Errorf("could not update reference file: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// replay cherry-picks the commits of refs on top of start, in order, and points
+// the branch of each ref to its last commit. On failure, branches are reset to tips.
+func (s *Stack) replay(shas []string, start string, commits map[string][]string, tips map[string]string) error {
+	original, _ := CurrentBranch()
+	restoreHead := func() {
+		if original != "" {
+			_, _ = stackGit.Git("checkout", "--quiet", original)
+		}
+	}
+
+	if _, err := stackGit.Git("checkout", "--quiet", "--detach", start); err != nil {
+		return err
+	}
+
+	for _, sha := range shas {
+		ref := s.Refs[sha]
+		for _, commit := range commits[sha] {
+			if _, err := stackGit.Git("cherry-pick", "--allow-empty", commit); err != nil {
+				_, _ = stackGit.Git("cherry-pick", "--abort")
+				for branch, tip := range tips {
+					_, _ = stackGit.Git("branch", "--force", branch, tip)
+				}
+				restoreHead()
+				return fmt.Errorf("could not move %s: commit %s does not apply. The stack was not changed.", ref.Branch, commit)
+			}
+		}
+
+		if _, err := stackGit.Git("branch", "--force", ref.Branch, "HEAD"); err != nil {
+			return err
+		}
+	}
+
+	restoreHead()
+	return nil
+}
+
+// Fold merges a ref into the previous ref: the branch of the previous ref is moved
+// to the branch of ref, which is then removed from the stack. The refs after it are
+// already based on these commits, so they do not need to be rebased.
+func (s *Stack) Fold(ref StackRef) error {
+	if ref.IsFirst() {
+		return fmt.Errorf("%s is the first ref of the stack and has no parent to fold into.", ref.Branch)
+	}
+
+	if err := EnsureCleanWorkingTree(); err != nil {
+		return err
+	}
+
+	parent := s.Refs[ref.Prev]
+
+	// the branch of the parent can't be moved while it is checked out.
+	if err := CheckoutBranch(ref.Branch); err != nil {
+		return err
+	}
+	if _, err := stackGit.Git("branch", "--force", parent.Branch, ref.Branch); err != nil {
+		return err
+	}
+
+	return s.RemoveRef(ref)
+}
+
+// Split moves the changes to files out of the commit of ref, into the commit of
+// newRef, which is inserted in the stack after ref with its own branch. The ref must
+// have exactly one commit. The refs after it are rebased onto newRef.
+func (s *Stack) Split(ref StackRef, newRef StackRef, files []string) error {
+	if err := EnsureCleanWorkingTree(); err != nil {
+		return err
+	}
+
+	tip, err := s.singleCommit(ref)
+	if err != nil {
+		return err
+	}
+	base := tip + "^"
+
+	changedFiles, err := s.RefFiles(ref)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !slices.Contains(changedFiles, file) {
+			return fmt.Errorf("%s is not changed by %s.", file, ref.Branch)
+		}
+	}
+	if len(files) == 0 || len(files) >= len(changedFiles) {
+		return errors.New("select some, but not all, of the changed files to split into the new ref.")
+	}
+
+	// On failure, the branch of ref is reset to its commit, and the branch of newRef is removed.
+	created := false
+	restore := func() {
+		_, _ = stackGit.Git("checkout", "--quiet", "--force", ref.Branch)
+		_, _ = stackGit.Git("reset", "--quiet", "--hard", tip)
+		if created {
+			_, _ = stackGit.Git("branch", "--quiet", "--delete", "--force", newRef.Branch)
+		}
+	}
+
+	steps := [][]string{
+		{"checkout", "--quiet", ref.Branch},
+		{"reset", "--quiet", "--soft", base},
+		append([]string{"reset", "--quiet", base, "--"}, files...),
+		{"commit", "--quiet", "--reuse-message", tip},
+		{"checkout", "--quiet", "-b", newRef.Branch},
+		append([]string{"add", "--all", "--"}, files...),
+		{"commit", "--quiet", "--message", newRef.Description},
+	}
+	for _, step := range steps {
+		if _, err := stackGit.Git(step...); err != nil {
+			restore()
+			return fmt.Errorf("could not split %s: %v. The stack was not changed.", ref.Branch, err)
+		}
+		created = created || slices.Contains(step, "-b")
+	}
+
+	if !ref.IsLast() {
+		// the tree of newRef is the tree of the old commit, so this can't conflict.
+		_, err := stackGit.Git("rebase", "--quiet", "--update-refs", "--onto", newRef.Branch, tip, s.Last().Branch)
+		if err != nil {
+			_, _ = stackGit.Git("rebase", "--abort")
+			restore()
+			return fmt.Errorf("could not rebase the refs after %s: %v. The stack was not changed.", ref.Branch, err)
+		}
+		if err := CheckoutBranch(newRef.Branch); err != nil {
+			return err
+		}
+	}
+
+	return s.InsertAfter(ref, newRef)
+}
+
+// RefFiles returns the files changed by the commit of a ref, which must have exactly one commit.
+func (s *Stack) RefFiles(ref StackRef) ([]string, error) {
+	tip, err := s.singleCommit(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := gitLine("diff", "--name-only", tip+"^", tip, "--")
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(changed, "\n"), nil
+}
+
+func (s *Stack) singleCommit(ref StackRef) (string, error) {
+	var base string
+	if ref.IsFirst() {
+		var err error
+		if base, err = s.Base(); err != nil {
+			return "", err
+		}
+	}
+
+	commits, err := s.RefCommits(ref, base)
+	if err != nil {
+		return "", err
+	}
+	if len(commits) != 1 {
+		return "", fmt.Errorf("%s has %d commits. Only a ref with a single commit can be split.", ref.Branch, len(commits))
+	}
+
+	return commits[0], nil
+}
+
+// InsertAfter links newRef into the stack after ref and writes the stack files.
+func (s *Stack) InsertAfter(ref StackRef, newRef StackRef) error {
+	newRef.Prev = ref.SHA
+	newRef.Next = ref.Next
+
+	if !ref.IsLast() {
+		next := s.Refs[ref.Next]
+		next.Prev = newRef.SHA
+		s.Refs[next.SHA] = next
+		if err := UpdateStackRefFile(s.Title, next); err != nil {
+			return fmt.Errorf("could not update reference file: %v", err)
+		}
+	}
+
+	ref.Next = newRef.SHA
+	s.Refs[ref.SHA] = ref
+	if err := UpdateStackRefFile(s.Title, ref); err != nil {
+		return fmt.Errorf("could not update reference file: %v", err)
+	}
+
+	s.Refs[newRef.SHA] = newRef
+	if err := AddStackRefFile(s.Title, newRef); err != nil {
+		return fmt.Errorf("could not create reference file: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// initStackRepo creates a repository with an origin remote and a stack where each
+// ref adds one file named after its branch.
+func initStackRepo(t *testing.T, title string, branches ...string) Stack {
+	t.Helper()
+
+	origin := t.TempDir()
+	_, err := gitLine("init", "--quiet", "--bare", origin)
+	require.NoError(t, err)
+
+	dir := InitGitRepoWithCommit(t)
+	for _, args := range [][]string{
+		{"branch", "-M", "main"},
+		{"remote", "add", DefaultRemote, origin},
+		{"push", "--quiet", DefaultRemote, "main"},
+		{"--git-dir", origin, "symbolic-ref", "HEAD", "refs/heads/main"},
+		{"fetch", "--quiet", DefaultRemote},
+	} {
+		_, err := gitLine(args...)
+		require.NoError(t, err)
+	}
+
+	stack := Stack{Title: title, Refs: map[string]StackRef{}}
+	for i, branch := range branches {
+		require.NoError(t, CheckoutNewBranch(branch))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, branch+".txt"), []byte(branch+"\n"), 0o644))
+		_, err := gitLine("add", branch+".txt")
+		require.NoError(t, err)
+		_, err = gitLine("commit", "--quiet", "-m", "add "+branch)
+		require.NoError(t, err)
+
+		ref := StackRef{SHA: "sha-" + branch, Branch: branch, Description: "add " + branch}
+		if i > 0 {
+			ref.Prev = "sha-" + branches[i-1]
+		}
+		if i < len(branches)-1 {
+			ref.Next = "sha-" + branches[i+1]
+		}
+		stack.Refs[ref.SHA] = ref
+	}
+	require.NoError(t, CreateRefFiles(stack.Refs, title))
+
+	return stack
+}
+
+func commitSubjects(t *testing.T, branch string) []string {
+	t.Helper()
+
+	log, err := gitLine("log", "--format=%s", "main.."+branch)
+	require.NoError(t, err)
+	if log == "" {
+		return nil
+	}
+	return strings.Split(log, "\n")
+}
+
+func Test_StackReorder(t *testing.T) {
+	stack := initStackRepo(t, "reorder", "one", "two", "three")
+
+	err := stack.Reorder([]string{"sha-two", "sha-one", "sha-three"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"add two"}, commitSubjects(t, "two"))
+	require.Equal(t, []string{"add one", "add two"}, commitSubjects(t, "one"))
+	require.Equal(t, []string{"add three", "add one", "add two"}, commitSubjects(t, "three"))
+
+	gathered, err := GatherStackRefs("reorder")
+	require.NoError(t, err)
+	require.Equal(t, []string{"two", "one", "three"}, gathered.Branches())
+
+	branch, err := CurrentBranch()
+	require.NoError(t, err)
+	require.Equal(t, "three", branch)
+}
+
+func Test_StackReorder_invalid(t *testing.T) {
+	stack := Stack{
+		Title: "invalid",
+		Refs: map[string]StackRef{
+			"sha1": {SHA: "sha1", Next: "sha2", Branch: "one"},
+			"sha2": {SHA: "sha2", Prev: "sha1", Branch: "two"},
+		},
+	}
+
+	require.EqualError(t, stack.Reorder([]string{"sha2"}), "expected 2 refs, got 1. Use 'glab stack fold' to remove a ref.")
+	require.EqualError(t, stack.Reorder([]string{"sha2", "sha2"}), `ref "sha2" is listed more than once.`)
+	require.EqualError(t, stack.Reorder([]string{"sha2", "nope"}), `unknown ref "nope".`)
+	require.NoError(t, stack.Reorder([]string{"sha1", "sha2"}))
+}
+
+func Test_StackReorder_conflict(t *testing.T) {
+	stack := initStackRepo(t, "conflict", "one", "two")
+
+	// make two depend on one, so it can't move before it
+	require.NoError(t, os.WriteFile("one.txt", []byte("changed by two\n"), 0o644))
+	_, err := gitLine("commit", "--quiet", "-am", "change one")
+	require.NoError(t, err)
+	before, err := gitLine("rev-parse", "one", "two")
+	require.NoError(t, err)
+
+	err = stack.Reorder([]string{"sha-two", "sha-one"})
+	require.ErrorContains(t, err, "could not move two")
+
+	after, err := gitLine("rev-parse", "one", "two")
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+
+	gathered, err := GatherStackRefs("conflict")
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "two"}, gathered.Branches())
+}
+
+func Test_StackFold(t *testing.T) {
+	stack := initStackRepo(t, "fold", "one", "two", "three")
+
+	require.EqualError(t, stack.Fold(stack.Refs["sha-one"]), "one is the first ref of the stack and has no parent to fold into.")
+
+	err := stack.Fold(stack.Refs["sha-two"])
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"add two", "add one"}, commitSubjects(t, "one"))
+	require.False(t, HasLocalBranch("two"))
+
+	gathered, err := GatherStackRefs("fold")
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "three"}, gathered.Branches())
+}
+
+func Test_StackSplit(t *testing.T) {
+	stack := initStackRepo(t, "split", "one", "two")
+
+	// add a second file to the commit of one
+	require.NoError(t, CheckoutBranch("one"))
+	require.NoError(t, os.WriteFile("extra", []byte("extra\n"), 0o644))
+	for _, args := range [][]string{
+		{"add", "extra"},
+		{"commit", "--quiet", "--amend", "--no-edit"},
+		{"rebase", "--quiet", "--onto", "one", "one@{1}", "two"},
+	} {
+		_, err := gitLine(args...)
+		require.NoError(t, err)
+	}
+
+	tip, err := gitLine("rev-parse", "one")
+	require.NoError(t, err)
+
+	// the branch of the new ref already exists, so the split fails after one was reset.
+	err = stack.Split(stack.Refs["sha-one"], StackRef{SHA: "sha-x", Branch: "two", Description: "x"}, []string{"extra"})
+	require.ErrorContains(t, err, "could not split one: ")
+	restored, err := gitLine("rev-parse", "one")
+	require.NoError(t, err)
+	require.Equal(t, tip, restored)
+	require.Equal(t, []string{"add two", "add one"}, commitSubjects(t, "two"))
+	require.NoError(t, EnsureCleanWorkingTree())
+
+	newRef := StackRef{SHA: "sha-extra", Branch: "extra-branch", Description: "add extra"}
+	err = stack.Split(stack.Refs["sha-one"], newRef, []string{"extra"})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"add one"}, commitSubjects(t, "one"))
+	require.Equal(t, []string{"add extra", "add one"}, commitSubjects(t, "extra-branch"))
+	require.Equal(t, []string{"add two", "add extra", "add one"}, commitSubjects(t, "two"))
+
+	files, err := gitLine("diff", "--name-only", "one", "extra-branch")
+	require.NoError(t, err)
+	require.Equal(t, "extra", files)
+
+	gathered, err := GatherStackRefs("split")
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "extra-branch", "two"}, gathered.Branches())
+
+	err = gathered.Split(gathered.Refs["sha-two"], StackRef{SHA: "sha-x", Branch: "x"}, []string{"two.txt"})
+	require.EqualError(t, err, "select some, but not all, of the changed files to split into the new ref.")
+}
@@ -0,0 +1,184 @@
+package git
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+// StackRefPrefix is where the metadata of exported stacks is stored, one ref per stack.
+// The ref points to a commit with the ref files of the stack.
+const StackRefPrefix = "refs/glab-stacks/"
+
+// StackRefName returns the name of the ref that stores the metadata of an exported stack.
+func StackRefName(title string) string {
+	return StackRefPrefix + title
+}
+
+// Export writes the ref files of the stack to a commit on StackRefName and pushes
+// it to remote. The commit is added on top of the one on remote, if the stack was
+// already exported, so concurrent exports are rejected instead of lost.
+func (s *Stack) Export(remote string) error {
+	refName := StackRefName(s.Title)
+
+	var parent string
+	if hasRemoteRef(remote, refName) {
+		if _, err := stackGit.Git("fetch", "--quiet", remote, "+"+refName+":"+refName); err != nil {
+			return fmt.Errorf("could not fetch %s from %s: %v", refName, remote, err)
+		}
+		parent, _ = gitLine("rev-parse", "--verify", "--quiet", refName)
+	}
+
+	var tree strings.Builder
+	for ref := range s.Iter() {
+		data, err := json.Marshal(ref)
+		if err != nil {
+			return fmt.Errorf("error marshaling data: %v", err)
+		}
+
+		blob, err := gitWithInput(string(data), "hash-object", "-w", "--stdin")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&tree, "100644 blob %s\t%s.json\n", blob, ref.SHA)
+	}
+
+	treeSHA, err := gitWithInput(tree.String(), "mktree")
+	if err != nil {
+		return err
+	}
+
+	args := []string{"commit-tree", treeSHA, "-m", "Export stack " + s.Title}
+	if parent != "" {
+		args = append(args, "-p", parent)
+	}
+	commit, err := gitLine(args...)
+	if err != nil {
+		return err
+	}
+
+	if _, err := stackGit.Git("update-ref", refName, commit); err != nil {
+		return err
+	}
+
+	if _, err := stackGit.Git("push", "--quiet", remote, refName+":"+refName); err != nil {
+		return fmt.Errorf("could not push %s to %s: %v", refName, remote, err)
+	}
+
+	return nil
+}
+
+// ImportStack fetches the metadata of a stack exported to remote. It does not
+// write the ref files of the stack.
+func ImportStack(remote, title string) (Stack, error) {
+	refName := StackRefName(title)
+
+	if !hasRemoteRef(remote, refName) {
+		return Stack{}, fmt.Errorf("no stack named %s was exported to %s.", title, remote)
+	}
+
+	if _, err := stackGit.Git("fetch", "--quiet", remote, "+"+refName+":"+refName); err != nil {
+		return Stack{}, fmt.Errorf("could not fetch %s from %s: %v", refName, remote, err)
+	}
+
+	files, err := gitLine("ls-tree", "--name-only", refName)
+	if err != nil {
+		return Stack{}, err
+	}
+
+	stack := Stack{Title: title, Refs: make(map[string]StackRef)}
+	for _, file := range strings.Split(files, "\n") {
+		if path.Ext(file) != ".json" {
+			continue
+		}
+
+		data, err := stackGit.Git("cat-file", "blob", refName+":"+file)
+		if err != nil {
+			return Stack{}, err
+		}
+
+		ref := StackRef{}
+		if err := json.Unmarshal([]byte(data), &ref); err != nil {
+			return Stack{}, fmt.Errorf("could not read %s of stack %s: %v", file, title, err)
+		}
+		stack.Refs[ref.SHA] = ref
+	}
+
+	if err := validateStackRefs(stack); err != nil {
+		return Stack{}, err
+	}
+
+	return stack, nil
+}
+
+// ExportedStacks returns the titles of the stacks exported to remote.
+func ExportedStacks(remote string) ([]string, error) {
+	output, err := gitLine("ls-remote", "--refs", remote, StackRefPrefix+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	var titles []string
+	for _, line := range strings.Split(output, "\n") {
+		_, refName, found := strings.Cut(line, "\t")
+		if found {
+			titles = append(titles, strings.TrimPrefix(refName, StackRefPrefix))
+		}
+	}
+
+	return titles, nil
+}
+
+// WriteRefFiles writes the ref files of every ref of the stack.
+func (s *Stack) WriteRefFiles() error {
+	for _, ref := range s.Refs {
+		if err := AddStackRefFile(s.Title, ref); err != nil {
+			return fmt.Errorf("could not create reference file: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// TrackRemoteBranches creates a local branch for every branch of the stack that
+// exists only on remote. It returns the branches that exist on neither.
+func (s *Stack) TrackRemoteBranches(remote string) ([]string, error) {
+	if _, err := stackGit.Git("fetch", "--quiet", remote); err != nil {
+		return nil, fmt.Errorf("could not fetch from %s: %v", remote, err)
+	}
+
+	var missing []string
+	for ref := range s.Iter() {
+		if HasLocalBranch(ref.Branch) {
+			continue
+		}
+
+		remoteBranch := remote + "/" + ref.Branch
+		if _, err := gitLine("rev-parse", "--verify", "--quiet", remoteBranch); err != nil {
+			missing = append(missing, ref.Branch)
+			continue
+		}
+
+		if _, err := stackGit.Git("branch", "--quiet", "--track", ref.Branch, remoteBranch); err != nil {
+			return nil, err
+		}
+	}
+
+	return missing, nil
+}
+
+func hasRemoteRef(remote, refName string) bool {
+	output, err := gitLine("ls-remote", "--refs", remote, refName)
+	return err == nil && output != ""
+}
+
+func gitWithInput(input string, args ...string) (string, error) {
+	cmd := GitCommand(args...)
+	cmd.Stdin = strings.NewReader(input)
+
+	output, err := run.PrepareCmd(cmd).Output()
+	return strings.TrimSpace(string(output)), err
+}
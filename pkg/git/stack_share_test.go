@@ -0,0 +1,66 @@
+package git
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StackExportImport(t *testing.T) {
+	stack := initStackRepo(t, "shared", "one", "two")
+
+	require.NoError(t, stack.Export(DefaultRemote))
+
+	titles, err := ExportedStacks(DefaultRemote)
+	require.NoError(t, err)
+	require.Equal(t, []string{"shared"}, titles)
+
+	// export again, on top of the first export.
+	two := stack.Refs["sha-two"]
+	two.MR = "https://gitlab.com/a/b/-/merge_requests/2"
+	stack.Refs["sha-two"] = two
+	require.NoError(t, stack.Export(DefaultRemote))
+
+	count, err := gitLine("rev-list", "--count", StackRefName("shared"))
+	require.NoError(t, err)
+	require.Equal(t, "2", count)
+
+	// forget the stack locally
+	root, err := StackRootDir("shared")
+	require.NoError(t, err)
+	require.NoError(t, os.RemoveAll(root))
+	_, err = gitLine("update-ref", "-d", StackRefName("shared"))
+	require.NoError(t, err)
+
+	imported, err := ImportStack(DefaultRemote, "shared")
+	require.NoError(t, err)
+	require.Equal(t, stack.Refs, imported.Refs)
+
+	require.NoError(t, imported.WriteRefFiles())
+	gathered, err := GatherStackRefs("shared")
+	require.NoError(t, err)
+	require.Equal(t, []string{"one", "two"}, gathered.Branches())
+
+	_, err = ImportStack(DefaultRemote, "nope")
+	require.EqualError(t, err, "no stack named nope was exported to origin.")
+}
+
+func Test_StackTrackRemoteBranches(t *testing.T) {
+	stack := initStackRepo(t, "tracked", "one", "two")
+
+	_, err := gitLine("push", "--quiet", DefaultRemote, "one")
+	require.NoError(t, err)
+	require.NoError(t, CheckoutBranch("main"))
+	require.NoError(t, DeleteLocalBranch("one"))
+	require.NoError(t, DeleteLocalBranch("two"))
+
+	missing, err := stack.TrackRemoteBranches(DefaultRemote)
+	require.NoError(t, err)
+	require.Equal(t, []string{"two"}, missing)
+	require.True(t, HasLocalBranch("one"))
+
+	upstream, err := gitLine("rev-parse", "--abbrev-ref", "one@{upstream}")
+	require.NoError(t, err)
+	require.Equal(t, "origin/one", upstream)
+}
@@ -75,6 +75,17 @@ func StackRootDir(title string) (string, error) {
 	return filepath.Join(baseDir, StackLocation, title), nil
 }
 
+// StackSyncStateFile returns the path of the state of a 'stack sync' stopped on a conflict.
+// It is next to the directory of the stack, which must only contain ref files.
+func StackSyncStateFile(title string) (string, error) {
+	root, err := StackRootDir(title)
+	if err != nil {
+		return "", err
+	}
+
+	return root + ".sync.json", nil
+}
+
 func AddStackRefFile(title string, stackRef StackRef) error {
 	refDir, err := StackRootDir(title)
 	if err != nil {
@@ -1,11 +1,9 @@
 package git
 
 import (
-	"math/rand/v2"
 	"net/http"
 	"os"
 	"os/exec"
-	"strconv"
 	"testing"
 
 	"gitlab.com/gitlab-org/cli/internal/run"
@@ -121,7 +119,7 @@ func MockStackUser() HttpMock {
 	}
 }
 
-func MockPostStackMR(source, target, project string) HttpMock {
+func MockPostStackMR(source, target, project, iid string) HttpMock {
 	return HttpMock{
 		method: http.MethodPost,
 		path:   "/api/v4/projects/stack_guy%2Fstackproject/merge_requests",
@@ -136,7 +134,8 @@ func MockPostStackMR(source, target, project string) HttpMock {
 			}`,
 		body: `{
 			"title": "Test MR",
-			"iid": ` + strconv.Itoa(rand.IntN(100)) + `,
+			"iid": ` + iid + `,
+			"project_id": ` + project + `,
 			"source_branch":"` + source + `",
 			"target_branch":"` + target + `"
 		}`,
@@ -153,6 +152,15 @@ func MockPutStackMR(target, iid, project string) HttpMock {
 	}
 }
 
+func MockPutStackMRDescription(project, iid string) HttpMock {
+	return HttpMock{
+		method: http.MethodPut,
+		path:   "/api/v4/projects/" + project + "/merge_requests/" + iid,
+		status: http.StatusOK,
+		body:   `{}`,
+	}
+}
+
 func MockListStackMRsByBranch(branch, iid string) HttpMock {
 	return HttpMock{
 		method: http.MethodGet,
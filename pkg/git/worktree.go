@@ -0,0 +1,83 @@
+package git
+
+import (
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+)
+
+// Worktree is a working tree of the repository, as listed by `git worktree list`.
+type Worktree struct {
+	Path   string
+	Head   string
+	Branch string
+	// Prunable is true if the directory of the worktree no longer exists.
+	Prunable bool
+}
+
+// ListWorktrees returns the worktrees of the repository. The first one is the main worktree.
+func ListWorktrees() ([]Worktree, error) {
+	worktreeCmd := GitCommand("worktree", "list", "--porcelain")
+	output, err := run.PrepareCmd(worktreeCmd).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktrees(string(output)), nil
+}
+
+func parseWorktrees(output string) []Worktree {
+	var worktrees []Worktree
+
+	for _, block := range strings.Split(strings.TrimSpace(output), "\n\n") {
+		var wt Worktree
+		for _, line := range strings.Split(block, "\n") {
+			key, value, _ := strings.Cut(line, " ")
+			switch key {
+			case "worktree":
+				wt.Path = value
+			case "HEAD":
+				wt.Head = value
+			case "branch":
+				wt.Branch = strings.TrimPrefix(value, "refs/heads/")
+			case "prunable":
+				wt.Prunable = true
+			}
+		}
+
+		if wt.Path != "" {
+			worktrees = append(worktrees, wt)
+		}
+	}
+
+	return worktrees
+}
+
+// AddWorktree creates a worktree at path, with branch checked out.
+func AddWorktree(path, branch string) error {
+	return RunCmd([]string{"worktree", "add", path, branch})
+}
+
+// RemoveWorktree removes the worktree at path. With force, uncommitted changes are discarded.
+func RemoveWorktree(path string, force bool) error {
+	args := []string{"worktree", "remove"}
+	if force {
+		args = append(args, "--force")
+	}
+
+	return RunCmd(append(args, path))
+}
+
+// DeleteBranch deletes a local branch. Without force, a branch with commits that are
+// not merged into its upstream branch or HEAD is kept, and an error is returned.
+func DeleteBranch(branch string, force bool) error {
+	if force {
+		return RunCmd([]string{"branch", "-D", branch})
+	}
+	return RunCmd([]string{"branch", "-d", branch})
+}
+
+// PruneWorktrees removes the administrative files of worktrees whose directory no longer exists.
+func PruneWorktrees() error {
+	return RunCmd([]string{"worktree", "prune"})
+}
@@ -0,0 +1,43 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parseWorktrees(t *testing.T) {
+	output := heredoc.Doc(`
+		worktree /home/user/project
+		HEAD 1234567890abcdef1234567890abcdef12345678
+		branch refs/heads/main
+
+		worktree /home/user/project-mr-12
+		HEAD abcdef1234567890abcdef1234567890abcdef12
+		branch refs/heads/feature/login
+
+		worktree /home/user/detached
+		HEAD fedcba0987654321fedcba0987654321fedcba09
+		detached
+		prunable gitdir file points to non-existent location
+	`)
+
+	assert.Equal(t, []Worktree{
+		{
+			Path:   "/home/user/project",
+			Head:   "1234567890abcdef1234567890abcdef12345678",
+			Branch: "main",
+		},
+		{
+			Path:   "/home/user/project-mr-12",
+			Head:   "abcdef1234567890abcdef1234567890abcdef12",
+			Branch: "feature/login",
+		},
+		{
+			Path:     "/home/user/detached",
+			Head:     "fedcba0987654321fedcba0987654321fedcba09",
+			Prunable: true,
+		},
+	}, parseWorktrees(output))
+}
@@ -0,0 +1,54 @@
+package text
+
+// DiffOp tells whether a token of a diff is in both sequences, or only in one of them.
+type DiffOp int
+
+const (
+	DiffEqual DiffOp = iota
+	DiffRemoved
+	DiffAdded
+)
+
+// DiffEdit is a token of a diff.
+type DiffEdit struct {
+	Op   DiffOp
+	Text string
+}
+
+// Diff returns the edits that turn a into b, based on their longest common subsequence.
+// Removed tokens come before the added tokens that replace them. It takes time and memory
+// proportional to len(a)*len(b), so callers must limit the size of their input.
+func Diff(a, b []string) []DiffEdit {
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	edits := make([]DiffEdit, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, DiffEdit{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, DiffEdit{Op: DiffRemoved, Text: a[i]})
+			i++
+		default:
+			edits = append(edits, DiffEdit{Op: DiffAdded, Text: b[j]})
+			j++
+		}
+	}
+	return edits
+}
@@ -0,0 +1,47 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    []string
+		b    []string
+		want []DiffEdit
+	}{
+		{
+			name: "equal",
+			a:    []string{"a", "b"},
+			b:    []string{"a", "b"},
+			want: []DiffEdit{{DiffEqual, "a"}, {DiffEqual, "b"}},
+		},
+		{
+			name: "replaced",
+			a:    []string{"a", "b", "c"},
+			b:    []string{"a", "x", "c"},
+			want: []DiffEdit{{DiffEqual, "a"}, {DiffRemoved, "b"}, {DiffAdded, "x"}, {DiffEqual, "c"}},
+		},
+		{
+			name: "added and removed",
+			a:    []string{"a", "b"},
+			b:    []string{"b", "c"},
+			want: []DiffEdit{{DiffRemoved, "a"}, {DiffEqual, "b"}, {DiffAdded, "c"}},
+		},
+		{
+			name: "empty",
+			a:    nil,
+			b:    []string{"a"},
+			want: []DiffEdit{{DiffAdded, "a"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Diff(tt.a, tt.b))
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -128,6 +130,77 @@ func FmtDuration(d time.Duration) string {
 	return fmt.Sprintf("%02dm %02ds", m, s)
 }
 
+// timeTrackingUnits are the units of GitLab time tracking, from the largest. A day is
+// 8 hours, a week is 5 days, and a month is 4 weeks: the working time units of GitLab.
+var timeTrackingUnits = []struct {
+	name    string
+	seconds int
+}{
+	{"mo", 4 * 5 * 8 * 3600},
+	{"w", 5 * 8 * 3600},
+	{"d", 8 * 3600},
+	{"h", 3600},
+	{"m", 60},
+}
+
+// FmtTimeTracking formats seconds of time tracking like GitLab does, for example "1w 2d 3h 30m".
+func FmtTimeTracking(seconds int) string {
+	if seconds <= 0 {
+		return "0h"
+	}
+
+	var parts []string
+	// months are left out, like GitLab does.
+	for _, unit := range timeTrackingUnits[1:] {
+		if n := seconds / unit.seconds; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.name))
+			seconds -= n * unit.seconds
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return strings.Join(parts, " ")
+}
+
+var timeTrackingPartRE = regexp.MustCompile(`^(\d+(?:\.\d+)?)(mo|w|d|h|m)$`)
+
+// ParseTimeTracking parses a duration in the GitLab time tracking syntax, like "1w 2d 3h 30m"
+// or "1.5h", and returns it in seconds. A number without unit is a number of hours, and a
+// leading '-' makes the duration negative, to subtract time spent.
+func ParseTimeTracking(duration string) (int, error) {
+	s := strings.TrimSpace(duration)
+	sign := 1
+	if strings.HasPrefix(s, "-") {
+		sign = -1
+		s = strings.TrimSpace(s[1:])
+	}
+
+	if hours, err := strconv.ParseFloat(s, 64); err == nil && hours >= 0 {
+		return sign * int(hours*3600), nil
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("invalid duration: %q. Use the GitLab time tracking syntax, like 1h 30m.", duration)
+	}
+
+	seconds := 0
+	for _, field := range fields {
+		m := timeTrackingPartRE.FindStringSubmatch(field)
+		if m == nil {
+			return 0, fmt.Errorf("invalid duration: %q. Use the GitLab time tracking syntax, like 1h 30m.", duration)
+		}
+		n, _ := strconv.ParseFloat(m[1], 64)
+		for _, unit := range timeTrackingUnits {
+			if unit.name == m[2] {
+				seconds += int(n * float64(unit.seconds))
+			}
+		}
+	}
+	return sign * seconds, nil
+}
+
 func Humanize(s string) string {
 	// Replaces - and _ with spaces.
 	replace := "_-"
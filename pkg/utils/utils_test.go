@@ -36,6 +36,47 @@ func Test_PrettyTimeAgo(t *testing.T) {
 	}
 }
 
+func Test_FmtTimeTracking(t *testing.T) {
+	cases := map[int]string{
+		0:      "0h",
+		30:     "0m",
+		1800:   "30m",
+		3600:   "1h",
+		30600:  "1d 30m",
+		190800: "1w 1d 5h",
+	}
+
+	for seconds, expected := range cases {
+		require.Equal(t, expected, FmtTimeTracking(seconds))
+	}
+}
+
+func Test_ParseTimeTracking(t *testing.T) {
+	cases := map[string]int{
+		"30m":      1800,
+		"1h 30m":   5400,
+		"1.5h":     5400,
+		"2":        7200,
+		"1w 1d 5h": 190800,
+		"1mo":      576000,
+		" -1h ":    -3600,
+		"-1d 2h":   -36000,
+		"1h   15m": 4500,
+		"0.25d":    7200,
+	}
+
+	for duration, expected := range cases {
+		seconds, err := ParseTimeTracking(duration)
+		require.NoError(t, err, duration)
+		require.Equal(t, expected, seconds, duration)
+	}
+
+	for _, duration := range []string{"", "-", "1x", "h", "1h 2", "1 h", "--1h"} {
+		_, err := ParseTimeTracking(duration)
+		require.Error(t, err, duration)
+	}
+}
+
 func Test_Pluralize(t *testing.T) {
 	testCases := []struct {
 		name   string